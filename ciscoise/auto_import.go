@@ -0,0 +1,179 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Modes of the automatic import of an object which already exists on create.
+const (
+	// The object is imported as is, the next plan shows its differences.
+	AUTO_IMPORT_ADOPT = "adopt"
+	// The object is imported, then updated with the configuration.
+	AUTO_IMPORT_OVERWRITE = "overwrite"
+	// The object is imported only if it matches the configuration.
+	AUTO_IMPORT_FAIL_ON_DIFF = "fail_on_diff"
+)
+
+// ERS reports a duplicate with a 400 and a message, OpenAPI with a 409.
+var conflictRegexp = regexp.MustCompile(`(?i)already exist|duplicate|conflict|\b409\b`)
+
+// autoImportResources wraps the create of every resource whose importer
+// accepts a natural key, so that an object which already exists is imported
+// when enable_auto_import is set.
+func autoImportResources(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		keys := importKeysOf(r.Importer)
+		if r.CreateContext == nil || r.ReadContext == nil || len(keys) == 0 {
+			continue
+		}
+		r.CreateContext = schema.CreateContextFunc(autoImportCreate(name, keys, r.Schema["parameters"], r.CreateContext, r.ReadContext, r.UpdateContext))
+	}
+}
+
+func autoImportCreate(name string, keys []importKey, parameters *schema.Schema, create schema.CreateContextFunc, read schema.ReadContextFunc, update schema.UpdateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clientConfig, ok := m.(ClientConfig)
+		diags := create(ctx, d, m)
+		if !ok || !clientConfig.EnableAutoImport || !isConflict(diags) {
+			return diags
+		}
+		params := autoImportParams(d, keys)
+		if len(params) == 0 {
+			logDebugf(ctx, "%s already exists, but its natural key is not configured", name)
+			return diags
+		}
+		logDebugf(ctx, "%s already exists, importing %s", name, joinResourceID(params))
+
+		planned := d.Get("parameters")
+		_, err := importStateRead(ctx, d, m, read, joinResourceID(params), params, map[string][]string{
+			"id": {"item.0.id", "parameters.0.id", "parameters.0.rule.0.id"},
+		})
+		if err != nil {
+			return append(diags, diagError(
+				"Failure when importing the existing object", err))
+		}
+
+		switch clientConfig.AutoImportMode {
+		case AUTO_IMPORT_FAIL_ON_DIFF:
+			if differences := configuredDifferences("parameters", parameters, planned, d.Get("parameters")); len(differences) > 0 {
+				d.SetId("")
+				return append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "The existing object differs from the configuration",
+					Detail:   fmt.Sprintf("Attributes differing: %s", strings.Join(differences, ", ")),
+				})
+			}
+		case AUTO_IMPORT_OVERWRITE:
+			if update == nil {
+				break
+			}
+			if err := d.Set("parameters", planned); err != nil {
+				return append(diags, diagError(
+					"Failure when setting the configuration of the existing object", err))
+			}
+			return update(ctx, d, m)
+		}
+		return nil
+	}
+}
+
+// isConflict reports whether diags hold an error reporting that the object
+// already exists.
+func isConflict(diags diag.Diagnostics) bool {
+	for _, d := range diags {
+		if d.Severity == diag.Error && (conflictRegexp.MatchString(d.Summary) || conflictRegexp.MatchString(d.Detail)) {
+			return true
+		}
+	}
+	return false
+}
+
+// autoImportParams returns the natural key of the configured object, nil
+// when any of its keys is not configured.
+func autoImportParams(d *schema.ResourceData, keys []importKey) map[string]string {
+	params := make(map[string]string)
+	for _, key := range keys {
+		value, ok := d.GetOk(key.path)
+		if !ok || interfaceToString(value) == "" {
+			return nil
+		}
+		params[key.param] = interfaceToString(value)
+	}
+	return params
+}
+
+// configuredDifferences returns the paths of the values configured in planned
+// which differ in remote. Values not configured, not returned by ISE or
+// sensitive in s, like secrets, are not compared; zero values returned by ISE
+// are.
+func configuredDifferences(path string, s *schema.Schema, planned interface{}, remote interface{}) []string {
+	if s != nil && s.Sensitive {
+		return nil
+	}
+	var differences []string
+	switch p := planned.(type) {
+	case map[string]interface{}:
+		r, _ := remote.(map[string]interface{})
+		keys := make([]string, 0, len(p))
+		for key := range p {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			differences = append(differences, configuredDifferences(path+"."+key, elemSchema(s, key), p[key], r[key])...)
+		}
+	case *schema.Set:
+		var rList []interface{}
+		if r, ok := remote.(*schema.Set); ok && r != nil {
+			rList = r.List()
+		}
+		differences = append(differences, configuredDifferences(path, s, p.List(), rList)...)
+	case []interface{}:
+		r, _ := remote.([]interface{})
+		for i, value := range p {
+			elemPath := fmt.Sprintf("%s.%d", path, i)
+			if i >= len(r) {
+				// The list returned by ISE lacks the element
+				if r != nil && !isEmptyValue(reflect.ValueOf(value)) {
+					differences = append(differences, elemPath)
+				}
+				continue
+			}
+			differences = append(differences, configuredDifferences(elemPath, elemSchema(s, ""), value, r[i])...)
+		}
+	default:
+		if isEmptyValue(reflect.ValueOf(planned)) || reflect.ValueOf(planned).IsZero() || remote == nil {
+			return nil
+		}
+		if fmt.Sprint(planned) != fmt.Sprint(remote) {
+			differences = append(differences, path)
+		}
+	}
+	return differences
+}
+
+// elemSchema returns the schema of the element of s, the attribute key of a
+// block element or the value of a list, set or map.
+func elemSchema(s *schema.Schema, key string) *schema.Schema {
+	if s == nil {
+		return nil
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		if key == "" {
+			return &schema.Schema{Elem: elem}
+		}
+		return elem.Schema[key]
+	case *schema.Schema:
+		return elem
+	}
+	return nil
+}
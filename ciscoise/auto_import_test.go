@@ -0,0 +1,171 @@
+package ciscoise
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testAutoImportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"description": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAutoImportCreate(t *testing.T) {
+	var updated bool
+	create := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return diag.Diagnostics{diagErrorWithResponse("Failure when executing CreateNetworkDevice", nil,
+			`{"ERSResponse":{"messages":[{"title":"Network Device Name already exist"}]}}`)}
+	}
+	read := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if separateResourceID(d.Id())["name"] != "switch1" {
+			d.SetId("")
+			return nil
+		}
+		_ = d.Set("parameters", []interface{}{map[string]interface{}{"id": "1234", "name": "switch1", "description": "remote"}})
+		return nil
+	}
+	update := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		updated = d.Get("parameters.0.description") == "configured"
+		return nil
+	}
+	createFunc := autoImportCreate("ciscoise_network_device", []importKey{{"name", "parameters.0.name"}}, testAutoImportSchema()["parameters"], create, read, update)
+
+	cases := map[string]struct {
+		mode        string
+		expectError bool
+		expectID    string
+		expectWrite bool
+	}{
+		"adopt":        {AUTO_IMPORT_ADOPT, false, "v2:id=1234&name=switch1", false},
+		"overwrite":    {AUTO_IMPORT_OVERWRITE, false, "v2:id=1234&name=switch1", true},
+		"fail_on_diff": {AUTO_IMPORT_FAIL_ON_DIFF, true, "", false},
+	}
+	for name, c := range cases {
+		updated = false
+		d := schema.TestResourceDataRaw(t, testAutoImportSchema(), map[string]interface{}{
+			"parameters": []interface{}{map[string]interface{}{"name": "switch1", "description": "configured"}},
+		})
+		diags := createFunc(context.Background(), d, ClientConfig{EnableAutoImport: true, AutoImportMode: c.mode})
+		if diags.HasError() != c.expectError {
+			t.Errorf("%s: unexpected diagnostics %v", name, diags)
+		}
+		if d.Id() != c.expectID {
+			t.Errorf("%s: expect ID %q, got %q", name, c.expectID, d.Id())
+		}
+		if updated != c.expectWrite {
+			t.Errorf("%s: expect update %v, got %v", name, c.expectWrite, updated)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, testAutoImportSchema(), map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"name": "switch2"}},
+	})
+	if diags := createFunc(context.Background(), d, ClientConfig{EnableAutoImport: true}); len(diags) != 2 {
+		t.Errorf("bad: expect the create and the import errors, got %v", diags)
+	}
+}
+
+func TestAutoImportParams(t *testing.T) {
+	keys := []importKey{{"policy_id", "parameters.0.id"}, {"name", "parameters.0.name"}}
+	d := schema.TestResourceDataRaw(t, testAutoImportSchema(), map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"id": "1234", "name": "rule1"}},
+	})
+	expected := map[string]string{"policy_id": "1234", "name": "rule1"}
+	if params := autoImportParams(d, keys); !reflect.DeepEqual(params, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, params)
+	}
+	d = schema.TestResourceDataRaw(t, testAutoImportSchema(), map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"name": "rule1"}},
+	})
+	if params := autoImportParams(d, keys); params != nil {
+		t.Errorf("bad: expect no natural key, got %v", params)
+	}
+}
+
+func TestAutoImportKeys(t *testing.T) {
+	for name, r := range Provider().ResourcesMap {
+		for _, key := range importKeysOf(r.Importer) {
+			if schemaAtPath(r.Schema, key.path) == nil {
+				t.Errorf("%s: natural key %s is not in the schema", name, key.path)
+			}
+		}
+	}
+}
+
+func TestIsConflict(t *testing.T) {
+	cases := map[string]bool{
+		`{"ERSResponse":{"messages":[{"title":"Network Device Name already exist"}]}}`: true,
+		`{"message":"Duplicate name","code":409}`:                                      true,
+		`{"message":"Invalid IP address"}`:                                             false,
+	}
+	for detail, expected := range cases {
+		diags := diag.Diagnostics{diagErrorWithResponse("Failure when executing Create", nil, detail)}
+		if isConflict(diags) != expected {
+			t.Errorf("bad: expect %v for %s", expected, detail)
+		}
+	}
+}
+
+func TestConfiguredDifferences(t *testing.T) {
+	parameters := &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"password": &schema.Schema{
+					Type:      schema.TypeString,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+	planned := []interface{}{map[string]interface{}{
+		"name":        "switch1",
+		"description": "",
+		"password":    "secret",
+		"coa_port":    1700,
+		"enabled":     true,
+		"location":    "San Jose",
+		"profile":     "Cisco",
+		"groups":      []interface{}{"Location#All Locations", "Device Type#All Device Types"},
+	}}
+	remote := []interface{}{map[string]interface{}{
+		"name":        "switch1",
+		"description": "remote",
+		"password":    "",
+		"coa_port":    0,
+		"enabled":     false,
+		"location":    "",
+		"groups":      []interface{}{"Location#All Locations#Site1"},
+	}}
+	differences := configuredDifferences("parameters", parameters, planned, remote)
+	expected := []string{"parameters.0.coa_port", "parameters.0.enabled", "parameters.0.groups.0", "parameters.0.groups.1", "parameters.0.location"}
+	if !reflect.DeepEqual(differences, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, differences)
+	}
+}
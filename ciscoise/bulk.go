@@ -0,0 +1,353 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// bulkStatus is the BulkStatus document returned by every ERS
+// MonitorBulkStatus operation.
+type bulkStatus struct {
+	BulkID          string               `json:"bulkId,omitempty"`
+	MediaType       string               `json:"mediaType,omitempty"`
+	ExecutionStatus string               `json:"executionStatus,omitempty"`
+	OperationType   string               `json:"operationType,omitempty"`
+	StartTime       string               `json:"startTime,omitempty"`
+	ResourcesCount  *int                 `json:"resourcesCount,omitempty"`
+	SuccessCount    *int                 `json:"successCount,omitempty"`
+	FailCount       *int                 `json:"failCount,omitempty"`
+	ResourcesStatus []bulkResourceStatus `json:"resourcesStatus,omitempty"`
+}
+
+type bulkResourceStatus struct {
+	ID                      string `json:"id,omitempty"`
+	Name                    string `json:"name,omitempty"`
+	Description             string `json:"description,omitempty"`
+	ResourceExecutionStatus string `json:"resourceExecutionStatus,omitempty"`
+	Status                  string `json:"status,omitempty"`
+}
+
+func (r bulkResourceStatus) failed() bool {
+	return isFailedStatus(r.ResourceExecutionStatus)
+}
+
+// Number of resources sent in a single ERS bulk request.
+const ERS_BULK_SIZE = 500
+
+// ersBulkType describes the ERS bulk submit operation of a resource type.
+// The SDK requests only carry operationType and resourceMediaType, so the
+// resources are sent with the client of the SDK.
+type ersBulkType struct {
+	name      string
+	path      string
+	mediaType string
+	monitor   func(client *isegosdk.Client, bulkID string) (interface{}, *resty.Response, error)
+}
+
+type ersBulkRequestBody struct {
+	OperationType     string        `json:"operationType"`
+	ResourceMediaType string        `json:"resourceMediaType"`
+	ResourcesList     []interface{} `json:"resourcesList,omitempty"`
+	IDList            []string      `json:"idList,omitempty"`
+}
+
+// bulkMonitorFunc calls the MonitorBulkStatus operation of a resource type.
+type bulkMonitorFunc func(bulkID string) (interface{}, *resty.Response, error)
+
+// bulkRequestSchema adds the attributes reporting the bulk results to the
+// schema of a bulk request resource.
+func bulkRequestSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["fail_on_partial_error"] = &schema.Schema{
+		Description: `Fail the apply when any resource of the bulk request fails. Defaults to false.`,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+	}
+	s["bulk_id"] = &schema.Schema{
+		Description: `ID of the bulk request, taken from the Location header.`,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["execution_status"] = &schema.Schema{
+		Description: `Execution status of the bulk request.`,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["results"] = &schema.Schema{
+		Description: `Status of each resource of the bulk request.`,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+	return s
+}
+
+// bulkIDFromResponse returns the last segment of the Location header of a
+// bulk request response, /ers/config/{resource}/bulk/{bulkid}.
+func bulkIDFromResponse(response *resty.Response) string {
+	if response == nil {
+		return ""
+	}
+	location := strings.TrimRight(response.Header().Get("Location"), "/")
+	if location == "" {
+		return ""
+	}
+	return location[strings.LastIndex(location, "/")+1:]
+}
+
+func parseBulkStatus(response interface{}) (*bulkStatus, error) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	document := struct {
+		BulkStatus *bulkStatus `json:"BulkStatus,omitempty"`
+	}{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return document.BulkStatus, nil
+}
+
+// waitForBulkRequest polls the monitor of a bulk request until it completes,
+// sets its results and returns a diagnostic for each failed resource. They
+// are errors with fail_on_partial_error and warnings otherwise, a bulk
+// failing without any resource status is always an error.
+func waitForBulkRequest(ctx context.Context, d *schema.ResourceData, operation string, response *resty.Response, monitor bulkMonitorFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	bulkID := bulkIDFromResponse(response)
+	if bulkID == "" {
+		logWarnf(ctx, "%s did not return a Location header, not waiting for its completion", operation)
+		return diags
+	}
+	_ = d.Set("bulk_id", bulkID)
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	status, err := waitForBulkStatus(waitCtx, operation, bulkID, monitor)
+	if err != nil {
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when waiting for %s bulk %s", operation, bulkID), err))
+		return diags
+	}
+
+	_ = d.Set("execution_status", status.ExecutionStatus)
+	if err := d.Set("results", flattenBulkResults(status.ResourcesStatus)); err != nil {
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when setting %s results", operation), err))
+		return diags
+	}
+	return append(diags, bulkDiagnostics(status, operation, d.Get("fail_on_partial_error").(bool))...)
+}
+
+// waitForBulkStatus polls the monitor of a bulk until it reaches a terminal
+// executionStatus.
+func waitForBulkStatus(ctx context.Context, operation string, bulkID string, monitor bulkMonitorFunc) (*bulkStatus, error) {
+	var status *bulkStatus
+	err := taskWaiter.wait(ctx, fmt.Sprintf("%s bulk %s", operation, bulkID), func() (bool, error) {
+		monitorResponse, restyResp, err := monitor(bulkID)
+		if err != nil {
+			// A client error, such as an unknown bulk, does not go away by
+			// waiting longer
+			if restyResp != nil && isPermanentClientError(restyResp.StatusCode()) {
+				return false, fmt.Errorf("%s: %s", err, restyResp.String())
+			}
+			logDebugf(ctx, "Unable to get the status of bulk %s: %v", bulkID, err)
+			return false, nil
+		}
+		current, err := parseBulkStatus(monitorResponse)
+		if err != nil || current == nil {
+			return false, nil
+		}
+		status = current
+		logInfof(ctx, "Bulk %s is %s", bulkID, status.ExecutionStatus)
+		return !pendingTaskStatuses[strings.ToUpper(status.ExecutionStatus)], nil
+	})
+	return status, err
+}
+
+// isPermanentClientError reports the 4xx status codes other than the ones
+// asking to try again later.
+func isPermanentClientError(statusCode int) bool {
+	if statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests {
+		return false
+	}
+	return statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError
+}
+
+// submitERSBulk sends the operation for names in bulk requests of
+// ERS_BULK_SIZE resources and waits for their completion. items holds the
+// resource of each name for create and update, ids the ID of each name for
+// update and delete. It returns the names whose operation failed.
+func submitERSBulk(ctx context.Context, m interface{}, bulkType ersBulkType, operation string, names []string, ids map[string]string, items map[string]interface{}) (map[string]bool, diag.Diagnostics) {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	failed := make(map[string]bool)
+	operationName := fmt.Sprintf("BulkRequestFor%s %s", bulkType.name, operation)
+	submitURL := clientConfig.Config.APIURL(ERS_API_PORT, fmt.Sprintf("/ers/config/%s/bulk/submit", bulkType.path))
+	for start := 0; start < len(names); start += ERS_BULK_SIZE {
+		end := start + ERS_BULK_SIZE
+		if end > len(names) {
+			end = len(names)
+		}
+		chunk := names[start:end]
+		request := ersBulkRequestBody{
+			OperationType:     operation,
+			ResourceMediaType: bulkType.mediaType,
+		}
+		nameByID := make(map[string]string)
+		for _, name := range chunk {
+			if id := ids[name]; id != "" {
+				nameByID[id] = name
+			}
+			if operation == "delete" {
+				request.IDList = append(request.IDList, ids[name])
+				continue
+			}
+			request.ResourcesList = append(request.ResourcesList, map[string]interface{}{bulkType.name: items[name]})
+		}
+
+		restyResp, err := putERSBulk(ctx, client, submitURL, bulkType, request)
+		bulkID := bulkIDFromResponse(restyResp)
+		if err == nil && bulkID == "" {
+			err = fmt.Errorf("no bulk ID in the Location header")
+		}
+		var status *bulkStatus
+		if err == nil {
+			status, err = waitForBulkStatus(ctx, operationName, bulkID, func(bulkID string) (interface{}, *resty.Response, error) {
+				return bulkType.monitor(client, bulkID)
+			})
+		}
+		if err != nil {
+			if restyResp != nil && restyResp.IsError() {
+				diags = append(diags, diagErrorWithResponse(
+					fmt.Sprintf("Failure when executing %s", operationName), err, restyResp.String()))
+			} else {
+				diags = append(diags, diagError(
+					fmt.Sprintf("Failure when executing %s", operationName), err))
+			}
+			for _, name := range chunk {
+				failed[name] = true
+			}
+			continue
+		}
+
+		itemFailures := 0
+		for _, item := range status.ResourcesStatus {
+			if !item.failed() {
+				continue
+			}
+			itemFailures++
+			if name, ok := nameByID[item.ID]; ok {
+				failed[name] = true
+			} else {
+				failed[item.Name] = true
+			}
+		}
+		if itemFailures == 0 && isFailedStatus(status.ExecutionStatus) {
+			for _, name := range chunk {
+				failed[name] = true
+			}
+		}
+		diags = append(diags, bulkDiagnostics(status, operationName, true)...)
+	}
+	return failed, diags
+}
+
+func putERSBulk(ctx context.Context, client *isegosdk.Client, submitURL string, bulkType ersBulkType, request ersBulkRequestBody) (*resty.Response, error) {
+	body := map[string]interface{}{
+		fmt.Sprintf("%sBulkRequest", bulkType.name): request,
+	}
+	logDebugf(ctx, "request sent => %v", responseInterfaceToString(body))
+	response, err := client.RestyClient().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetBody(body).
+		Put(submitURL)
+	if err != nil {
+		return nil, err
+	}
+	if response.IsError() {
+		return response, fmt.Errorf("error with operation BulkRequestFor%s", bulkType.name)
+	}
+	return response, nil
+}
+
+func flattenBulkResults(items []bulkResourceStatus) []map[string]interface{} {
+	var respItems []map[string]interface{}
+	for _, item := range items {
+		respItem := make(map[string]interface{})
+		respItem["id"] = item.ID
+		respItem["name"] = item.Name
+		respItem["status"] = item.ResourceExecutionStatus
+		if item.failed() {
+			respItem["error"] = item.Status
+		} else {
+			respItem["error"] = ""
+		}
+		respItems = append(respItems, respItem)
+	}
+	return respItems
+}
+
+func bulkDiagnostics(status *bulkStatus, operation string, failOnPartialError bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	severity := diag.Warning
+	if failOnPartialError {
+		severity = diag.Error
+	}
+	failures := 0
+	for _, item := range status.ResourcesStatus {
+		if !item.failed() {
+			continue
+		}
+		failures++
+		name := item.Name
+		if name == "" {
+			name = item.ID
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("%s failed for %s", operation, name),
+			Detail:   redactSecrets(item.Status),
+		})
+	}
+	if failures == 0 && isFailedStatus(status.ExecutionStatus) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s bulk %s ended with status %s", operation, status.BulkID, status.ExecutionStatus),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,87 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
+)
+
+func TestBulkIDFromResponse(t *testing.T) {
+	response := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+	response.RawResponse.Header.Set("Location", "https://ise:9060/ers/config/sxpvpns/bulk/1234/")
+	if bulkID := bulkIDFromResponse(response); bulkID != "1234" {
+		t.Errorf("bad: expect bulk 1234, got %q", bulkID)
+	}
+	if bulkID := bulkIDFromResponse(nil); bulkID != "" {
+		t.Errorf("bad: expect no bulk without a response, got %q", bulkID)
+	}
+}
+
+func TestParseBulkStatus(t *testing.T) {
+	items := []isegosdk.ResponseSxpVpnsMonitorBulkStatusSxpVpnsBulkStatusResourcesStatus{
+		{ID: "1", Name: "vpn1", ResourceExecutionStatus: "SUCCESS"},
+		{ID: "2", Name: "vpn2", ResourceExecutionStatus: "FAIL", Status: "Resource already exists"},
+	}
+	response := &isegosdk.ResponseSxpVpnsMonitorBulkStatusSxpVpns{
+		BulkStatus: &isegosdk.ResponseSxpVpnsMonitorBulkStatusSxpVpnsBulkStatus{
+			BulkID:          "1234",
+			ExecutionStatus: "COMPLETED",
+			ResourcesStatus: &items,
+		},
+	}
+	status, err := parseBulkStatus(response)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if status.BulkID != "1234" || len(status.ResourcesStatus) != 2 {
+		t.Fatalf("bad: unexpected status %+v", status)
+	}
+
+	diags := bulkDiagnostics(status, "BulkRequestForSxpVpns", false)
+	if len(diags) != 1 || diags.HasError() {
+		t.Errorf("bad: expect a warning for the failed resource, got %v", diags)
+	}
+	diags = bulkDiagnostics(status, "BulkRequestForSxpVpns", true)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Errorf("bad: expect an error for the failed resource, got %v", diags)
+	}
+
+	results := flattenBulkResults(status.ResourcesStatus)
+	if results[0]["error"] != "" || results[1]["error"] != "Resource already exists" {
+		t.Errorf("bad: unexpected results %v", results)
+	}
+}
+
+func TestBulkDiagnosticsFailedBulk(t *testing.T) {
+	status := &bulkStatus{BulkID: "1234", ExecutionStatus: "FAILED"}
+	if diags := bulkDiagnostics(status, "BulkRequestForSxpVpns", false); !diags.HasError() {
+		t.Errorf("bad: expect an error for a failed bulk, got %v", diags)
+	}
+}
+
+func TestWaitForBulkStatusClientError(t *testing.T) {
+	calls := 0
+	monitor := func(statusCode int) bulkMonitorFunc {
+		return func(bulkID string) (interface{}, *resty.Response, error) {
+			calls++
+			return nil, &resty.Response{RawResponse: &http.Response{StatusCode: statusCode}}, fmt.Errorf("error with operation MonitorBulkStatusSxpVpns")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := waitForBulkStatus(ctx, "create", "1234", monitor(http.StatusNotFound)); err == nil || strings.Contains(err.Error(), "stopped waiting") || calls != 1 {
+		t.Errorf("bad: expect an unknown bulk to end the wait, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	if _, err := waitForBulkStatus(ctx, "create", "1234", monitor(http.StatusServiceUnavailable)); err == nil || !strings.Contains(err.Error(), "stopped waiting") {
+		t.Errorf("bad: expect an unavailable server to be waited for, got %v after %d calls", err, calls)
+	}
+}
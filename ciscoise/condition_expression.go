@@ -0,0 +1,739 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Dictionary of the library conditions referenced in a condition expression,
+// e.g. Lib:Wireless_802.1X.
+const CONDITION_LIBRARY_DICTIONARY = "Lib"
+
+// Condition types of the ISE policy API.
+const (
+	CONDITION_TYPE_ATTRIBUTES = "ConditionAttributes"
+	CONDITION_TYPE_REFERENCE  = "ConditionReference"
+	CONDITION_TYPE_AND_BLOCK  = "ConditionAndBlock"
+	CONDITION_TYPE_OR_BLOCK   = "ConditionOrBlock"
+	CONDITION_TYPE_LIBRARY    = "Library"
+	// Time and date conditions exist only in the library
+	CONDITION_TYPE_TIME_AND_DATE = "TimeAndDateCondition"
+)
+
+// Operators of the ISE policy API. Condition expressions write them in upper
+// snake case, e.g. NOT_STARTS_WITH for notStartsWith.
+var conditionOperators = []string{
+	"contains", "endsWith", "equals", "greaterOrEquals", "greaterThan", "in",
+	"ipEquals", "ipGreaterThan", "ipLessThan", "ipNotEquals", "lessOrEquals",
+	"lessThan", "macContains", "macEndsWith", "macEquals", "macIn",
+	"macNotContains", "macNotEndsWith", "macNotEquals", "macNotIn",
+	"macNotStartsWith", "macStartsWith", "matches", "notContains",
+	"notEndsWith", "notEquals", "notIn", "notStartsWith", "startsWith",
+}
+
+// Keys of the condition of a library condition request, replaced by the
+// condition expression.
+var conditionKeys = []string{
+	"conditionType", "isNegate", "dictionaryName", "attributeName", "attributeId",
+	"attributeValue", "dictionaryValue", "operator", "children", "datesRange",
+	"datesRangeException", "hoursRange", "hoursRangeException", "weekDays",
+	"weekDaysException",
+}
+
+var conditionIdentifierRegexp = regexp.MustCompile(`^[A-Za-z0-9_.#-]+$`)
+
+// conditionNode is a condition tree in the JSON form of the ISE policy API.
+type conditionNode struct {
+	ConditionType  string          `json:"conditionType,omitempty"`
+	IsNegate       *bool           `json:"isNegate,omitempty"`
+	DictionaryName string          `json:"dictionaryName,omitempty"`
+	AttributeName  string          `json:"attributeName,omitempty"`
+	Operator       string          `json:"operator,omitempty"`
+	AttributeValue string          `json:"attributeValue,omitempty"`
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	Children       []conditionNode `json:"children,omitempty"`
+
+	Link                *conditionLink       `json:"link,omitempty"`
+	DatesRange          *conditionDatesRange `json:"datesRange,omitempty"`
+	DatesRangeException *conditionDatesRange `json:"datesRangeException,omitempty"`
+	HoursRange          *conditionHoursRange `json:"hoursRange,omitempty"`
+	HoursRangeException *conditionHoursRange `json:"hoursRangeException,omitempty"`
+	WeekDays            []string             `json:"weekDays,omitempty"`
+	WeekDaysException   []string             `json:"weekDaysException,omitempty"`
+}
+
+type conditionLink struct {
+	Href string `json:"href,omitempty"`
+}
+
+type conditionDatesRange struct {
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+}
+
+type conditionHoursRange struct {
+	StartTime string `json:"startTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+}
+
+func (n *conditionNode) negated() bool {
+	return n.IsNegate != nil && *n.IsNegate
+}
+
+func (n *conditionNode) negate() {
+	negate := !n.negated()
+	n.IsNegate = &negate
+}
+
+// referenceID returns the ID of the library condition referenced by n. The
+// children of policy sets only hold its link.
+func (n *conditionNode) referenceID() string {
+	if n.ID != "" || n.Link == nil {
+		return n.ID
+	}
+	href := strings.TrimSuffix(n.Link.Href, "/")
+	return href[strings.LastIndex(href, "/")+1:]
+}
+
+// conditionLibrary resolves the library conditions referenced by name in
+// condition expressions. apiPath is the policy API path of its domain.
+type conditionLibrary struct {
+	apiPath  string
+	idByName func(client *isegosdk.Client, name string) (string, error)
+	nameByID func(client *isegosdk.Client, id string) (string, error)
+}
+
+var networkAccessConditionLibrary = conditionLibrary{
+	apiPath: "/api/v1/policy/network-access",
+	idByName: func(client *isegosdk.Client, name string) (string, error) {
+		response, _, err := client.NetworkAccessConditions.GetNetworkAccessConditionByName(name)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found", name)
+		}
+		return response.Response.ID, nil
+	},
+	nameByID: func(client *isegosdk.Client, id string) (string, error) {
+		response, _, err := client.NetworkAccessConditions.GetNetworkAccessConditionByID(id)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found", id)
+		}
+		return response.Response.Name, nil
+	},
+}
+
+var deviceAdministrationConditionLibrary = conditionLibrary{
+	apiPath: "/api/v1/policy/device-admin",
+	idByName: func(client *isegosdk.Client, name string) (string, error) {
+		response, _, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByName(name)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found", name)
+		}
+		return response.Response.ID, nil
+	},
+	nameByID: func(client *isegosdk.Client, id string) (string, error) {
+		response, _, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByID(id)
+		if err != nil || response == nil || response.Response == nil {
+			return "", fmt.Errorf("library condition %s not found", id)
+		}
+		return response.Response.Name, nil
+	},
+}
+
+// conditionExpressionSchema returns the condition_expression attribute. When
+// set, it replaces the condition block.
+func conditionExpressionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Condition written as an expression, replacing the condition block when set, e.g. Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND (AD1:ExternalGroups EQUALS "corp/Staff" OR NOT Lib:Compliant).
+Operators are written in upper snake case, e.g. NOT_STARTS_WITH, and library conditions as Lib:name.`,
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validateConditionExpression,
+		DiffSuppressFunc: diffSuppressConditionExpression(),
+	}
+}
+
+func validateConditionExpression(v interface{}, k string) ([]string, []error) {
+	if _, err := parseConditionExpression(interfaceToString(v)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+	return nil, nil
+}
+
+func diffSuppressConditionExpression() schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if normalizeConditionExpression(old) == normalizeConditionExpression(new) {
+			return true
+		}
+		// An expression never configured is rendered from the condition
+		return new == "" && !conditionExpressionConfigured(d, k)
+	}
+}
+
+// conditionExpressionConfigured reports whether the condition_expression at
+// k is set in the configuration, or was when the state was written.
+func conditionExpressionConfigured(d *schema.ResourceData, k string) bool {
+	config := d.GetRawConfig()
+	if !config.IsNull() && config.IsKnown() && !configValueAt(config, strings.Split(k, ".")).IsNull() {
+		return true
+	}
+	recorded, _ := d.GetChange("configured_parameters")
+	if set, ok := recorded.(*schema.Set); ok {
+		paths := make(parameterPaths)
+		for _, path := range set.List() {
+			paths[interfaceToString(path)] = true
+		}
+		return paths.covers(k)
+	}
+	return false
+}
+
+// configValueAt returns the value at parts, a path of list indexes and
+// attribute names, within value, a raw configuration. Values that cannot be
+// indexed, like sets, are unknown.
+func configValueAt(value cty.Value, parts []string) cty.Value {
+	if len(parts) == 0 || value.IsNull() || !value.IsKnown() {
+		return value
+	}
+	t := value.Type()
+	switch {
+	case t.IsObjectType():
+		if !t.HasAttribute(parts[0]) {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		return configValueAt(value.GetAttr(parts[0]), parts[1:])
+	case t.IsListType() || t.IsTupleType():
+		i, err := strconv.Atoi(parts[0])
+		if err != nil || i >= value.LengthInt() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		return configValueAt(value.Index(cty.NumberIntVal(int64(i))), parts[1:])
+	}
+	return cty.UnknownVal(cty.DynamicPseudoType)
+}
+
+// normalizeConditionExpression returns expression as rendered from its tree,
+// or unchanged if it cannot be parsed.
+func normalizeConditionExpression(expression string) string {
+	node, err := parseConditionExpression(expression)
+	if err != nil {
+		return expression
+	}
+	rendered, err := renderConditionExpression(node)
+	if err != nil {
+		return expression
+	}
+	return rendered
+}
+
+// expandConditionExpression returns the body of request, an SDK request,
+// with its condition at keys replaced by the tree of the condition_expression
+// at path, if any. Without keys, the request is a library condition, the
+// condition itself. The SDK types hold only part of nested conditions, so the
+// body is in the JSON form of the policy API, to send with policyAPIRequest.
+func expandConditionExpression(ctx context.Context, d *schema.ResourceData, client *isegosdk.Client, library conditionLibrary, path string, request interface{}, keys ...string) (interface{}, error) {
+	expression := interfaceToString(d.Get(path + ".condition_expression"))
+	if expression == "" {
+		return request, nil
+	}
+	node, err := parseConditionExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	inline := len(keys) == 0
+	if inline {
+		if node.ConditionType == CONDITION_TYPE_REFERENCE {
+			return nil, fmt.Errorf("a library condition cannot be a reference to %s:%s", CONDITION_LIBRARY_DICTIONARY, node.Name)
+		}
+		node.ConditionType = CONDITION_TYPE_LIBRARY + node.ConditionType
+	}
+	if err := resolveConditionReferences(client, library, node); err != nil {
+		return nil, err
+	}
+	var conditionMap map[string]interface{}
+	if err := responseToJSONTypes(node, &conditionMap); err != nil {
+		return nil, err
+	}
+	requestMap := make(map[string]interface{})
+	if request != nil && !reflect.ValueOf(request).IsNil() {
+		if err := responseToJSONTypes(request, &requestMap); err != nil {
+			return nil, err
+		}
+	}
+
+	if inline {
+		for _, key := range conditionKeys {
+			delete(requestMap, key)
+		}
+		for key, value := range conditionMap {
+			requestMap[key] = value
+		}
+	} else {
+		parent := requestMap
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := parent[key].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				parent[key] = child
+			}
+			parent = child
+		}
+		parent[keys[len(keys)-1]] = conditionMap
+	}
+	logDebugf(ctx, "Expanded condition_expression %s", expression)
+	return requestMap, nil
+}
+
+func resolveConditionReferences(client *isegosdk.Client, library conditionLibrary, node *conditionNode) error {
+	if node.ConditionType == CONDITION_TYPE_REFERENCE {
+		if isUUID(node.Name) {
+			node.ID = node.Name
+		} else {
+			id, err := library.idByName(client, node.Name)
+			if err != nil {
+				return err
+			}
+			node.ID = id
+		}
+		// ISE references library conditions by ID
+		node.Name = ""
+	}
+	for i := range node.Children {
+		if err := resolveConditionReferences(client, library, &node.Children[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setConditionExpression sets the condition_expression at path rendered from
+// the condition at keys of the policy API object of response, the response
+// fetched by the read. When response holds a list, the object is the one
+// whose ID, next to the condition, is id. Without keys, the object is a
+// library condition, the condition itself. Conditions that have no
+// expression, like time and date conditions, are rendered empty.
+func setConditionExpression(ctx context.Context, d *schema.ResourceData, m interface{}, library conditionLibrary, path string, response *resty.Response, id string, keys ...string) error {
+	parameters := d.Get("parameters")
+	parts := strings.Split(path, ".")
+	item := mapAtPath(parameters, parts[1:])
+	if item == nil || response == nil {
+		return nil
+	}
+	object, err := policyAPIResponseObject(response, id, keys)
+	if err != nil {
+		return err
+	}
+	condition := mapAtPath(object, keys)
+	expression := ""
+	node, err := conditionFromResponse(condition)
+	if err == nil && node != nil {
+		if err := nameConditionReferences(m.(ClientConfig).Client, cachedConditionLibrary(library), node); err != nil {
+			logDebugf(ctx, "Failure when naming the library conditions: %s", err)
+		}
+		expression, err = renderConditionExpression(node)
+	}
+	if err != nil {
+		logDebugf(ctx, "Condition without expression: %s", err)
+	}
+	item["condition_expression"] = expression
+	return d.Set("parameters", parameters)
+}
+
+// policyAPIResponseObject returns the object of response, a response of the
+// policy API, or the item of its list whose ID is id. The ID of an item is
+// next to its condition at keys.
+func policyAPIResponseObject(response *resty.Response, id string, keys []string) (map[string]interface{}, error) {
+	var result struct {
+		Response json.RawMessage `json:"response"`
+	}
+	if err := json.Unmarshal(response.Body(), &result); err != nil {
+		return nil, fmt.Errorf("unexpected response %s: %s", response.Body(), err)
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(result.Response, &object); err == nil {
+		return object, nil
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal(result.Response, &items); err != nil {
+		return nil, fmt.Errorf("unexpected response %s: %s", result.Response, err)
+	}
+	idKeys := keys
+	if len(idKeys) > 0 {
+		idKeys = idKeys[:len(idKeys)-1]
+	}
+	for _, item := range items {
+		if holder := mapAtPath(item, idKeys); holder != nil && interfaceToString(holder["id"]) == id {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
+
+func conditionFromResponse(condition interface{}) (*conditionNode, error) {
+	if condition == nil || reflect.ValueOf(condition).IsZero() {
+		return nil, nil
+	}
+	conditionJSON, err := json.Marshal(condition)
+	if err != nil {
+		return nil, err
+	}
+	node := &conditionNode{}
+	if err := json.Unmarshal(conditionJSON, node); err != nil {
+		return nil, err
+	}
+	if node.ConditionType == "" {
+		return nil, nil
+	}
+	return node, nil
+}
+
+func nameConditionReferences(client *isegosdk.Client, library conditionLibrary, node *conditionNode) error {
+	if strings.HasSuffix(node.ConditionType, "Reference") && node.Name == "" && node.referenceID() != "" {
+		name, err := library.nameByID(client, node.referenceID())
+		if err != nil {
+			return err
+		}
+		node.Name = name
+	}
+	for i := range node.Children {
+		if err := nameConditionReferences(client, library, &node.Children[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mapAtPath returns the map at parts, a path of list indexes and map keys,
+// within value as returned by d.Get, or nil.
+func mapAtPath(value interface{}, parts []string) map[string]interface{} {
+	if len(parts) == 0 {
+		m, _ := value.(map[string]interface{})
+		return m
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		i, err := strconv.Atoi(parts[0])
+		if err != nil || i >= len(v) {
+			return nil
+		}
+		return mapAtPath(v[i], parts[1:])
+	case map[string]interface{}:
+		return mapAtPath(v[parts[0]], parts[1:])
+	}
+	return nil
+}
+
+// renderConditionExpression renders node in the normalized syntax of
+// condition expressions.
+func renderConditionExpression(node *conditionNode) (string, error) {
+	conditionType := strings.TrimPrefix(node.ConditionType, CONDITION_TYPE_LIBRARY)
+	var rendered string
+	switch conditionType {
+	case CONDITION_TYPE_ATTRIBUTES:
+		operator := ""
+		for _, known := range conditionOperators {
+			if strings.EqualFold(known, node.Operator) {
+				operator = conditionOperatorKeyword(known)
+			}
+		}
+		if operator == "" {
+			return "", fmt.Errorf("unexpected operator %s", node.Operator)
+		}
+		rendered = fmt.Sprintf("%s:%s %s %s", quoteConditionIdentifier(node.DictionaryName), quoteConditionIdentifier(node.AttributeName), operator, quoteConditionString(node.AttributeValue))
+	case CONDITION_TYPE_REFERENCE:
+		name := node.Name
+		if name == "" {
+			name = node.referenceID()
+		}
+		if name == "" {
+			return "", fmt.Errorf("library condition without name or ID")
+		}
+		rendered = fmt.Sprintf("%s:%s", CONDITION_LIBRARY_DICTIONARY, quoteConditionIdentifier(name))
+	case CONDITION_TYPE_AND_BLOCK, CONDITION_TYPE_OR_BLOCK:
+		separator := " AND "
+		if conditionType == CONDITION_TYPE_OR_BLOCK {
+			separator = " OR "
+		}
+		children := make([]string, 0, len(node.Children))
+		for i := range node.Children {
+			child, err := renderConditionExpression(&node.Children[i])
+			if err != nil {
+				return "", err
+			}
+			if isConditionBlock(&node.Children[i]) && !node.Children[i].negated() {
+				child = "(" + child + ")"
+			}
+			children = append(children, child)
+		}
+		rendered = strings.Join(children, separator)
+		if node.negated() {
+			rendered = "(" + rendered + ")"
+		}
+	default:
+		return "", fmt.Errorf("unexpected condition type %s", node.ConditionType)
+	}
+	if node.negated() {
+		rendered = "NOT " + rendered
+	}
+	return rendered, nil
+}
+
+func isConditionBlock(node *conditionNode) bool {
+	conditionType := strings.TrimPrefix(node.ConditionType, CONDITION_TYPE_LIBRARY)
+	return conditionType == CONDITION_TYPE_AND_BLOCK || conditionType == CONDITION_TYPE_OR_BLOCK
+}
+
+// conditionOperatorKeyword returns the upper snake case of operator.
+func conditionOperatorKeyword(operator string) string {
+	var keyword strings.Builder
+	for i, r := range operator {
+		if i > 0 && unicode.IsUpper(r) {
+			keyword.WriteRune('_')
+		}
+		keyword.WriteRune(unicode.ToUpper(r))
+	}
+	return keyword.String()
+}
+
+func quoteConditionIdentifier(identifier string) string {
+	if conditionIdentifierRegexp.MatchString(identifier) && !isConditionKeyword(identifier) {
+		return identifier
+	}
+	return quoteConditionString(identifier)
+}
+
+func quoteConditionString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func isConditionKeyword(word string) bool {
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "NOT":
+		return true
+	}
+	return false
+}
+
+type conditionTokenKind int
+
+const (
+	conditionTokenEnd conditionTokenKind = iota
+	conditionTokenWord
+	conditionTokenString
+	conditionTokenColon
+	conditionTokenOpen
+	conditionTokenClose
+)
+
+type conditionToken struct {
+	kind   conditionTokenKind
+	value  string
+	offset int
+}
+
+func (t conditionToken) String() string {
+	switch t.kind {
+	case conditionTokenEnd:
+		return "end of expression"
+	case conditionTokenString:
+		return quoteConditionString(t.value)
+	}
+	return t.value
+}
+
+func tokenizeConditionExpression(expression string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, conditionToken{conditionTokenOpen, "(", i})
+			i++
+		case r == ')':
+			tokens = append(tokens, conditionToken{conditionTokenClose, ")", i})
+			i++
+		case r == ':':
+			tokens = append(tokens, conditionToken{conditionTokenColon, ":", i})
+			i++
+		case r == '"':
+			start := i
+			var value strings.Builder
+			for i++; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at offset %d", start)
+			}
+			i++
+			tokens = append(tokens, conditionToken{conditionTokenString, value.String(), start})
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune(`():"`, runes[i]) {
+				i++
+			}
+			tokens = append(tokens, conditionToken{conditionTokenWord, string(runes[start:i]), start})
+		}
+	}
+	return append(tokens, conditionToken{conditionTokenEnd, "", len(runes)}), nil
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	next   int
+}
+
+// parseConditionExpression parses a condition expression:
+//
+//	expression = term { "OR" term }
+//	term       = factor { "AND" factor }
+//	factor     = "NOT" factor | "(" expression ")" | "Lib" ":" name
+//	           | dictionary ":" attribute OPERATOR value
+//
+// Names and values are words or double quoted strings. Nested blocks of the
+// same type are merged, so that A AND (B AND C) is A AND B AND C.
+func parseConditionExpression(expression string) (*conditionNode, error) {
+	tokens, err := tokenizeConditionExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &conditionParser{tokens: tokens}
+	node, err := p.parseBlock(CONDITION_TYPE_OR_BLOCK)
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != conditionTokenEnd {
+		return nil, fmt.Errorf("unexpected %s at offset %d", token, token.offset)
+	}
+	return node, nil
+}
+
+func (p *conditionParser) peek() conditionToken {
+	return p.tokens[p.next]
+}
+
+func (p *conditionParser) take() conditionToken {
+	token := p.tokens[p.next]
+	if token.kind != conditionTokenEnd {
+		p.next++
+	}
+	return token
+}
+
+func (p *conditionParser) keyword(keyword string) bool {
+	token := p.peek()
+	if token.kind == conditionTokenWord && strings.EqualFold(token.value, keyword) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+// parseBlock parses the operands of an OR block, or of an AND block.
+func (p *conditionParser) parseBlock(conditionType string) (*conditionNode, error) {
+	keyword, parseOperand := "OR", func() (*conditionNode, error) { return p.parseBlock(CONDITION_TYPE_AND_BLOCK) }
+	if conditionType == CONDITION_TYPE_AND_BLOCK {
+		keyword, parseOperand = "AND", p.parseFactor
+	}
+	block := &conditionNode{ConditionType: conditionType}
+	for {
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if operand.ConditionType == conditionType && !operand.negated() {
+			block.Children = append(block.Children, operand.Children...)
+		} else {
+			block.Children = append(block.Children, *operand)
+		}
+		if !p.keyword(keyword) {
+			break
+		}
+	}
+	if len(block.Children) == 1 {
+		return &block.Children[0], nil
+	}
+	return block, nil
+}
+
+func (p *conditionParser) parseFactor() (*conditionNode, error) {
+	if p.keyword("NOT") {
+		node, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		node.negate()
+		return node, nil
+	}
+	token := p.take()
+	switch token.kind {
+	case conditionTokenOpen:
+		node, err := p.parseBlock(CONDITION_TYPE_OR_BLOCK)
+		if err != nil {
+			return nil, err
+		}
+		if token := p.take(); token.kind != conditionTokenClose {
+			return nil, fmt.Errorf("expected ) at offset %d, got %s", token.offset, token)
+		}
+		return node, nil
+	case conditionTokenWord, conditionTokenString:
+		if isConditionKeyword(token.value) && token.kind == conditionTokenWord {
+			return nil, fmt.Errorf("unexpected %s at offset %d", token, token.offset)
+		}
+	default:
+		return nil, fmt.Errorf("expected a condition at offset %d, got %s", token.offset, token)
+	}
+	dictionary := token.value
+	if colon := p.take(); colon.kind != conditionTokenColon {
+		return nil, fmt.Errorf("expected : after %s at offset %d, got %s", dictionary, colon.offset, colon)
+	}
+	attribute := p.take()
+	if attribute.kind != conditionTokenWord && attribute.kind != conditionTokenString {
+		return nil, fmt.Errorf("expected an attribute at offset %d, got %s", attribute.offset, attribute)
+	}
+	if token.kind == conditionTokenWord && dictionary == CONDITION_LIBRARY_DICTIONARY {
+		return &conditionNode{ConditionType: CONDITION_TYPE_REFERENCE, Name: attribute.value}, nil
+	}
+
+	operatorToken := p.take()
+	operator := ""
+	if operatorToken.kind == conditionTokenWord {
+		for _, known := range conditionOperators {
+			if strings.EqualFold(conditionOperatorKeyword(known), operatorToken.value) || strings.EqualFold(known, operatorToken.value) {
+				operator = known
+			}
+		}
+	}
+	if operator == "" {
+		return nil, fmt.Errorf("expected an operator after %s:%s at offset %d, got %s", dictionary, attribute.value, operatorToken.offset, operatorToken)
+	}
+	value := p.take()
+	if value.kind != conditionTokenWord && value.kind != conditionTokenString {
+		return nil, fmt.Errorf("expected a value at offset %d, got %s", value.offset, value)
+	}
+	return &conditionNode{
+		ConditionType:  CONDITION_TYPE_ATTRIBUTES,
+		DictionaryName: dictionary,
+		AttributeName:  attribute.value,
+		Operator:       operator,
+		AttributeValue: value.value,
+	}, nil
+}
@@ -0,0 +1,278 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/hashicorp/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestConditionExpressionRoundTrip(t *testing.T) {
+	cases := map[string]string{
+		`Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11"`:   `Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11"`,
+		`Radius:NAS-Port-Type equals Ethernet`:                   `Radius:NAS-Port-Type EQUALS "Ethernet"`,
+		`a:b notStartsWith "x" and (c:d EQUALS "y")`:             `a:b NOT_STARTS_WITH "x" AND c:d EQUALS "y"`,
+		`a:b EQUALS "1" AND (c:d EQUALS "2" AND e:f EQUALS "3")`: `a:b EQUALS "1" AND c:d EQUALS "2" AND e:f EQUALS "3"`,
+		`Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND (AD1:ExternalGroups EQUALS "corp/Staff" OR NOT Lib:Compliant)`: `Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND (AD1:ExternalGroups EQUALS "corp/Staff" OR NOT Lib:Compliant)`,
+		`NOT (a:b EQUALS "1" OR c:d EQUALS "2")`:       `NOT (a:b EQUALS "1" OR c:d EQUALS "2")`,
+		`NOT NOT Lib:Compliant`:                        `Lib:Compliant`,
+		`"Network Access":UseCase EQUALS "Guest Flow"`: `"Network Access":UseCase EQUALS "Guest Flow"`,
+		`a:b EQUALS "say \"hi\""`:                      `a:b EQUALS "say \"hi\""`,
+		`Lib:"Wired 802.1X"`:                           `Lib:"Wired 802.1X"`,
+	}
+	for expression, expected := range cases {
+		node, err := parseConditionExpression(expression)
+		if err != nil {
+			t.Errorf("bad: %s: %s", expression, err)
+			continue
+		}
+		rendered, err := renderConditionExpression(node)
+		if err != nil {
+			t.Errorf("bad: %s: %s", expression, err)
+			continue
+		}
+		if rendered != expected {
+			t.Errorf("bad: expect %s for %s, got %s", expected, expression, rendered)
+		}
+		if normalizeConditionExpression(rendered) != rendered {
+			t.Errorf("bad: expect %s to be normalized", rendered)
+		}
+	}
+}
+
+func TestConditionExpressionErrors(t *testing.T) {
+	for _, expression := range []string{
+		``,
+		`a:b EQUALS`,
+		`a:b IS "x"`,
+		`a EQUALS "x"`,
+		`(a:b EQUALS "x"`,
+		`a:b EQUALS "x" OR`,
+		`a:b EQUALS "x`,
+		`a:b EQUALS "x" c:d EQUALS "y"`,
+	} {
+		if _, err := parseConditionExpression(expression); err == nil {
+			t.Errorf("bad: expect an error for %s", expression)
+		}
+	}
+}
+
+func testConditionExpressionData(t *testing.T, expression string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"condition_expression": conditionExpressionSchema(),
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"name": "Staff", "condition_expression": expression}},
+	})
+}
+
+func TestExpandConditionExpression(t *testing.T) {
+	compliant := "01234567-89ab-cdef-0123-456789abcdef"
+	expression := `Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND (AD1:ExternalGroups EQUALS "corp/Staff" OR NOT Lib:` + compliant + `)`
+	d := testConditionExpressionData(t, expression)
+	request := &isegosdk.RequestNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule{
+		Profile: []string{"PermitAccess"},
+		Rule: &isegosdk.RequestNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRuleRule{
+			Name: "Staff",
+			Condition: &isegosdk.RequestNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRuleRuleCondition{
+				ConditionType: CONDITION_TYPE_ATTRIBUTES,
+				Operator:      "equals",
+			},
+		},
+	}
+	body, err := expandConditionExpression(context.Background(), d, nil, networkAccessConditionLibrary, "parameters.0", request, "rule", "condition")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	bodyMap, _ := body.(map[string]interface{})
+	rule := mapAtPath(bodyMap, []string{"rule"})
+	if rule["name"] != "Staff" || !reflect.DeepEqual(bodyMap["profile"], []interface{}{"PermitAccess"}) {
+		t.Errorf("bad: expect the other attributes of the request to be kept, got %v", bodyMap)
+	}
+	// The SDK types of the children of rules hold no children
+	node, err := conditionFromResponse(rule["condition"])
+	if err != nil || node == nil {
+		t.Fatalf("bad: unexpected condition %v: %s", rule["condition"], err)
+	}
+	if node.Operator != "" || len(node.Children) != 2 || len(node.Children[1].Children) != 2 {
+		t.Fatalf("bad: unexpected condition %+v", node)
+	}
+	reference := node.Children[1].Children[1]
+	if reference.ConditionType != CONDITION_TYPE_REFERENCE || reference.ID != compliant || !reference.negated() {
+		t.Errorf("bad: unexpected reference %+v", reference)
+	}
+	if rendered, _ := renderConditionExpression(node); rendered != expression {
+		t.Errorf("bad: expect %s, got %s", expression, rendered)
+	}
+
+	// The SDK types of the children of policy sets only hold references
+	policySet := &isegosdk.RequestNetworkAccessPolicySetCreateNetworkAccessPolicySet{Name: "Wireless"}
+	body, err = expandConditionExpression(context.Background(), d, nil, networkAccessConditionLibrary, "parameters.0", policySet, "condition")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	node, _ = conditionFromResponse(body.(map[string]interface{})["condition"])
+	if node == nil || len(node.Children) != 2 || node.Children[0].DictionaryName != "Radius" {
+		t.Errorf("bad: unexpected policy set condition %+v", node)
+	}
+
+	library := &isegosdk.RequestNetworkAccessConditionsCreateNetworkAccessCondition{
+		Name:          "Staff",
+		ConditionType: "LibraryConditionAttributes",
+		Operator:      "equals",
+	}
+	body, err = expandConditionExpression(context.Background(), d, nil, networkAccessConditionLibrary, "parameters.0", library)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	bodyMap = body.(map[string]interface{})
+	if bodyMap["name"] != "Staff" || bodyMap["conditionType"] != "LibraryConditionAndBlock" || bodyMap["operator"] != nil {
+		t.Errorf("bad: unexpected library condition %v", bodyMap)
+	}
+	node, _ = conditionFromResponse(bodyMap)
+	if rendered, _ := renderConditionExpression(node); rendered != expression {
+		t.Errorf("bad: expect %s, got %s", expression, rendered)
+	}
+	reference = node.Children[1].Children[1]
+	if reference.ID != compliant {
+		t.Errorf("bad: unexpected reference %+v", reference)
+	}
+
+	referenced := testConditionExpressionData(t, `Lib:`+compliant)
+	if _, err := expandConditionExpression(context.Background(), referenced, nil, networkAccessConditionLibrary, "parameters.0", library); err == nil {
+		t.Errorf("bad: expect an error for a library condition referencing another")
+	}
+	unset := testConditionExpressionData(t, "")
+	if body, err := expandConditionExpression(context.Background(), unset, nil, networkAccessConditionLibrary, "parameters.0", library); err != nil || body != library {
+		t.Errorf("bad: expect the request without condition_expression, got %v: %v", body, err)
+	}
+}
+
+func TestSetConditionExpression(t *testing.T) {
+	var fetched []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched = append(fetched, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/policy/network-access/policy-set/ps1/authorization":
+			w.Write([]byte(`{"response": [{"rule": {"id": "r0", "condition": {"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "AD1", "attributeName": "ExternalGroups", "operator": "equals", "attributeValue": "corp/Guests"}}},
+				{"rule": {"id": "r1", "condition": {"conditionType": "ConditionOrBlock", "isNegate": false, "children": [
+				{"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "AD1", "attributeName": "ExternalGroups", "operator": "equals", "attributeValue": "corp/Staff"},
+				{"conditionType": "ConditionAndBlock", "isNegate": false, "children": [
+					{"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "Network Access", "attributeName": "EapAuthentication", "operator": "notEquals", "attributeValue": "EAP-TLS"},
+					{"conditionType": "ConditionReference", "isNegate": true, "link": {"href": "https://ise/api/v1/policy/network-access/condition/c1"}}
+				]}
+			]}}}]}`))
+		case "/api/v1/policy/network-access/condition/c1":
+			w.Write([]byte(`{"response": {"id": "c1", "name": "Compliant"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	clientConfig.Config.UseAPIGateway = "true"
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	_, response, err := clientConfig.Client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules("ps1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	fetched = nil
+	d := testConditionExpressionData(t, `AD1:ExternalGroups EQUALS "corp/Staff" OR ("Network Access":EapAuthentication NOT_EQUALS EAP-TLS AND NOT Lib:Compliant)`)
+	if err := setConditionExpression(ctx, d, clientConfig, networkAccessConditionLibrary, "parameters.0", response, "r1", "rule", "condition"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := `AD1:ExternalGroups EQUALS "corp/Staff" OR ("Network Access":EapAuthentication NOT_EQUALS "EAP-TLS" AND NOT Lib:Compliant)`
+	if expression := d.Get("parameters.0.condition_expression"); expression != expected {
+		t.Errorf("bad: expect %s, got %s", expected, expression)
+	}
+	if d.Get("parameters.0.name") != "Staff" {
+		t.Errorf("bad: expect the other parameters to be kept")
+	}
+	if !reflect.DeepEqual(fetched, []string{"/api/v1/policy/network-access/condition/c1"}) {
+		t.Errorf("bad: expect only the library condition to be fetched, got %v", fetched)
+	}
+}
+
+func TestDiffSuppressConditionExpression(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"condition_expression": conditionExpressionSchema(),
+				},
+			},
+		},
+		"configured_parameters": &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	k := "parameters.0.condition_expression"
+	config := func(expression cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"id":                    cty.NullVal(cty.String),
+			"configured_parameters": cty.NullVal(cty.Set(cty.String)),
+			"parameters": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"condition_expression": expression,
+			})}),
+		})
+	}
+	cases := map[string]struct {
+		config   cty.Value
+		recorded []string
+		old, new string
+		expected bool
+	}{
+		"never configured":   {config(cty.NullVal(cty.String)), nil, "AD1:Group EQUALS Staff", "", true},
+		"unset":              {config(cty.NullVal(cty.String)), []string{k}, "AD1:Group EQUALS Staff", "", false},
+		"configured empty":   {config(cty.StringVal("")), nil, "AD1:Group EQUALS Staff", "", false},
+		"equivalent":         {config(cty.StringVal("AD1:Group EQUALS Staff")), []string{k}, "AD1:Group EQUALS Staff", `AD1:Group EQUALS "Staff"`, true},
+		"changed":            {config(cty.StringVal("AD1:Group EQUALS Guests")), []string{k}, "AD1:Group EQUALS Staff", "AD1:Group EQUALS Guests", false},
+		"without raw config": {cty.NilVal, nil, "AD1:Group EQUALS Staff", "", true},
+	}
+	for name, c := range cases {
+		attributes := map[string]string{"id": "1", "parameters.#": "1", k: c.old, "configured_parameters.#": strconv.Itoa(len(c.recorded))}
+		for _, path := range c.recorded {
+			attributes[fmt.Sprintf("configured_parameters.%d", schema.HashString(path))] = path
+		}
+		diff := &terraform.InstanceDiff{}
+		if c.config != cty.NilVal {
+			diff.RawConfig = c.config
+		}
+		d, err := schema.InternalMap(s).Data(&terraform.InstanceState{ID: "1", Attributes: attributes}, diff)
+		if err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+		if suppressed := diffSuppressConditionExpression()(k, c.old, c.new, d); suppressed != c.expected {
+			t.Errorf("%s: bad: expect suppressed %v, got %v", name, c.expected, suppressed)
+		}
+	}
+}
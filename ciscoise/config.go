@@ -2,10 +2,15 @@ package ciscoise
 
 import (
 	"context"
+	"crypto/tls"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,19 +19,42 @@ import (
 // Config is the configuration structure used to instantiate a
 // new Cisco Identity Services Engine client.
 type Config struct {
-	BaseURL        string
-	Username       string
-	Password       string
-	Debug          string
-	SSLVerify      string
-	UseAPIGateway  string
-	UseCSRFToken   string
-	RequestTimeout int
+	BaseURL               string
+	BaseURLs              []string
+	Username              string
+	Password              string
+	PasswordFile          string
+	CredentialsCommand    []string
+	Debug                 string
+	SSLVerify             string
+	UseAPIGateway         string
+	UseCSRFToken          string
+	RequestTimeout        int
+	MaxRetries            int
+	RetryMinWait          int
+	RetryMaxWait          int
+	MaxConcurrentRequests int
+	RequestsPerSecond     int
+	CAFile                string
+	CAPEM                 string
+	ClientCertPEM         string
+	ClientKeyPEM          string
+	TLSServerName         string
+	ProxyURL              string
+	NoProxy               string
+
+	limiter   *requestLimiter
+	failover  *failoverTransport
+	logCtx    context.Context
+	transport http.RoundTripper
 }
 
 type ClientConfig struct {
 	Client           *isegosdk.Client
+	Config           *Config
 	EnableAutoImport bool
+	AutoImportMode   string
+	ISEVersion       *ISEVersion
 }
 
 // NewClient returns a new Cisco Identity Services Engine client.
@@ -39,25 +67,186 @@ func (c *Config) NewClient() (*isegosdk.Client, error) {
 	if err != nil {
 		return client, err
 	}
-	client.RestyClient().SetLogger(createLogger())
-	if c.RequestTimeout > 0 {
-		client.RestyClient().SetTimeout(time.Duration(c.RequestTimeout) * time.Second)
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return client, err
 	}
+	hosts, err := baseURLHosts(c.BaseURLs)
+	if err != nil {
+		return client, err
+	}
+	c.limiter = newRequestLimiter(c.MaxConcurrentRequests, c.RequestsPerSecond)
+	err = c.configureRestyClient(client.RestyClient(), tlsConfig)
+	if err != nil {
+		return client, err
+	}
+	c.failover = failoverClient(client.RestyClient(), hosts)
+	credentialsClient(client.RestyClient(), c.CredentialsCommand, c.Username, c.Password)
+	c.transport = client.RestyClient().GetClient().Transport
 	return client, err
 }
 
+// NewOperationClient returns an SDK client bound to the context of a resource
+// operation. It shares the transport of the provider client, and so its TLS,
+// proxy, failover, credentials and limits settings, while its requests are
+// canceled with ctx and logged with the fields of ctx. The SDK client is
+// built from the settings of c, rather than the ones left in the environment
+// by the provider client.
+func (c *Config) NewOperationClient(ctx context.Context) (*isegosdk.Client, error) {
+	client, err := isegosdk.NewClientWithOptions(c.BaseURL,
+		c.Username, c.Password,
+		c.Debug, c.SSLVerify,
+		c.UseAPIGateway, c.UseCSRFToken,
+	)
+	if err != nil {
+		return client, err
+	}
+	restyClient := client.RestyClient()
+	restyClient.SetTransport(c.transport)
+	c.configureRestyRequests(restyClient)
+	restyClient.OnBeforeRequest(func(_ *resty.Client, request *resty.Request) error {
+		request.SetContext(ctx)
+		return nil
+	})
+	return client, nil
+}
+
+// Ports of the ISE API groups, used unless the API gateway serves them all.
+const (
+	ERS_API_PORT  = ":9060"
+	OPEN_API_PORT = ":443"
+)
+
+// APIURL returns the URL of path on the API group served at port. The
+// requests the provider sends itself use it, rather than the base URL of the
+// SDK client which is the one of the API group of the last SDK call.
+func (c *Config) APIURL(port string, path string) string {
+	if c.UseAPIGateway == "true" {
+		port = ""
+	}
+	return strings.TrimSuffix(c.BaseURL, "/") + port + path
+}
+
+// ActiveBaseURL returns the base URL of the admin node currently serving the
+// SDK requests, it changes when a node of base_urls fails over to the next.
+func (c *Config) ActiveBaseURL() string {
+	if c.failover == nil {
+		return c.BaseURL
+	}
+	activeHost := c.failover.ActiveHost()
+	for _, baseURL := range c.BaseURLs {
+		if u, err := url.Parse(baseURL); err == nil && u.Hostname() == activeHost {
+			return baseURL
+		}
+	}
+	return c.BaseURL
+}
+
+// NewNodeClient returns a resty client, independent of the SDK one, with the
+// same TLS, proxy, timeout, retry and limits settings. It is used to reach
+// deployment nodes directly, so tls_server_name is left to each node.
+func (c *Config) NewNodeClient() (*resty.Client, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ServerName = ""
+	client := resty.New()
+	client.SetHeader("User-Agent", isegosdk.USER_AGENT)
+	if c.Debug == "true" {
+		client.SetDebug(true)
+	}
+	if err := c.configureRestyClient(client, tlsConfig); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *Config) configureRestyClient(client *resty.Client, tlsConfig *tls.Config) error {
+	client.SetTLSClientConfig(tlsConfig)
+	proxy, err := c.proxyFunc()
+	if err != nil {
+		return err
+	}
+	if transport, ok := client.GetClient().Transport.(*http.Transport); ok {
+		transport.Proxy = proxy
+	}
+	c.configureRestyRequests(client)
+	// The logger and the limiter wrap the transport, so they go after the TLS
+	// and proxy settings
+	logClient(c.logCtx, client)
+	c.limiter.limitClient(client)
+	return nil
+}
+
+// configureRestyRequests applies the settings resty keeps on the client
+// rather than on its transport.
+func (c *Config) configureRestyRequests(client *resty.Client) {
+	restyLogger := createLogger()
+	client.SetLogger(restyLogger)
+	if c.RequestTimeout > 0 {
+		client.SetTimeout(time.Duration(c.RequestTimeout) * time.Second)
+	}
+	if c.MaxRetries > 0 {
+		client.
+			SetRetryCount(c.MaxRetries).
+			SetRetryWaitTime(time.Duration(c.RetryMinWait) * time.Second).
+			SetRetryMaxWaitTime(time.Duration(c.RetryMaxWait) * time.Second).
+			SetRetryAfter(retryAfter).
+			AddRetryCondition(retryCondition).
+			AddRetryHook(retryHook(restyLogger, c.MaxRetries))
+	}
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
+	redactLogOutput()
 
 	config := Config{
-		BaseURL:        d.Get("base_url").(string),
-		Username:       d.Get("username").(string),
-		Password:       d.Get("password").(string),
-		Debug:          d.Get("debug").(string),
-		SSLVerify:      d.Get("ssl_verify").(string),
-		UseAPIGateway:  d.Get("use_api_gateway").(string),
-		UseCSRFToken:   d.Get("use_csrf_token").(string),
-		RequestTimeout: d.Get("single_request_timeout").(int),
+		BaseURL:               d.Get("base_url").(string),
+		Username:              d.Get("username").(string),
+		Password:              d.Get("password").(string),
+		PasswordFile:          d.Get("password_file").(string),
+		CredentialsCommand:    interfaceToSliceString(d.Get("credentials_command")),
+		Debug:                 d.Get("debug").(string),
+		SSLVerify:             d.Get("ssl_verify").(string),
+		UseAPIGateway:         d.Get("use_api_gateway").(string),
+		UseCSRFToken:          d.Get("use_csrf_token").(string),
+		RequestTimeout:        d.Get("single_request_timeout").(int),
+		MaxRetries:            d.Get("max_retries").(int),
+		RetryMinWait:          d.Get("retry_min_wait").(int),
+		RetryMaxWait:          d.Get("retry_max_wait").(int),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		RequestsPerSecond:     d.Get("requests_per_second").(int),
+		CAFile:                d.Get("ca_file").(string),
+		CAPEM:                 d.Get("ca_pem").(string),
+		ClientCertPEM:         d.Get("client_cert_pem").(string),
+		ClientKeyPEM:          d.Get("client_key_pem").(string),
+		TLSServerName:         d.Get("tls_server_name").(string),
+		ProxyURL:              d.Get("proxy_url").(string),
+		NoProxy:               d.Get("no_proxy").(string),
+		logCtx:                ctx,
+	}
+	if v, ok := d.GetOk("base_urls"); ok {
+		config.BaseURLs = interfaceToSliceString(v)
+		config.BaseURL = config.BaseURLs[0]
+	}
+	if config.RetryMaxWait < config.RetryMinWait {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid provider retry configuration",
+			Detail:   "retry_max_wait cannot be lower than retry_min_wait",
+		})
+		return nil, diags
+	}
+
+	if err := config.loadCredentials(ctx); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to load Cisco Identity Services Engine credentials",
+			Detail:   err.Error(),
+		})
+		return nil, diags
 	}
 
 	client, err := config.NewClient()
@@ -69,6 +258,14 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		})
 		return nil, diags
 	}
+	// The version is only used to check the resources requirements, so a
+	// deployment without the OpenAPI enabled is still usable
+	iseVersion, err := getISEVersion(ctx, client)
+	if err != nil {
+		logWarnf(ctx, "Unable to detect the Identity Services Engine version: %v", err)
+	} else {
+		logDebugf(ctx, "Connected to Identity Services Engine %s", iseVersion)
+	}
 	boolValue, err := strconv.ParseBool(d.Get("enable_auto_import").(string))
 
 	if err != nil {
@@ -77,7 +274,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	clientConfig := ClientConfig{
 		Client:           client,
+		Config:           &config,
 		EnableAutoImport: boolValue,
+		AutoImportMode:   d.Get("auto_import_mode").(string),
+		ISEVersion:       iseVersion,
 	}
 	return clientConfig, diags
 }
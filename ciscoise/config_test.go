@@ -0,0 +1,71 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestConfigNewNodeClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	cases := map[string]struct {
+		Config      Config
+		ExpectError bool
+	}{
+		"verified with ca pem": {
+			Config: Config{SSLVerify: "true", CAPEM: caPEM, TLSServerName: "ise.example.com"},
+		},
+		"not verified": {
+			Config: Config{SSLVerify: "false"},
+		},
+		"verified without ca pem": {
+			Config:      Config{SSLVerify: "true"},
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		client, err := tc.Config.NewNodeClient()
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error %v", tn, err)
+		}
+		_, err = client.R().Get(server.URL)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expect error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestConfigAPIURL(t *testing.T) {
+	config := Config{BaseURL: "https://ise.example.com/", UseAPIGateway: "false"}
+	if got := config.APIURL(ERS_API_PORT, "/ers/config/networkdevice/bulk/submit"); got != "https://ise.example.com:9060/ers/config/networkdevice/bulk/submit" {
+		t.Errorf("bad: unexpected ERS URL %s", got)
+	}
+	config.UseAPIGateway = "true"
+	if got := config.APIURL(ERS_API_PORT, "/ers/config/networkdevice/bulk/submit"); got != "https://ise.example.com/ers/config/networkdevice/bulk/submit" {
+		t.Errorf("bad: unexpected API gateway URL %s", got)
+	}
+}
+
+func TestConfigNewOperationClient(t *testing.T) {
+	clientConfig := testClientConfig(t, context.Background(), "https://ise.example.com")
+	// The provider client leaves its settings in the environment
+	for _, env := range []string{"ISE_BASE_URL", "ISE_USERNAME", "ISE_PASSWORD"} {
+		os.Unsetenv(env)
+	}
+	client, err := clientConfig.Config.NewOperationClient(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	userInfo := client.RestyClient().UserInfo
+	if userInfo == nil || userInfo.Username != "admin" || userInfo.Password != "C1sco12345" {
+		t.Errorf("bad: expect the credentials of the configuration, got %+v", userInfo)
+	}
+}
@@ -138,3 +138,16 @@ func interfaceToFloat64Ptr(item interface{}) *float64 {
 	}
 	return &nnItem
 }
+
+// responseToJSONTypes converts an SDK response to target, through the JSON of
+// the ISE API, for types shared by several SDK responses.
+func responseToJSONTypes(response interface{}, target interface{}) error {
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(responseJSON, target); err != nil {
+		return fmt.Errorf("unexpected response %s: %s", responseJSON, err)
+	}
+	return nil
+}
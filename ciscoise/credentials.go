@@ -0,0 +1,168 @@
+package ciscoise
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const credentialsCommandTimeout = time.Duration(1) * time.Minute
+
+// commandCredentials is the JSON document printed by credentials_command.
+type commandCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password"`
+}
+
+// loadCredentials resolves password_file and credentials_command into the
+// username and password used by the client, the command wins over the file.
+func (c *Config) loadCredentials(ctx context.Context) error {
+	if c.PasswordFile != "" {
+		password, err := readPasswordFile(c.PasswordFile)
+		if err != nil {
+			return err
+		}
+		c.Password = password
+	}
+	if len(c.CredentialsCommand) > 0 {
+		credentials, err := runCredentialsCommand(ctx, c.CredentialsCommand)
+		if err != nil {
+			return err
+		}
+		if credentials.Username != "" {
+			c.Username = credentials.Username
+		}
+		c.Password = credentials.Password
+	}
+	return nil
+}
+
+func readPasswordFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read password_file: %v", err)
+	}
+	password := strings.TrimRight(string(data), "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("password_file %s is empty", path)
+	}
+	return password, nil
+}
+
+func runCredentialsCommand(ctx context.Context, command []string) (*commandCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialsCommandTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credentials_command %s failed: %v\n%s", command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	credentials := commandCredentials{}
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return nil, fmt.Errorf("credentials_command %s must print a JSON object with username and password: %v", command[0], err)
+	}
+	if credentials.Password == "" {
+		return nil, fmt.Errorf("credentials_command %s did not return a password", command[0])
+	}
+	return &credentials, nil
+}
+
+// credentialsTransport sends the credentials returned by credentials_command
+// and runs the command again when ISE rejects them, which happens when the
+// password is rotated during a run. The rejected request is replayed once.
+type credentialsTransport struct {
+	transport http.RoundTripper
+	command   []string
+
+	mutex    sync.Mutex
+	username string
+	password string
+}
+
+func credentialsClient(client *resty.Client, command []string, username string, password string) {
+	if len(command) == 0 {
+		return
+	}
+	transport := client.GetClient().Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.SetTransport(&credentialsTransport{
+		transport: transport,
+		command:   command,
+		username:  username,
+		password:  password,
+	})
+}
+
+func (t *credentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	username, password := t.current()
+	attempt, err := requestWithCredentials(req, username, password, false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(attempt)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	newUsername, newPassword, errRefresh := t.refresh(req.Context(), password)
+	if errRefresh != nil {
+		logWarnf(req.Context(), "Unable to refresh the credentials: %v", errRefresh)
+		return resp, err
+	}
+	if newPassword == password {
+		return resp, err
+	}
+	retry, errRetry := requestWithCredentials(req, newUsername, newPassword, true)
+	if errRetry != nil {
+		return resp, err
+	}
+	resp.Body.Close()
+	return t.transport.RoundTrip(retry)
+}
+
+func requestWithCredentials(req *http.Request, username string, password string, replay bool) (*http.Request, error) {
+	attempt, err := cloneRequest(req, replay)
+	if err != nil {
+		return nil, err
+	}
+	attempt.SetBasicAuth(username, password)
+	return attempt, nil
+}
+
+func (t *credentialsTransport) current() (string, string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.username, t.password
+}
+
+// refresh runs the command unless another request already refreshed the
+// credentials since usedPassword was sent.
+func (t *credentialsTransport) refresh(ctx context.Context, usedPassword string) (string, string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.password != usedPassword {
+		return t.username, t.password, nil
+	}
+	logDebugf(ctx, "Identity Services Engine returned 401, running credentials_command again")
+	credentials, err := runCredentialsCommand(ctx, t.command)
+	if err != nil {
+		return "", "", err
+	}
+	if credentials.Username != "" {
+		t.username = credentials.Username
+	}
+	t.password = credentials.Password
+	return t.username, t.password, nil
+}
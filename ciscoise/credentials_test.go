@@ -0,0 +1,135 @@
+package ciscoise
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadPasswordFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(path, []byte("C1sco12345\n"), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	password, err := readPasswordFile(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if password != "C1sco12345" {
+		t.Errorf("bad: expect the trailing newline to be removed, got %q", password)
+	}
+	if _, err := readPasswordFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("bad: expect an error for a missing file")
+	}
+}
+
+func testCredentialsCommand(t *testing.T, output string) []string {
+	if _, err := exec.LookPath("cat"); err != nil {
+		t.Skip("cat is not available")
+	}
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(output), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return []string{"cat", path}
+}
+
+func TestConfigLoadCredentials(t *testing.T) {
+	cases := map[string]struct {
+		Output         string
+		ExpectUsername string
+		ExpectPassword string
+		ExpectError    bool
+	}{
+		"username and password": {
+			Output:         `{"username": "ersadmin", "password": "rotated"}`,
+			ExpectUsername: "ersadmin",
+			ExpectPassword: "rotated",
+		},
+		"password only": {
+			Output:         `{"password": "rotated"}`,
+			ExpectUsername: "admin",
+			ExpectPassword: "rotated",
+		},
+		"missing password": {
+			Output:      `{"username": "ersadmin"}`,
+			ExpectError: true,
+		},
+		"not json": {
+			Output:      "rotated",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		config := Config{Username: "admin", Password: "initial", CredentialsCommand: testCredentialsCommand(t, tc.Output)}
+		err := config.loadCredentials(context.Background())
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expect error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if err == nil && (config.Username != tc.ExpectUsername || config.Password != tc.ExpectPassword) {
+			t.Errorf("bad: %s, expect %s/%s, got %s/%s", tn, tc.ExpectUsername, tc.ExpectPassword, config.Username, config.Password)
+		}
+	}
+}
+
+type stubAuthTransport struct {
+	password string
+	calls    int
+}
+
+func (t *stubAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	_, password, _ := req.BasicAuth()
+	if password != t.password {
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+}
+
+func TestCredentialsTransportRotation(t *testing.T) {
+	stub := &stubAuthTransport{password: "rotated"}
+	transport := &credentialsTransport{
+		transport: stub,
+		command:   testCredentialsCommand(t, `{"password": "rotated"}`),
+		username:  "admin",
+		password:  "initial",
+	}
+	req, _ := http.NewRequest(http.MethodPost, "https://ise.example.com:9060/ers/config/networkdevice", strings.NewReader("{}"))
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("bad: expect the request to be replayed with the rotated password, got %d", resp.StatusCode)
+	}
+	if stub.calls != 2 {
+		t.Errorf("bad: expect 2 calls, got %d", stub.calls)
+	}
+	if _, password := transport.current(); password != "rotated" {
+		t.Errorf("bad: expect the rotated password to be kept, got %s", password)
+	}
+}
+
+func TestCredentialsTransportWrongPassword(t *testing.T) {
+	stub := &stubAuthTransport{password: "other"}
+	transport := &credentialsTransport{
+		transport: stub,
+		command:   testCredentialsCommand(t, `{"password": "initial"}`),
+		username:  "admin",
+		password:  "initial",
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://ise.example.com:9060/ers/config/networkdevice", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized || stub.calls != 1 {
+		t.Errorf("bad: expect the 401 to be returned without replay, got %d after %d calls", resp.StatusCode, stub.calls)
+	}
+}
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -129,7 +127,7 @@ func dataSourceAciBindingsRead(ctx context.Context, d *schema.ResourceData, m in
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAciBindings")
+		logDebugf(ctx, "Selected method: GetAciBindings")
 		queryParams1 := isegosdk.GetAciBindingsQueryParams{}
 
 		if okPage {
@@ -155,7 +153,7 @@ func dataSourceAciBindingsRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAciBindings", err,
@@ -163,7 +161,7 @@ func dataSourceAciBindingsRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAciBindingsGetAciBindingsItem(response1.AciBindings)
 		if err := d.Set("item", vItem1); err != nil {
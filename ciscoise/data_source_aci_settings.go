@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -147,13 +145,13 @@ func dataSourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m in
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAciSettings")
+		logDebugf(ctx, "Selected method: GetAciSettings")
 
 		response1, restyResp1, err := client.AciSettings.GetAciSettings()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAciSettings", err,
@@ -161,7 +159,7 @@ func dataSourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAciSettingsGetAciSettingsItem(response1.AciSettings)
 		if err := d.Set("item", vItem1); err != nil {
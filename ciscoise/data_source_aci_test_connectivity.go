@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,13 +44,13 @@ func dataSourceAciTestConnectivityRead(ctx context.Context, d *schema.ResourceDa
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: TestAciConnectivity")
+		logDebugf(ctx, "Selected method: TestAciConnectivity")
 
 		response1, restyResp1, err := client.AciSettings.TestAciConnectivity()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing TestAciConnectivity", err,
@@ -60,7 +58,7 @@ func dataSourceAciTestConnectivityRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAciSettingsTestAciConnectivityItem(response1.AciTestConnectionResult)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -56,13 +54,13 @@ func dataSourceActiveDirectoriesInfoRead(ctx context.Context, d *schema.Resource
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectories")
+		logDebugf(ctx, "Selected method: GetActiveDirectories")
 
 		response1, restyResp1, err := client.ActiveDirectories.GetActiveDirectories()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetActiveDirectories", err,
@@ -70,7 +68,7 @@ func dataSourceActiveDirectoriesInfoRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenActiveDirectoriesGetActiveDirectoriesItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -699,15 +697,15 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectory")
+		logDebugf(ctx, "Selected method: GetActiveDirectory")
 		queryParams1 := isegosdk.GetActiveDirectoryQueryParams{}
 
 		if okPage {
@@ -721,7 +719,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectory", err,
@@ -729,7 +727,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseActiveDirectoryGetActiveDirectorySearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -764,14 +762,14 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectoryByName")
+		logDebugf(ctx, "Selected method: GetActiveDirectoryByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.ActiveDirectory.GetActiveDirectoryByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectoryByName", err,
@@ -779,7 +777,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenActiveDirectoryGetActiveDirectoryByNameItemName(response2.ERSActiveDirectory)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -793,14 +791,14 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectoryByID")
+		logDebugf(ctx, "Selected method: GetActiveDirectoryByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.ActiveDirectory.GetActiveDirectoryByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetActiveDirectoryByID", err,
@@ -808,7 +806,7 @@ func dataSourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenActiveDirectoryGetActiveDirectoryByIDItemID(response3.ERSActiveDirectory)
 		if err := d.Set("item_id", vItemID3); err != nil {
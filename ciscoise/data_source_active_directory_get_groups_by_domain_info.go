@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -89,19 +87,19 @@ func dataSourceActiveDirectoryGetGroupsByDomainInfoRead(ctx context.Context, d *
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetGroupsByDomain")
+		logDebugf(ctx, "Selected method: GetGroupsByDomain")
 		vvID := vID.(string)
 		request1 := expandRequestActiveDirectoryGetGroupsByDomainInfoGetGroupsByDomain(ctx, "", d)
 
 		response1, restyResp1, err := client.ActiveDirectory.GetGroupsByDomain(vvID, request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetGroupsByDomain", err,
@@ -109,7 +107,7 @@ func dataSourceActiveDirectoryGetGroupsByDomainInfoRead(ctx context.Context, d *
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenActiveDirectoryGetGroupsByDomainItem(response1.ERSActiveDirectoryGroups)
 		if err := d.Set("item", vItem1); err != nil {
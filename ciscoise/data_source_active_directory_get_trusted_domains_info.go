@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -70,14 +68,14 @@ func dataSourceActiveDirectoryGetTrustedDomainsInfoRead(ctx context.Context, d *
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTrustedDomains")
+		logDebugf(ctx, "Selected method: GetTrustedDomains")
 		vvID := vID.(string)
 
 		response1, restyResp1, err := client.ActiveDirectory.GetTrustedDomains(vvID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTrustedDomains", err,
@@ -85,7 +83,7 @@ func dataSourceActiveDirectoryGetTrustedDomainsInfoRead(ctx context.Context, d *
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenActiveDirectoryGetTrustedDomainsItem(response1.ERSActiveDirectoryDomains)
 		if err := d.Set("item", vItem1); err != nil {
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -92,19 +90,19 @@ func dataSourceActiveDirectoryGetUserGroupsInfoRead(ctx context.Context, d *sche
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetUserGroups")
+		logDebugf(ctx, "Selected method: GetUserGroups")
 		vvID := vID.(string)
 		request1 := expandRequestActiveDirectoryGetUserGroupsInfoGetUserGroups(ctx, "", d)
 
 		response1, restyResp1, err := client.ActiveDirectory.GetUserGroups(vvID, request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetUserGroups", err,
@@ -112,7 +110,7 @@ func dataSourceActiveDirectoryGetUserGroupsInfoRead(ctx context.Context, d *sche
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenActiveDirectoryGetUserGroupsItem(response1.ERSActiveDirectoryGroups)
 		if err := d.Set("item", vItem1); err != nil {
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -92,19 +90,19 @@ func dataSourceActiveDirectoryIsUserMemberOfGroupRead(ctx context.Context, d *sc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: IsUserMemberOfGroups")
+		logDebugf(ctx, "Selected method: IsUserMemberOfGroups")
 		vvID := vID.(string)
 		request1 := expandRequestActiveDirectoryIsUserMemberOfGroupIsUserMemberOfGroups(ctx, "", d)
 
 		response1, restyResp1, err := client.ActiveDirectory.IsUserMemberOfGroups(vvID, request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing IsUserMemberOfGroups", err,
@@ -112,7 +110,7 @@ func dataSourceActiveDirectoryIsUserMemberOfGroupRead(ctx context.Context, d *sc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenActiveDirectoryIsUserMemberOfGroupsItem(response1.ERSActiveDirectoryGroups)
 		if err := d.Set("item", vItem1); err != nil {
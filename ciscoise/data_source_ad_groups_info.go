@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -57,14 +55,14 @@ func dataSourceAdGroupsInfoRead(ctx context.Context, d *schema.ResourceData, m i
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAdgroups")
+		logDebugf(ctx, "Selected method: GetAdgroups")
 		vvActiveDirectory := vActiveDirectory.(string)
 
 		response1, restyResp1, err := client.ADGroups.GetAdgroups(vvActiveDirectory)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetAdgroups", err,
@@ -72,7 +70,7 @@ func dataSourceAdGroupsInfoRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenADGroupsGetAdgroupsItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -239,13 +237,13 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAdminUsers")
+		logDebugf(ctx, "Selected method: GetAdminUsers")
 		queryParams1 := isegosdk.GetAdminUsersQueryParams{}
 
 		if okPage {
@@ -271,7 +269,7 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAdminUsers", err,
@@ -279,7 +277,7 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseAdminUserGetAdminUsersSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -314,14 +312,14 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAdminUserByID")
+		logDebugf(ctx, "Selected method: GetAdminUserByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.AdminUser.GetAdminUserByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAdminUserByID", err,
@@ -329,7 +327,7 @@ func dataSourceAdminUserRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenAdminUserGetAdminUserByIDItem(response2.AdminUser)
 		if err := d.Set("item", vItem2); err != nil {
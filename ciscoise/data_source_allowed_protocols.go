@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -1039,15 +1037,15 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAllowedProtocols")
+		logDebugf(ctx, "Selected method: GetAllowedProtocols")
 		queryParams1 := isegosdk.GetAllowedProtocolsQueryParams{}
 
 		if okPage {
@@ -1061,7 +1059,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocols", err,
@@ -1069,7 +1067,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseAllowedProtocolsGetAllowedProtocolsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -1104,14 +1102,14 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAllowedProtocolByName")
+		logDebugf(ctx, "Selected method: GetAllowedProtocolByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.AllowedProtocols.GetAllowedProtocolByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocolByName", err,
@@ -1119,7 +1117,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenAllowedProtocolsGetAllowedProtocolByNameItemName(response2.AllowedProtocols)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -1133,14 +1131,14 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetAllowedProtocolByID")
+		logDebugf(ctx, "Selected method: GetAllowedProtocolByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.AllowedProtocols.GetAllowedProtocolByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllowedProtocolByID", err,
@@ -1148,7 +1146,7 @@ func dataSourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenAllowedProtocolsGetAllowedProtocolByIDItemID(response3.AllowedProtocols)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -193,13 +191,13 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAncEndpoint")
+		logDebugf(ctx, "Selected method: GetAncEndpoint")
 		queryParams1 := isegosdk.GetAncEndpointQueryParams{}
 
 		if okPage {
@@ -225,7 +223,7 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncEndpoint", err,
@@ -233,7 +231,7 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseAncEndpointGetAncEndpointSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -268,14 +266,14 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAncEndpointByID")
+		logDebugf(ctx, "Selected method: GetAncEndpointByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.AncEndpoint.GetAncEndpointByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAncEndpointByID", err,
@@ -283,7 +281,7 @@ func dataSourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenAncEndpointGetAncEndpointByIDItem(response2.ErsAncEndpoint)
 		if err := d.Set("item", vItem2); err != nil {
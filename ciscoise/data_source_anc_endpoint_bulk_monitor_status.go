@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -104,14 +102,14 @@ func dataSourceAncEndpointBulkMonitorStatusRead(ctx context.Context, d *schema.R
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: MonitorBulkStatusAncEndpoint")
+		logDebugf(ctx, "Selected method: MonitorBulkStatusAncEndpoint")
 		vvBulkid := vBulkid.(string)
 
 		response1, restyResp1, err := client.AncEndpoint.MonitorBulkStatusAncEndpoint(vvBulkid)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusAncEndpoint", err,
@@ -119,7 +117,7 @@ func dataSourceAncEndpointBulkMonitorStatusRead(ctx context.Context, d *schema.R
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAncEndpointMonitorBulkStatusAncEndpointItem(response1.BulkStatus)
 		if err := d.Set("item", vItem1); err != nil {
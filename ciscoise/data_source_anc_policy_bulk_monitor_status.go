@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -104,14 +102,14 @@ func dataSourceAncPolicyBulkMonitorStatusRead(ctx context.Context, d *schema.Res
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: MonitorBulkStatusAncPolicy")
+		logDebugf(ctx, "Selected method: MonitorBulkStatusAncPolicy")
 		vvBulkid := vBulkid.(string)
 
 		response1, restyResp1, err := client.AncPolicy.MonitorBulkStatusAncPolicy(vvBulkid)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusAncPolicy", err,
@@ -119,7 +117,7 @@ func dataSourceAncPolicyBulkMonitorStatusRead(ctx context.Context, d *schema.Res
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAncPolicyMonitorBulkStatusAncPolicyItem(response1.BulkStatus)
 		if err := d.Set("item", vItem1); err != nil {
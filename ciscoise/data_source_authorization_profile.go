@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -651,15 +649,15 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAuthorizationProfiles")
+		logDebugf(ctx, "Selected method: GetAuthorizationProfiles")
 		queryParams1 := isegosdk.GetAuthorizationProfilesQueryParams{}
 
 		if okPage {
@@ -673,7 +671,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfiles", err,
@@ -681,7 +679,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseAuthorizationProfileGetAuthorizationProfilesSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -716,14 +714,14 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAuthorizationProfileByName")
+		logDebugf(ctx, "Selected method: GetAuthorizationProfileByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.AuthorizationProfile.GetAuthorizationProfileByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfileByName", err,
@@ -731,7 +729,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenAuthorizationProfileGetAuthorizationProfileByNameItemName(response2.AuthorizationProfile)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -745,14 +743,14 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetAuthorizationProfileByID")
+		logDebugf(ctx, "Selected method: GetAuthorizationProfileByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.AuthorizationProfile.GetAuthorizationProfileByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAuthorizationProfileByID", err,
@@ -760,7 +758,7 @@ func dataSourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenAuthorizationProfileGetAuthorizationProfileByIDItemID(response3.AuthorizationProfile)
 		if err := d.Set("item_id", vItemID3); err != nil {
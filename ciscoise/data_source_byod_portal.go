@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -614,13 +612,13 @@ func dataSourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m int
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetByodPortal")
+		logDebugf(ctx, "Selected method: GetByodPortal")
 		queryParams1 := isegosdk.GetByodPortalQueryParams{}
 
 		if okPage {
@@ -646,7 +644,7 @@ func dataSourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetByodPortal", err,
@@ -654,7 +652,7 @@ func dataSourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseByodPortalGetByodPortalSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -689,14 +687,14 @@ func dataSourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetByodPortalByID")
+		logDebugf(ctx, "Selected method: GetByodPortalByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.ByodPortal.GetByodPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetByodPortalByID", err,
@@ -704,7 +702,7 @@ func dataSourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenByodPortalGetByodPortalByIDItem(response2.ByodPortal)
 		if err := d.Set("item", vItem2); err != nil {
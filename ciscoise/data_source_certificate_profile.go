@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -225,15 +223,15 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetCertificateProfile")
+		logDebugf(ctx, "Selected method: GetCertificateProfile")
 		queryParams1 := isegosdk.GetCertificateProfileQueryParams{}
 
 		if okPage {
@@ -247,7 +245,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfile", err,
@@ -255,7 +253,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseCertificateProfileGetCertificateProfileSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -290,14 +288,14 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetCertificateProfileByName")
+		logDebugf(ctx, "Selected method: GetCertificateProfileByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.CertificateProfile.GetCertificateProfileByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfileByName", err,
@@ -305,7 +303,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenCertificateProfileGetCertificateProfileByNameItemName(response2.CertificateProfile)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -319,14 +317,14 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetCertificateProfileByID")
+		logDebugf(ctx, "Selected method: GetCertificateProfileByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.CertificateProfile.GetCertificateProfileByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateProfileByID", err,
@@ -334,7 +332,7 @@ func dataSourceCertificateProfileRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenCertificateProfileGetCertificateProfileByIDItemID(response3.CertificateProfile)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -173,15 +171,15 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetCertificateTemplate")
+		logDebugf(ctx, "Selected method: GetCertificateTemplate")
 		queryParams1 := isegosdk.GetCertificateTemplateQueryParams{}
 
 		if okPage {
@@ -195,7 +193,7 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateTemplate", err,
@@ -203,7 +201,7 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseCertificateTemplateGetCertificateTemplateSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -238,14 +236,14 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetCertificateTemplateByName")
+		logDebugf(ctx, "Selected method: GetCertificateTemplateByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.CertificateTemplate.GetCertificateTemplateByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateTemplateByName", err,
@@ -253,7 +251,7 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenCertificateTemplateGetCertificateTemplateByNameItemName(response2.ERSCertificateTemplate)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -267,14 +265,14 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetCertificateTemplateByID")
+		logDebugf(ctx, "Selected method: GetCertificateTemplateByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.CertificateTemplate.GetCertificateTemplateByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCertificateTemplateByID", err,
@@ -282,7 +280,7 @@ func dataSourceCertificateTemplateRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenCertificateTemplateGetCertificateTemplateByIDItemID(response3.ERSCertificateTemplate)
 		if err := d.Set("item_id", vItemID3); err != nil {
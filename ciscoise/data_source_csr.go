@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -339,13 +337,13 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName, okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetCsrs")
+		logDebugf(ctx, "Selected method: GetCsrs")
 		queryParams1 := isegosdk.GetCsrsQueryParams{}
 
 		if okPage {
@@ -371,7 +369,7 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCsrs", err,
@@ -379,7 +377,7 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseCertificatesGetCsrsResponse
 		for response1.Response != nil && len(*response1.Response) > 0 {
@@ -414,7 +412,7 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetCsrByID")
+		logDebugf(ctx, "Selected method: GetCsrByID")
 		vvHostName := vHostName.(string)
 		vvID := vID.(string)
 
@@ -422,7 +420,7 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetCsrByID", err,
@@ -430,7 +428,7 @@ func dataSourceCsrRead(ctx context.Context, d *schema.ResourceData, m interface{
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenCertificatesGetCsrByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
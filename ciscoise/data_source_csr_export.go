@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -47,7 +45,7 @@ func dataSourceCsrExportRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: ExportCsr")
+		logDebugf(ctx, "Selected method: ExportCsr")
 		vvHostname := vHostname.(string)
 		vvID := vID.(string)
 
@@ -59,7 +57,7 @@ func dataSourceCsrExportRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response")
+		logDebugf(ctx, "Retrieved response")
 
 		vvDirpath := d.Get("dirpath").(string)
 		err = response1.SaveDownload(vvDirpath)
@@ -68,7 +66,7 @@ func dataSourceCsrExportRead(ctx context.Context, d *schema.ResourceData, m inte
 				"Failure when downloading file", err))
 			return diags
 		}
-		log.Printf("[DEBUG] Downloaded file %s", vvDirpath)
+		logDebugf(ctx, "Downloaded file %s", vvDirpath)
 
 	}
 	return diags
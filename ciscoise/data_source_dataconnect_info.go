@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,13 +58,13 @@ func dataSourceDataconnectInfoRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetOdbcDetail")
+		logDebugf(ctx, "Selected method: GetOdbcDetail")
 
 		response1, restyResp1, err := client.DataconnectServices.GetOdbcDetail()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetOdbcDetail", err,
@@ -74,7 +72,7 @@ func dataSourceDataconnectInfoRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenDataconnectServicesGetOdbcDetailItemResponse(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -460,13 +458,13 @@ func dataSourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m int
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeploymentInfo")
+		logDebugf(ctx, "Selected method: GetDeploymentInfo")
 
 		response1, restyResp1, err := client.PullDeploymentInfo.GetDeploymentInfo()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeploymentInfo", err,
@@ -474,7 +472,7 @@ func dataSourceDeploymentRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPullDeploymentInfoGetDeploymentInfoItem(response1.ERSDeploymentInfo)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -665,20 +663,20 @@ func dataSourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context,
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okPolicyID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthenticationRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthenticationRules")
 		vvPolicyID := vPolicyID.(string)
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminAuthenticationRules", err,
@@ -686,7 +684,7 @@ func dataSourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRulesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -700,7 +698,7 @@ func dataSourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context,
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthenticationRuleByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthenticationRuleByID")
 		vvPolicyID := vPolicyID.(string)
 		vvID := vID.(string)
 
@@ -708,7 +706,7 @@ func dataSourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context,
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminAuthenticationRuleByID", err,
@@ -716,7 +714,7 @@ func dataSourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRuleByIDItem(response2.Response, vvPolicyID)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -637,20 +635,20 @@ func dataSourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okPolicyID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthorizationRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthorizationRules")
 		vvPolicyID := vPolicyID.(string)
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminAuthorizationRules", err,
@@ -658,7 +656,7 @@ func dataSourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRulesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -672,7 +670,7 @@ func dataSourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthorizationRuleByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthorizationRuleByID")
 		vvPolicyID := vPolicyID.(string)
 		vvID := vID.(string)
 
@@ -680,7 +678,7 @@ func dataSourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminAuthorizationRuleByID", err,
@@ -688,7 +686,7 @@ func dataSourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -686,21 +684,21 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminConditions")
+		logDebugf(ctx, "Selected method: GetDeviceAdminConditions")
 
 		response1, restyResp1, err := client.DeviceAdministrationConditions.GetDeviceAdminConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminConditions", err,
@@ -708,7 +706,7 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationConditionsGetDeviceAdminConditionsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -722,14 +720,14 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminConditionByName")
+		logDebugf(ctx, "Selected method: GetDeviceAdminConditionByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminConditionByName", err,
@@ -737,7 +735,7 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenDeviceAdministrationConditionsGetDeviceAdminConditionByNameItemName(response2.Response)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -751,14 +749,14 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminConditionByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminConditionByID", err,
@@ -766,7 +764,7 @@ func dataSourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenDeviceAdministrationConditionsGetDeviceAdminConditionByIDItemID(response3.Response)
 		if err := d.Set("item_id", vItemID3); err != nil {
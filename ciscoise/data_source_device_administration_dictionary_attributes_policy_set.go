@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -99,13 +97,13 @@ func dataSourceDeviceAdministrationDictionaryAttributesPolicySetRead(ctx context
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminDictionariesPolicySet")
+		logDebugf(ctx, "Selected method: GetDeviceAdminDictionariesPolicySet")
 
 		response1, restyResp1, err := client.DeviceAdministrationDictionaryAttributesList.GetDeviceAdminDictionariesPolicySet()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminDictionariesPolicySet", err,
@@ -113,7 +111,7 @@ func dataSourceDeviceAdministrationDictionaryAttributesPolicySetRead(ctx context
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationDictionaryAttributesListGetDeviceAdminDictionariesPolicySetItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
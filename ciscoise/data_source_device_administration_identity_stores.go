@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,13 +48,13 @@ func dataSourceDeviceAdministrationIDentityStoresRead(ctx context.Context, d *sc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminIDentityStores")
+		logDebugf(ctx, "Selected method: GetDeviceAdminIDentityStores")
 
 		response1, restyResp1, err := client.DeviceAdministrationIDentityStores.GetDeviceAdminIDentityStores()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminIDentityStores", err,
@@ -64,7 +62,7 @@ func dataSourceDeviceAdministrationIDentityStoresRead(ctx context.Context, d *sc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationIDentityStoresGetDeviceAdminIDentityStoresItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -215,19 +213,19 @@ func dataSourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminNetworkConditions")
+		logDebugf(ctx, "Selected method: GetDeviceAdminNetworkConditions")
 
 		response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminNetworkConditions", err,
@@ -235,7 +233,7 @@ func dataSourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditionsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -249,14 +247,14 @@ func dataSourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminNetworkConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminNetworkConditionByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminNetworkConditionByID", err,
@@ -264,7 +262,7 @@ func dataSourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
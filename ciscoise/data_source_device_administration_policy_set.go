@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -615,19 +613,19 @@ func dataSourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySets")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySets")
 
 		response1, restyResp1, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySets()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminPolicySets", err,
@@ -635,7 +633,7 @@ func dataSourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationPolicySetGetDeviceAdminPolicySetsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -649,14 +647,14 @@ func dataSourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySetByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySetByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySetByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminPolicySetByID", err,
@@ -664,7 +662,7 @@ func dataSourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationPolicySetGetDeviceAdminPolicySetByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,13 +48,13 @@ func dataSourceDeviceAdministrationProfilesRead(ctx context.Context, d *schema.R
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminProfiles")
+		logDebugf(ctx, "Selected method: GetDeviceAdminProfiles")
 
 		response1, restyResp1, err := client.DeviceAdministrationProfiles.GetDeviceAdminProfiles()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminProfiles", err,
@@ -64,7 +62,7 @@ func dataSourceDeviceAdministrationProfilesRead(ctx context.Context, d *schema.R
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationProfilesGetDeviceAdminProfilesItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,13 +58,13 @@ func dataSourceDeviceAdministrationServiceNamesRead(ctx context.Context, d *sche
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminServiceNames")
+		logDebugf(ctx, "Selected method: GetDeviceAdminServiceNames")
 
 		response1, restyResp1, err := client.DeviceAdministrationServiceNames.GetDeviceAdminServiceNames()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminServiceNames", err,
@@ -74,7 +72,7 @@ func dataSourceDeviceAdministrationServiceNamesRead(ctx context.Context, d *sche
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationServiceNamesGetDeviceAdminServiceNamesItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -465,19 +463,19 @@ func dataSourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminTimeConditions")
+		logDebugf(ctx, "Selected method: GetDeviceAdminTimeConditions")
 
 		response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminTimeConditions", err,
@@ -485,7 +483,7 @@ func dataSourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditionsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -499,14 +497,14 @@ func dataSourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminTimeConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminTimeConditionByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeviceAdminTimeConditionByID", err,
@@ -514,7 +512,7 @@ func dataSourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
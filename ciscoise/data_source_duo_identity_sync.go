@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -178,19 +176,19 @@ func dataSourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData,
 	vSyncName, okSyncName := d.GetOk("sync_name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSyncName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentitysync")
+		logDebugf(ctx, "Selected method: GetIDentitysync")
 
 		response1, restyResp1, err := client.DuoIDentitySync.GetIDentitysync()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetIDentitysync", err,
@@ -198,7 +196,7 @@ func dataSourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDuoIDentitySyncGetIDentitysyncItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -213,14 +211,14 @@ func dataSourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentitysyncBySyncName")
+		logDebugf(ctx, "Selected method: GetIDentitysyncBySyncName")
 		vvSyncName := vSyncName.(string)
 
 		response2, restyResp2, err := client.DuoIDentitySync.GetIDentitysyncBySyncName(vvSyncName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetIDentitysyncBySyncName", err,
@@ -228,7 +226,7 @@ func dataSourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDuoIDentitySyncGetIDentitysyncBySyncNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,7 +37,7 @@ func dataSourceDuoIDentitysyncSyncInfoRead(ctx context.Context, d *schema.Resour
 	var diags diag.Diagnostics
 	vSyncName := d.Get("sync_name")
 
-	log.Printf("[DEBUG] Selected method: Sync")
+	logDebugf(ctx, "Selected method: Sync")
 	vvSyncName := vSyncName.(string)
 
 	response1, err := client.DuoIDentitySync.Sync(vvSyncName)
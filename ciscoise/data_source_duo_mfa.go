@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -137,19 +135,19 @@ func dataSourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interfa
 	vConnectionName, okConnectionName := d.GetOk("connection_name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectionName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetMfa")
+		logDebugf(ctx, "Selected method: GetMfa")
 
 		response1, restyResp1, err := client.DuoMfa.GetMfa()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetMfa", err,
@@ -157,7 +155,7 @@ func dataSourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenDuoMfaGetMfaItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -172,14 +170,14 @@ func dataSourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetMfaByconnectionName")
+		logDebugf(ctx, "Selected method: GetMfaByconnectionName")
 		vvConnectionName := vConnectionName.(string)
 
 		response2, restyResp2, err := client.DuoMfa.GetMfaByconnectionName(vvConnectionName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetMfaByconnectionName", err,
@@ -187,7 +185,7 @@ func dataSourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDuoMfaGetMfaByconnectionNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -108,14 +106,14 @@ func dataSourceEgressMatrixCellBulkMonitorStatusRead(ctx context.Context, d *sch
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: MonitorBulkStatusEgressMatrixCell")
+		logDebugf(ctx, "Selected method: MonitorBulkStatusEgressMatrixCell")
 		vvBulkid := vBulkid.(string)
 
 		response1, restyResp1, err := client.EgressMatrixCell.MonitorBulkStatusEgressMatrixCell(vvBulkid)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusEgressMatrixCell", err,
@@ -123,7 +121,7 @@ func dataSourceEgressMatrixCellBulkMonitorStatusRead(ctx context.Context, d *sch
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenEgressMatrixCellMonitorBulkStatusEgressMatrixCellItem(response1.BulkStatus)
 		if err := d.Set("item", vItem1); err != nil {
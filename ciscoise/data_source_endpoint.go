@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -486,15 +484,15 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEndpoints")
+		logDebugf(ctx, "Selected method: GetEndpoints")
 		queryParams1 := isegosdk.GetEndpointsQueryParams{}
 
 		if okPage {
@@ -520,7 +518,7 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetEndpoints", err,
@@ -528,7 +526,7 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseEndpointGetEndpointsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -563,14 +561,14 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetEndpointByName")
+		logDebugf(ctx, "Selected method: GetEndpointByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.Endpoint.GetEndpointByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetEndpointByName", err,
@@ -578,7 +576,7 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenEndpointGetEndpointByNameItemName(response2.ERSEndPoint)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -592,14 +590,14 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetEndpointByID")
+		logDebugf(ctx, "Selected method: GetEndpointByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.Endpoint.GetEndpointByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetEndpointByID", err,
@@ -607,7 +605,7 @@ func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenEndpointGetEndpointByIDItemID(response3.ERSEndPoint)
 		if err := d.Set("item_id", vItemID3); err != nil {
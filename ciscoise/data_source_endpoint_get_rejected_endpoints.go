@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -58,13 +56,13 @@ func dataSourceEndpointGetRejectedEndpointsRead(ctx context.Context, d *schema.R
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRejectedEndpoints")
+		logDebugf(ctx, "Selected method: GetRejectedEndpoints")
 
 		response1, restyResp1, err := client.Endpoint.GetRejectedEndpoints()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRejectedEndpoints", err,
@@ -72,7 +70,7 @@ func dataSourceEndpointGetRejectedEndpointsRead(ctx context.Context, d *schema.R
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenEndpointGetRejectedEndpointsItem(response1.OperationResult)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -350,13 +348,13 @@ func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m inte
 	vValue, okValue := d.GetOk("value")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okValue}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: List1")
+		logDebugf(ctx, "Selected method: List1")
 		queryParams1 := isegosdk.List1QueryParams{}
 
 		if okPage {
@@ -382,7 +380,7 @@ func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 List1", err,
@@ -390,7 +388,7 @@ func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenEndpointsList1Items(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -405,14 +403,14 @@ func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: Get1")
+		logDebugf(ctx, "Selected method: Get1")
 		vvValue := vValue.(string)
 
 		response2, restyResp2, err := client.Endpoints.Get1(vvValue)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 Get1", err,
@@ -420,7 +418,7 @@ func dataSourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenEndpointsGet1Item(response2)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -49,13 +47,13 @@ func dataSourceEndpointsDeviceTypeInfoRead(ctx context.Context, d *schema.Resour
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceTypeSummary")
+		logDebugf(ctx, "Selected method: GetDeviceTypeSummary")
 
 		response1, restyResp1, err := client.Endpoints.GetDeviceTypeSummary()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetDeviceTypeSummary", err,
@@ -63,7 +61,7 @@ func dataSourceEndpointsDeviceTypeInfoRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenEndpointsGetDeviceTypeSummaryItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
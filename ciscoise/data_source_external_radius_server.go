@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -309,15 +307,15 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetExternalRadiusServer")
+		logDebugf(ctx, "Selected method: GetExternalRadiusServer")
 		queryParams1 := isegosdk.GetExternalRadiusServerQueryParams{}
 
 		if okPage {
@@ -331,7 +329,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServer", err,
@@ -339,7 +337,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseExternalRadiusServerGetExternalRadiusServerSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -374,14 +372,14 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetExternalRadiusServerByName")
+		logDebugf(ctx, "Selected method: GetExternalRadiusServerByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.ExternalRadiusServer.GetExternalRadiusServerByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServerByName", err,
@@ -389,7 +387,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenExternalRadiusServerGetExternalRadiusServerByNameItemName(response2.ExternalRadiusServer)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -403,14 +401,14 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetExternalRadiusServerByID")
+		logDebugf(ctx, "Selected method: GetExternalRadiusServerByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.ExternalRadiusServer.GetExternalRadiusServerByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetExternalRadiusServerByID", err,
@@ -418,7 +416,7 @@ func dataSourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenExternalRadiusServerGetExternalRadiusServerByIDItemID(response3.ExternalRadiusServer)
 		if err := d.Set("item_id", vItemID3); err != nil {
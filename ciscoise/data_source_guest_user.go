@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -504,15 +502,15 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetGuestUsers")
+		logDebugf(ctx, "Selected method: GetGuestUsers")
 		queryParams1 := isegosdk.GetGuestUsersQueryParams{}
 
 		if okPage {
@@ -538,7 +536,7 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetGuestUsers", err,
@@ -546,7 +544,7 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseGuestUserGetGuestUsersSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -581,14 +579,14 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetGuestUserByName")
+		logDebugf(ctx, "Selected method: GetGuestUserByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.GuestUser.GetGuestUserByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetGuestUserByName", err,
@@ -596,7 +594,7 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenGuestUserGetGuestUserByNameItemName(response2.GuestUser)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -610,14 +608,14 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetGuestUserByID")
+		logDebugf(ctx, "Selected method: GetGuestUserByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.GuestUser.GetGuestUserByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetGuestUserByID", err,
@@ -625,7 +623,7 @@ func dataSourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenGuestUserGetGuestUserByIDItemID(response3.GuestUser)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -49,13 +47,13 @@ func dataSourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: ListInstalledHotpatches")
+		logDebugf(ctx, "Selected method: ListInstalledHotpatches")
 
 		response1, restyResp1, err := client.Patching.ListInstalledHotpatches()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing ListInstalledHotpatches", err,
@@ -63,7 +61,7 @@ func dataSourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenPatchingListInstalledHotpatchesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
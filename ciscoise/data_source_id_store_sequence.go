@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -243,15 +241,15 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentitySequence")
+		logDebugf(ctx, "Selected method: GetIDentitySequence")
 		queryParams1 := isegosdk.GetIDentitySequenceQueryParams{}
 
 		if okPage {
@@ -265,7 +263,7 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentitySequence", err,
@@ -273,7 +271,7 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseIDentitySequenceGetIDentitySequenceSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -308,14 +306,14 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentitySequenceByName")
+		logDebugf(ctx, "Selected method: GetIDentitySequenceByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.IDentitySequence.GetIDentitySequenceByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentitySequenceByName", err,
@@ -323,7 +321,7 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenIDentitySequenceGetIDentitySequenceByNameItemName(response2.IDStoreSequence)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -337,14 +335,14 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetIDentitySequenceByID")
+		logDebugf(ctx, "Selected method: GetIDentitySequenceByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.IDentitySequence.GetIDentitySequenceByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentitySequenceByID", err,
@@ -352,7 +350,7 @@ func dataSourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenIDentitySequenceGetIDentitySequenceByIDItemID(response3.IDStoreSequence)
 		if err := d.Set("item_id", vItemID3); err != nil {
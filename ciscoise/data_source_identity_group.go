@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -261,15 +259,15 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentityGroups")
+		logDebugf(ctx, "Selected method: GetIDentityGroups")
 		queryParams1 := isegosdk.GetIDentityGroupsQueryParams{}
 
 		if okPage {
@@ -295,7 +293,7 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentityGroups", err,
@@ -303,7 +301,7 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseIDentityGroupsGetIDentityGroupsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -338,14 +336,14 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentityGroupByName")
+		logDebugf(ctx, "Selected method: GetIDentityGroupByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.IDentityGroups.GetIDentityGroupByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentityGroupByName", err,
@@ -353,7 +351,7 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenIDentityGroupsGetIDentityGroupByNameItemName(response2.IDentityGroup)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -367,14 +365,14 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetIDentityGroupByID")
+		logDebugf(ctx, "Selected method: GetIDentityGroupByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.IDentityGroups.GetIDentityGroupByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetIDentityGroupByID", err,
@@ -382,7 +380,7 @@ func dataSourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenIDentityGroupsGetIDentityGroupByIDItemID(response3.IDentityGroup)
 		if err := d.Set("item_id", vItemID3); err != nil {
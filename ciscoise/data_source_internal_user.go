@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -369,15 +367,15 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetInternalUser")
+		logDebugf(ctx, "Selected method: GetInternalUser")
 		queryParams1 := isegosdk.GetInternalUserQueryParams{}
 
 		if okPage {
@@ -403,7 +401,7 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetInternalUser", err,
@@ -411,7 +409,7 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseInternalUserGetInternalUserSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -446,14 +444,14 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetInternalUserByName")
+		logDebugf(ctx, "Selected method: GetInternalUserByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.InternalUser.GetInternalUserByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetInternalUserByName", err,
@@ -461,7 +459,7 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenInternalUserGetInternalUserByNameItemName(response2.InternalUser)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -475,14 +473,14 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetInternalUserByID")
+		logDebugf(ctx, "Selected method: GetInternalUserByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.InternalUser.GetInternalUserByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetInternalUserByID", err,
@@ -490,7 +488,7 @@ func dataSourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenInternalUserGetInternalUserByIDItemID(response3.InternalUser)
 		if err := d.Set("item_id", vItemID3); err != nil {
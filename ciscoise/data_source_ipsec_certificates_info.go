@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -52,13 +50,13 @@ func dataSourceIPsecCertificatesInfoRead(ctx context.Context, d *schema.Resource
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIPSecCertificates")
+		logDebugf(ctx, "Selected method: GetIPSecCertificates")
 
 		response1, restyResp1, err := client.NativeIPsec.GetIPSecCertificates()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetIPSecCertificates", err,
@@ -66,7 +64,7 @@ func dataSourceIPsecCertificatesInfoRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNativeIPsecGetIPSecCertificatesItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
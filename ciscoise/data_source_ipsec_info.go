@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -448,13 +446,13 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 	vNadIP, okNadIP := d.GetOk("nad_ip")
 
 	method1 := []bool{okPage, okSize, okFilter, okFilterType, okSort, okSortBy}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName, okNadIP}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIPsecEnabledNodes")
+		logDebugf(ctx, "Selected method: GetIPsecEnabledNodes")
 		queryParams1 := isegosdk.GetIPsecEnabledNodesQueryParams{}
 
 		if okPage {
@@ -480,7 +478,7 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetIPsecEnabledNodes", err,
@@ -488,7 +486,7 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseNativeIPsecGetIPsecEnabledNodesResponse
 		for response1.Response != nil && len(*response1.Response) > 0 {
@@ -523,7 +521,7 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIPsecNode")
+		logDebugf(ctx, "Selected method: GetIPsecNode")
 		vvHostName := vHostName.(string)
 		vvNadIP := vNadIP.(string)
 
@@ -531,7 +529,7 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetIPsecNode", err,
@@ -539,7 +537,7 @@ func dataSourceIPsecInfoRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNativeIPsecGetIPsecNodeItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
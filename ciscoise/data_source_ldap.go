@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -554,13 +552,13 @@ func dataSourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetLdap")
+		logDebugf(ctx, "Selected method: GetLdap")
 		queryParams1 := isegosdk.GetLdapQueryParams{}
 
 		if okPage {
@@ -574,7 +572,7 @@ func dataSourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetLdap", err,
@@ -582,7 +580,7 @@ func dataSourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseLdapGetLdapSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -617,14 +615,14 @@ func dataSourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetLdapid")
+		logDebugf(ctx, "Selected method: GetLdapid")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.Ldap.GetLdapid(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetLdapid", err,
@@ -632,7 +630,7 @@ func dataSourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenLdapGetLdapidItem(response2.ERSLdap)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -490,13 +488,13 @@ func dataSourceLdapRootcacertificatesRead(ctx context.Context, d *schema.Resourc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetLdapRootcacertificates")
+		logDebugf(ctx, "Selected method: GetLdapRootcacertificates")
 
 		response1, restyResp1, err := client.Ldap.GetLdapRootcacertificates()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetLdapRootcacertificates", err,
@@ -504,7 +502,7 @@ func dataSourceLdapRootcacertificatesRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenLdapGetLdapRootcacertificatesItem(response1.ERSLdap)
 		if err := d.Set("item", vItem1); err != nil {
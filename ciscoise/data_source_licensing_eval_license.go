@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,13 +44,13 @@ func dataSourceLicensingEvalLicenseRead(ctx context.Context, d *schema.ResourceD
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEvalLicenseInfo")
+		logDebugf(ctx, "Selected method: GetEvalLicenseInfo")
 
 		response1, restyResp1, err := client.Licensing.GetEvalLicenseInfo()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetEvalLicenseInfo", err,
@@ -60,7 +58,7 @@ func dataSourceLicensingEvalLicenseRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenLicensingGetEvalLicenseInfoItem(response1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,13 +51,13 @@ func dataSourceLicensingFeatureToTierMappingRead(ctx context.Context, d *schema.
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetFeatureToTierMapping")
+		logDebugf(ctx, "Selected method: GetFeatureToTierMapping")
 
 		response1, restyResp1, err := client.Licensing.GetFeatureToTierMapping()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetFeatureToTierMapping", err,
@@ -67,7 +65,7 @@ func dataSourceLicensingFeatureToTierMappingRead(ctx context.Context, d *schema.
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenLicensingGetFeatureToTierMappingItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -46,7 +44,7 @@ func dataSourceMntAccountStatusRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAccountStatusByMac")
+		logDebugf(ctx, "Selected method: GetAccountStatusByMac")
 		vvMac := vMac.(string)
 		vvDuration := vDuration.(string)
 
@@ -54,7 +52,7 @@ func dataSourceMntAccountStatusRead(ctx context.Context, d *schema.ResourceData,
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetAccountStatusByMac", err, response1.String(),
 					"Failure at GetAccountStatusByMac, unexpected response", ""))
@@ -66,7 +64,7 @@ func dataSourceMntAccountStatusRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
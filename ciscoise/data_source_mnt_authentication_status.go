@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,7 +50,7 @@ func dataSourceMntAuthenticationStatusRead(ctx context.Context, d *schema.Resour
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAuthenticationStatusByMac")
+		logDebugf(ctx, "Selected method: GetAuthenticationStatusByMac")
 		vvMAC := vMAC.(string)
 		vvSECONDS := vSECONDS.(string)
 		vvRECORDS := vRECORDS.(string)
@@ -61,7 +59,7 @@ func dataSourceMntAuthenticationStatusRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetAuthenticationStatusByMac", err, response1.String(),
 					"Failure at GetAuthenticationStatusByMac, unexpected response", ""))
@@ -73,7 +71,7 @@ func dataSourceMntAuthenticationStatusRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
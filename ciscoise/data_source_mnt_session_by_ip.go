@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,14 +38,14 @@ func dataSourceMntSessionByIPRead(ctx context.Context, d *schema.ResourceData, m
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSessionsByEndpointIP")
+		logDebugf(ctx, "Selected method: GetSessionsByEndpointIP")
 		vvEndpointIPv4 := vEndpointIPv4.(string)
 
 		response1, err := client.Misc.GetSessionsByEndpointIP(vvEndpointIPv4)
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsByEndpointIP", err, response1.String(),
 					"Failure at GetSessionsByEndpointIP, unexpected response", ""))
@@ -59,7 +57,7 @@ func dataSourceMntSessionByIPRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,14 +38,14 @@ func dataSourceMntSessionByNasIPRead(ctx context.Context, d *schema.ResourceData
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSessionsByNasIP")
+		logDebugf(ctx, "Selected method: GetSessionsByNasIP")
 		vvNasIPv4 := vNasIPv4.(string)
 
 		response1, err := client.Misc.GetSessionsByNasIP(vvNasIPv4)
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsByNasIP", err, response1.String(),
 					"Failure at GetSessionsByNasIP, unexpected response", ""))
@@ -59,7 +57,7 @@ func dataSourceMntSessionByNasIPRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
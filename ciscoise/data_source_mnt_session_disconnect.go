@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -64,7 +62,7 @@ func dataSourceMntSessionDisconnectRead(ctx context.Context, d *schema.ResourceD
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: SessionDisconnect")
+		logDebugf(ctx, "Selected method: SessionDisconnect")
 		vvENDPOINTIP := vENDPOINTIP.(string)
 		vvPSNNAME := vPSNNAME.(string)
 		vvMAC := vMAC.(string)
@@ -75,7 +73,7 @@ func dataSourceMntSessionDisconnectRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SessionDisconnect", err, response1.String(),
 					"Failure at SessionDisconnect, unexpected response", ""))
@@ -87,7 +85,7 @@ func dataSourceMntSessionDisconnectRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -45,13 +43,13 @@ func dataSourceMntSessionPostureCountRead(ctx context.Context, d *schema.Resourc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPostureCount")
+		logDebugf(ctx, "Selected method: GetPostureCount")
 
 		response1, restyResp1, err := client.Misc.GetPostureCount()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPostureCount", err,
@@ -59,7 +57,7 @@ func dataSourceMntSessionPostureCountRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenMiscGetPostureCountItem(response1)
 		if err := d.Set("item", vItem1); err != nil {
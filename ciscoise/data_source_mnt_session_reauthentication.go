@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,7 +50,7 @@ func dataSourceMntSessionReauthenticationRead(ctx context.Context, d *schema.Res
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: SessionReauthenticationByMac")
+		logDebugf(ctx, "Selected method: SessionReauthenticationByMac")
 		vvPSNNAME := vPSNNAME.(string)
 		vvENDPOINTMac := vENDPOINTMac.(string)
 		vvREAuthTYPE := vREAuthTYPE.(string)
@@ -61,7 +59,7 @@ func dataSourceMntSessionReauthenticationRead(ctx context.Context, d *schema.Res
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SessionReauthenticationByMac", err, response1.String(),
 					"Failure at SessionReauthenticationByMac, unexpected response", ""))
@@ -73,7 +71,7 @@ func dataSourceMntSessionReauthenticationRead(ctx context.Context, d *schema.Res
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,14 +38,14 @@ func dataSourceMntSessionsBySessionIDRead(ctx context.Context, d *schema.Resourc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSessionsBySessionID")
+		logDebugf(ctx, "Selected method: GetSessionsBySessionID")
 		vvSessionID := vSessionID.(string)
 
 		response1, err := client.Misc.GetSessionsBySessionID(vvSessionID)
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetSessionsBySessionID", err, response1.String(),
 					"Failure at GetSessionsBySessionID, unexpected response", ""))
@@ -59,7 +57,7 @@ func dataSourceMntSessionsBySessionIDRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
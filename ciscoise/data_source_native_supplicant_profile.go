@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -171,13 +169,13 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNativeSupplicantProfile")
+		logDebugf(ctx, "Selected method: GetNativeSupplicantProfile")
 		queryParams1 := isegosdk.GetNativeSupplicantProfileQueryParams{}
 
 		if okPage {
@@ -191,7 +189,7 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNativeSupplicantProfile", err,
@@ -199,7 +197,7 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseNativeSupplicantProfileGetNativeSupplicantProfileSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -234,14 +232,14 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNativeSupplicantProfileByID")
+		logDebugf(ctx, "Selected method: GetNativeSupplicantProfileByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.NativeSupplicantProfile.GetNativeSupplicantProfileByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNativeSupplicantProfileByID", err,
@@ -249,7 +247,7 @@ func dataSourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNativeSupplicantProfileGetNativeSupplicantProfileByIDItem(response2.ERSNSpProfile)
 		if err := d.Set("item", vItem2); err != nil {
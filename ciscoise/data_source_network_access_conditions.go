@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -473,21 +471,21 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditions")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditions")
 
 		response1, restyResp1, err := client.NetworkAccessConditions.GetNetworkAccessConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessConditions", err,
@@ -495,7 +493,7 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessConditionsGetNetworkAccessConditionsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -509,14 +507,14 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditionByName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditionByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.NetworkAccessConditions.GetNetworkAccessConditionByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessConditionByName", err,
@@ -524,7 +522,7 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenNetworkAccessConditionsGetNetworkAccessConditionByNameItemName(response2.Response)
 		if err := d.Set("items", vItemName2); err != nil {
@@ -538,14 +536,14 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditionByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditionByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.NetworkAccessConditions.GetNetworkAccessConditionByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessConditionByID", err,
@@ -553,7 +551,7 @@ func dataSourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenNetworkAccessConditionsGetNetworkAccessConditionByIDItemID(response3.Response)
 		if err := d.Set("item", vItemID3); err != nil {
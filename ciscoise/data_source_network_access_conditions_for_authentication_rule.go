@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -245,13 +243,13 @@ func dataSourceNetworkAccessConditionsForAuthenticationRuleRead(ctx context.Cont
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditionsForAuthenticationRules")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditionsForAuthenticationRules")
 
 		response1, restyResp1, err := client.NetworkAccessConditions.GetNetworkAccessConditionsForAuthenticationRules()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessConditionsForAuthenticationRules", err,
@@ -259,7 +257,7 @@ func dataSourceNetworkAccessConditionsForAuthenticationRuleRead(ctx context.Cont
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessConditionsGetNetworkAccessConditionsForAuthenticationRulesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
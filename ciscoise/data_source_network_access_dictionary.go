@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -149,19 +147,19 @@ func dataSourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resour
 	vName, okName := d.GetOk("name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaries")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaries")
 
 		response1, restyResp1, err := client.NetworkAccessDictionary.GetNetworkAccessDictionaries()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessDictionaries", err,
@@ -169,7 +167,7 @@ func dataSourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessDictionaryGetNetworkAccessDictionariesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -183,14 +181,14 @@ func dataSourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resour
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaryByName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaryByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.NetworkAccessDictionary.GetNetworkAccessDictionaryByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessDictionaryByName", err,
@@ -198,7 +196,7 @@ func dataSourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resour
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessDictionaryGetNetworkAccessDictionaryByNameItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
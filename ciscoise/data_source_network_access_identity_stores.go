@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,13 +48,13 @@ func dataSourceNetworkAccessIDentityStoresRead(ctx context.Context, d *schema.Re
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessIDentityStores")
+		logDebugf(ctx, "Selected method: GetNetworkAccessIDentityStores")
 
 		response1, restyResp1, err := client.NetworkAccessIDentityStores.GetNetworkAccessIDentityStores()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessIDentityStores", err,
@@ -64,7 +62,7 @@ func dataSourceNetworkAccessIDentityStoresRead(ctx context.Context, d *schema.Re
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessIDentityStoresGetNetworkAccessIDentityStoresItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -0,0 +1,276 @@
+package ciscoise
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkAccessPolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: `It evaluates the Network Access policy for the attributes of a request, without sending a request to ISE.
+
+- Reads the policy sets, the authentication, authorization, local and global exception rules and the library conditions, then evaluates them in rank order the way ISE does.
+
+- Returns the policy set, the authentication rule and the authorization rule matched, with its profiles and security group.
+`,
+
+		ReadContext: dataSourceNetworkAccessPolicyEvaluationRead,
+		Schema: map[string]*schema.Schema{
+			"attribute": &schema.Schema{
+				Description: `Attribute of the request. Repeat the name for each value of a multi-valued attribute, like AD1:ExternalGroups.`,
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"name": &schema.Schema{
+							Description: `Dictionary and attribute name, e.g. Radius:NAS-Port-Type`,
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": &schema.Schema{
+							Description: `Value of the attribute`,
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"time": &schema.Schema{
+				Description:  `Time of the request for the time and date conditions, in RFC 3339 format. The dates and hours of the conditions are compared in its time zone. Defaults to the current time.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Time,
+			},
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"policy_set_id": &schema.Schema{
+							Description: `Id of the policy set matched, empty when none matches`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"policy_set_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": &schema.Schema{
+							Description: `Allowed protocols or server sequence of the policy set matched`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"authentication_rule_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authentication_rule_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identity_source_name": &schema.Schema{
+							Description: `Identity source of the authentication rule matched`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"if_auth_fail": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"if_user_not_found": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"if_process_fail": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_rule_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_rule_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"authorization_rule_scope": &schema.Schema{
+							Description: `Where the authorization rule matched is, local_exception, global_exception or authorization`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"profile": &schema.Schema{
+							Description: `The authorization profile/s of the authorization rule matched`,
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"security_group": &schema.Schema{
+							Description: `Security group of the authorization rule matched`,
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkAccessPolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	attributes := make(map[string][]string)
+	for _, attribute := range d.Get("attribute").([]interface{}) {
+		attributeMap, ok := attribute.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := interfaceToString(attributeMap["name"])
+		attributes[name] = append(attributes[name], interfaceToString(attributeMap["value"]))
+	}
+	now := time.Now()
+	if vTime, ok := d.GetOk("time"); ok {
+		parsed, err := time.Parse(time.RFC3339, vTime.(string))
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when parsing time", err))
+			return diags
+		}
+		now = parsed
+	}
+
+	logDebugf(ctx, "Selected method: GetNetworkAccessConditions")
+	var conditions []conditionNode
+	restyResp1, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/condition", &conditions)
+	if err != nil {
+		if restyResp1 != nil {
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
+		}
+		diags = append(diags, diagErrorWithAlt(
+			"Failure when executing GetNetworkAccessConditions", err,
+			"Failure at GetNetworkAccessConditions, unexpected response", ""))
+		return diags
+	}
+	evaluator := newPolicyEvaluator(attributes, now, conditions)
+
+	logDebugf(ctx, "Selected method: GetNetworkAccessPolicySets")
+	var policySets []policyEvaluationPolicySet
+	restyResp2, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/policy-set", &policySets)
+	if err != nil {
+		if restyResp2 != nil {
+			logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
+		}
+		diags = append(diags, diagErrorWithAlt(
+			"Failure when executing GetNetworkAccessPolicySets", err,
+			"Failure at GetNetworkAccessPolicySets, unexpected response", ""))
+		return diags
+	}
+	policySet, err := evaluator.matchPolicySet(policySets)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when evaluating the policy sets", err))
+		return diags
+	}
+
+	item := map[string]interface{}{}
+	if policySet != nil {
+		logDebugf(ctx, "Matched policy set %s", policySet.Name)
+		item["policy_set_id"] = policySet.ID
+		item["policy_set_name"] = policySet.Name
+		item["service_name"] = policySet.ServiceName
+
+		var authenticationRules []policyEvaluationAuthenticationRule
+		restyResp3, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/policy-set/"+policySet.ID+"/authentication", &authenticationRules)
+		if err != nil {
+			if restyResp3 != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
+			}
+			diags = append(diags, diagErrorWithAlt(
+				"Failure when executing GetNetworkAccessAuthenticationRules", err,
+				"Failure at GetNetworkAccessAuthenticationRules, unexpected response", ""))
+			return diags
+		}
+		authenticationRule, err := evaluator.matchAuthenticationRule(authenticationRules)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when evaluating the authentication rules", err))
+			return diags
+		}
+		if authenticationRule != nil {
+			rule := ruleOrEmpty(authenticationRule.Rule)
+			logDebugf(ctx, "Matched authentication rule %s", rule.Name)
+			item["authentication_rule_id"] = rule.ID
+			item["authentication_rule_name"] = rule.Name
+			item["identity_source_name"] = authenticationRule.IdentitySourceName
+			item["if_auth_fail"] = authenticationRule.IfAuthFail
+			item["if_user_not_found"] = authenticationRule.IfUserNotFound
+			item["if_process_fail"] = authenticationRule.IfProcessFail
+		}
+
+		var localExceptionRules []policyEvaluationAuthorizationRule
+		restyResp4, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/policy-set/"+policySet.ID+"/exception", &localExceptionRules)
+		if err != nil {
+			if restyResp4 != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp4.String())
+			}
+			diags = append(diags, diagErrorWithAlt(
+				"Failure when executing GetNetworkAccessLocalExceptionRules", err,
+				"Failure at GetNetworkAccessLocalExceptionRules, unexpected response", ""))
+			return diags
+		}
+
+		var globalExceptionRules []policyEvaluationAuthorizationRule
+		restyResp5, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/policy-set/global-exception", &globalExceptionRules)
+		if err != nil {
+			if restyResp5 != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp5.String())
+			}
+			diags = append(diags, diagErrorWithAlt(
+				"Failure when executing GetNetworkAccessPolicySetGlobalExceptionRules", err,
+				"Failure at GetNetworkAccessPolicySetGlobalExceptionRules, unexpected response", ""))
+			return diags
+		}
+
+		var authorizationRules []policyEvaluationAuthorizationRule
+		restyResp6, err := getPolicyAPIList(ctx, m, networkAccessConditionLibrary.apiPath+"/policy-set/"+policySet.ID+"/authorization", &authorizationRules)
+		if err != nil {
+			if restyResp6 != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp6.String())
+			}
+			diags = append(diags, diagErrorWithAlt(
+				"Failure when executing GetNetworkAccessAuthorizationRules", err,
+				"Failure at GetNetworkAccessAuthorizationRules, unexpected response", ""))
+			return diags
+		}
+
+		authorizationRule, scope, err := evaluator.matchAuthorizationRule(localExceptionRules, globalExceptionRules, authorizationRules)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when evaluating the authorization rules", err))
+			return diags
+		}
+		if authorizationRule != nil {
+			rule := ruleOrEmpty(authorizationRule.Rule)
+			logDebugf(ctx, "Matched %s rule %s", scope, rule.Name)
+			item["authorization_rule_id"] = rule.ID
+			item["authorization_rule_name"] = rule.Name
+			item["authorization_rule_scope"] = scope
+			item["profile"] = authorizationRule.Profile
+			item["security_group"] = authorizationRule.SecurityGroup
+		}
+	}
+
+	if err := d.Set("item", []interface{}{item}); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting the policy evaluation", err))
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	return diags
+}
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -615,19 +613,19 @@ func dataSourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.Resourc
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySets")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySets")
 
 		response1, restyResp1, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessPolicySets", err,
@@ -635,7 +633,7 @@ func dataSourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessPolicySetGetNetworkAccessPolicySetsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -649,14 +647,14 @@ func dataSourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.Resourc
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySetByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySetByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySetByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessPolicySetByID", err,
@@ -664,7 +662,7 @@ func dataSourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessPolicySetGetNetworkAccessPolicySetByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,13 +58,13 @@ func dataSourceNetworkAccessServiceNameRead(ctx context.Context, d *schema.Resou
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessServiceNames")
+		logDebugf(ctx, "Selected method: GetNetworkAccessServiceNames")
 
 		response1, restyResp1, err := client.NetworkAccessServiceNames.GetNetworkAccessServiceNames()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessServiceNames", err,
@@ -74,7 +72,7 @@ func dataSourceNetworkAccessServiceNameRead(ctx context.Context, d *schema.Resou
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessServiceNamesGetNetworkAccessServiceNamesItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -465,19 +463,19 @@ func dataSourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schem
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessTimeConditions")
+		logDebugf(ctx, "Selected method: GetNetworkAccessTimeConditions")
 
 		response1, restyResp1, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessTimeConditions", err,
@@ -485,7 +483,7 @@ func dataSourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schem
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditionsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -499,14 +497,14 @@ func dataSourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schem
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessTimeConditionByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessTimeConditionByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkAccessTimeConditionByID", err,
@@ -514,7 +512,7 @@ func dataSourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schem
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
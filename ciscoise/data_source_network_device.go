@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -777,15 +775,15 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkDevice")
+		logDebugf(ctx, "Selected method: GetNetworkDevice")
 		queryParams1 := isegosdk.GetNetworkDeviceQueryParams{}
 
 		if okPage {
@@ -811,7 +809,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDevice", err,
@@ -819,7 +817,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseNetworkDeviceGetNetworkDeviceSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -854,14 +852,14 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkDeviceByName")
+		logDebugf(ctx, "Selected method: GetNetworkDeviceByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.NetworkDevice.GetNetworkDeviceByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDeviceByName", err,
@@ -869,7 +867,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenNetworkDeviceGetNetworkDeviceByNameItemName(response2.NetworkDevice)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -883,14 +881,14 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetNetworkDeviceByID")
+		logDebugf(ctx, "Selected method: GetNetworkDeviceByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.NetworkDevice.GetNetworkDeviceByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNetworkDeviceByID", err,
@@ -898,7 +896,7 @@ func dataSourceNetworkDeviceRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenNetworkDeviceGetNetworkDeviceByIDItemID(response3.NetworkDevice)
 		if err := d.Set("item_id", vItemID3); err != nil {
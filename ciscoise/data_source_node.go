@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -348,15 +346,15 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNodeDetails")
+		logDebugf(ctx, "Selected method: GetNodeDetails")
 		queryParams1 := isegosdk.GetNodeDetailsQueryParams{}
 
 		if okPage {
@@ -376,7 +374,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetails", err,
@@ -384,7 +382,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseNodeDetailsGetNodeDetailsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -419,14 +417,14 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNodeDetailByName")
+		logDebugf(ctx, "Selected method: GetNodeDetailByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.NodeDetails.GetNodeDetailByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetailByName", err,
@@ -434,7 +432,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenNodeDetailsGetNodeDetailByNameItemName(response2.Node)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -448,14 +446,14 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetNodeDetailByID")
+		logDebugf(ctx, "Selected method: GetNodeDetailByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.NodeDetails.GetNodeDetailByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetailByID", err,
@@ -463,7 +461,7 @@ func dataSourceNodeRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenNodeDetailsGetNodeDetailByIDItemID(response3.Node)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -207,13 +205,13 @@ func dataSourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m
 	vHostname, okHostname := d.GetOk("hostname")
 
 	method1 := []bool{okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostname}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeploymentNodes")
+		logDebugf(ctx, "Selected method: GetDeploymentNodes")
 		queryParams1 := isegosdk.GetDeploymentNodesQueryParams{}
 
 		if okFilter {
@@ -227,7 +225,7 @@ func dataSourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeploymentNodes", err,
@@ -235,7 +233,7 @@ func dataSourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNodeDeploymentGetDeploymentNodesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -249,14 +247,14 @@ func dataSourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNodeDetails")
+		logDebugf(ctx, "Selected method: GetNodeDetails")
 		vvHostname := vHostname.(string)
 
 		response2, restyResp2, err := client.NodeDeployment.GetNodeDetails(vvHostname)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeDetails", err,
@@ -264,7 +262,7 @@ func dataSourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNodeDeploymentGetNodeDetailsItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -133,19 +131,19 @@ func dataSourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 	vNodeGroupName, okNodeGroupName := d.GetOk("node_group_name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okNodeGroupName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNodeGroups")
+		logDebugf(ctx, "Selected method: GetNodeGroups")
 
 		response1, restyResp1, err := client.NodeGroup.GetNodeGroups()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeGroups", err,
@@ -153,7 +151,7 @@ func dataSourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNodeGroupGetNodeGroupsItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -167,14 +165,14 @@ func dataSourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNodeGroup")
+		logDebugf(ctx, "Selected method: GetNodeGroup")
 		vvNodeGroupName := vNodeGroupName.(string)
 
 		response2, restyResp2, err := client.NodeGroup.GetNodeGroup(vvNodeGroupName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodeGroup", err,
@@ -182,7 +180,7 @@ func dataSourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNodeGroupGetNodeGroupItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
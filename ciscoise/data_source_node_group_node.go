@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,14 +49,14 @@ func dataSourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNodes")
+		logDebugf(ctx, "Selected method: GetNodes")
 		vvNodeGroupName := vNodeGroupName.(string)
 
 		response1, restyResp1, err := client.NodeGroup.GetNodes(vvNodeGroupName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetNodes", err,
@@ -66,7 +64,7 @@ func dataSourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNodeGroupGetNodesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,14 +49,14 @@ func dataSourceNodeServicesInterfacesRead(ctx context.Context, d *schema.Resourc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetInterfaces")
+		logDebugf(ctx, "Selected method: GetInterfaces")
 		vvHostname := vHostname.(string)
 
 		response1, restyResp1, err := client.NodeServices.GetInterfaces(vvHostname)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetInterfaces", err,
@@ -66,7 +64,7 @@ func dataSourceNodeServicesInterfacesRead(ctx context.Context, d *schema.Resourc
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenNodeServicesGetInterfacesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -256,14 +254,14 @@ func dataSourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schem
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetProfilerProbeConfig")
+		logDebugf(ctx, "Selected method: GetProfilerProbeConfig")
 		vvHostname := vHostname.(string)
 
 		response1, restyResp1, err := client.NodeServices.GetProfilerProbeConfig(vvHostname)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetProfilerProbeConfig", err,
@@ -271,7 +269,7 @@ func dataSourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schem
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeServicesGetProfilerProbeConfigItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,14 +49,14 @@ func dataSourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Reso
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSxpInterface")
+		logDebugf(ctx, "Selected method: GetSxpInterface")
 		vvHostname := vHostname.(string)
 
 		response1, restyResp1, err := client.NodeServices.GetSxpInterface(vvHostname)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSxpInterface", err,
@@ -66,7 +64,7 @@ func dataSourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Reso
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeServicesGetSxpInterfaceItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
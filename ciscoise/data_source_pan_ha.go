@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -84,13 +82,13 @@ func dataSourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPanHaStatus")
+		logDebugf(ctx, "Selected method: GetPanHaStatus")
 
 		response1, restyResp1, err := client.PanHa.GetPanHaStatus()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPanHaStatus", err,
@@ -98,7 +96,7 @@ func dataSourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPanHaGetPanHaStatusItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -207,13 +205,13 @@ func dataSourcePortalRead(ctx context.Context, d *schema.ResourceData, m interfa
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPortals")
+		logDebugf(ctx, "Selected method: GetPortals")
 		queryParams1 := isegosdk.GetPortalsQueryParams{}
 
 		if okPage {
@@ -239,7 +237,7 @@ func dataSourcePortalRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPortals", err,
@@ -247,7 +245,7 @@ func dataSourcePortalRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponsePortalGetPortalsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -282,14 +280,14 @@ func dataSourcePortalRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetPortalByID")
+		logDebugf(ctx, "Selected method: GetPortalByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.Portal.GetPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPortalByID", err,
@@ -297,7 +295,7 @@ func dataSourcePortalRead(ctx context.Context, d *schema.ResourceData, m interfa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPortalGetPortalByIDItem(response2.ERSPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -194,13 +192,13 @@ func dataSourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceDa
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPortalGlobalSettings")
+		logDebugf(ctx, "Selected method: GetPortalGlobalSettings")
 		queryParams1 := isegosdk.GetPortalGlobalSettingsQueryParams{}
 
 		if okPage {
@@ -226,7 +224,7 @@ func dataSourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPortalGlobalSettings", err,
@@ -234,7 +232,7 @@ func dataSourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponsePortalGlobalSettingGetPortalGlobalSettingsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -269,14 +267,14 @@ func dataSourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetPortalGlobalSettingByID")
+		logDebugf(ctx, "Selected method: GetPortalGlobalSettingByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.PortalGlobalSetting.GetPortalGlobalSettingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPortalGlobalSettingByID", err,
@@ -284,7 +282,7 @@ func dataSourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPortalGlobalSettingGetPortalGlobalSettingByIDItem(response2.PortalCustomizationGlobalSetting)
 		if err := d.Set("item", vItem2); err != nil {
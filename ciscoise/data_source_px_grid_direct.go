@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -351,19 +349,19 @@ func dataSourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m i
 	vConnectorName, okConnectorName := d.GetOk("connector_name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectorName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetConnectorConfig")
+		logDebugf(ctx, "Selected method: GetConnectorConfig")
 
 		response1, restyResp1, err := client.PxGridDirect.GetConnectorConfig()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetConnectorConfig", err,
@@ -371,7 +369,7 @@ func dataSourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenPxGridDirectGetConnectorConfigItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -386,14 +384,14 @@ func dataSourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetConnectorConfigByConnectorName")
+		logDebugf(ctx, "Selected method: GetConnectorConfigByConnectorName")
 		vvConnectorName := vConnectorName.(string)
 
 		response2, restyResp2, err := client.PxGridDirect.GetConnectorConfigByConnectorName(vvConnectorName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetConnectorConfigByConnectorName", err,
@@ -401,7 +399,7 @@ func dataSourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m i
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPxGridDirectGetConnectorConfigByConnectorNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
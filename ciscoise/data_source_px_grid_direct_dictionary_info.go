@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,13 +52,13 @@ func dataSourcePxGridDirectDictionaryInfoRead(ctx context.Context, d *schema.Res
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetpxgridDirectDictionaryReferences")
+		logDebugf(ctx, "Selected method: GetpxgridDirectDictionaryReferences")
 
 		response1, restyResp1, err := client.PxGridDirect.GetpxgridDirectDictionaryReferences()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetpxgridDirectDictionaryReferences", err,
@@ -68,7 +66,7 @@ func dataSourcePxGridDirectDictionaryInfoRead(ctx context.Context, d *schema.Res
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPxGridDirectGetpxgridDirectDictionaryReferencesItem(response1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -89,14 +87,14 @@ func dataSourcePxGridDirectSyncRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetConnectorConfigSyncNowStatus")
+		logDebugf(ctx, "Selected method: GetConnectorConfigSyncNowStatus")
 		vvConnectorName := vConnectorName.(string)
 
 		response1, restyResp1, err := client.PxGridDirect.GetConnectorConfigSyncNowStatus(vvConnectorName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetConnectorConfigSyncNowStatus", err,
@@ -104,7 +102,7 @@ func dataSourcePxGridDirectSyncRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPxGridDirectGetConnectorConfigSyncNowStatusItemResponse(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -209,15 +207,15 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPxGridNode")
+		logDebugf(ctx, "Selected method: GetPxGridNode")
 		queryParams1 := isegosdk.GetPxGridNodeQueryParams{}
 
 		if okPage {
@@ -231,7 +229,7 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPxGridNode", err,
@@ -239,7 +237,7 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponsePxGridNodeGetPxGridNodeSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -274,14 +272,14 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetPxGridNodeByName")
+		logDebugf(ctx, "Selected method: GetPxGridNodeByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.PxGridNode.GetPxGridNodeByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPxGridNodeByName", err,
@@ -289,7 +287,7 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenPxGridNodeGetPxGridNodeByNameItemName(response2.PxgridNode)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -303,14 +301,14 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetPxGridNodeByID")
+		logDebugf(ctx, "Selected method: GetPxGridNodeByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.PxGridNode.GetPxGridNodeByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetPxGridNodeByID", err,
@@ -318,7 +316,7 @@ func dataSourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenPxGridNodeGetPxGridNodeByIDItemID(response3.PxgridNode)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,13 +33,13 @@ func dataSourcePxgridEndpointsByOsTypeInfoRead(ctx context.Context, d *schema.Re
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEndpointsByOsType")
+		logDebugf(ctx, "Selected method: GetEndpointsByOsType")
 
 		response1, err := client.Mdm.GetEndpointsByOsType()
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing GetEndpointsByOsType", err, response1.String(),
 					"Failure at GetEndpointsByOsType, unexpected response", ""))
@@ -53,7 +51,7 @@ func dataSourcePxgridEndpointsByOsTypeInfoRead(ctx context.Context, d *schema.Re
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
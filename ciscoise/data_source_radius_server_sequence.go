@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -252,13 +250,13 @@ func dataSourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceD
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRadiusServerSequence")
+		logDebugf(ctx, "Selected method: GetRadiusServerSequence")
 		queryParams1 := isegosdk.GetRadiusServerSequenceQueryParams{}
 
 		if okPage {
@@ -272,7 +270,7 @@ func dataSourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRadiusServerSequence", err,
@@ -280,7 +278,7 @@ func dataSourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseRadiusServerSequenceGetRadiusServerSequenceSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -315,14 +313,14 @@ func dataSourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetRadiusServerSequenceByID")
+		logDebugf(ctx, "Selected method: GetRadiusServerSequenceByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.RadiusServerSequence.GetRadiusServerSequenceByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRadiusServerSequenceByID", err,
@@ -330,7 +328,7 @@ func dataSourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenRadiusServerSequenceGetRadiusServerSequenceByIDItem(response2.RadiusServerSequence)
 		if err := d.Set("item", vItem2); err != nil {
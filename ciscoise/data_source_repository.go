@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -123,19 +121,19 @@ func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m int
 	vRepositoryName, okRepositoryName := d.GetOk("repository_name")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okRepositoryName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRepositories")
+		logDebugf(ctx, "Selected method: GetRepositories")
 
 		response1, restyResp1, err := client.Repository.GetRepositories()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRepositories", err,
@@ -143,7 +141,7 @@ func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenRepositoryGetRepositoriesItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -157,14 +155,14 @@ func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetRepository")
+		logDebugf(ctx, "Selected method: GetRepository")
 		vvRepositoryName := vRepositoryName.(string)
 
 		response2, restyResp2, err := client.Repository.GetRepository(vvRepositoryName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRepository", err,
@@ -172,7 +170,7 @@ func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenRepositoryGetRepositoryItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
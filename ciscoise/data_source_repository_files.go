@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -58,14 +56,14 @@ func dataSourceRepositoryFilesRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRepositoryFiles")
+		logDebugf(ctx, "Selected method: GetRepositoryFiles")
 		vvRepositoryName := vRepositoryName.(string)
 
 		response1, restyResp1, err := client.Repository.GetRepositoryFiles(vvRepositoryName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRepositoryFiles", err,
@@ -73,7 +71,7 @@ func dataSourceRepositoryFilesRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenRepositoryGetRepositoryFilesItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -109,13 +107,13 @@ func dataSourceReservationRead(ctx context.Context, d *schema.ResourceData, m in
 	vClientID, okClientID := d.GetOk("client_id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okClientID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSgtReservedRanges")
+		logDebugf(ctx, "Selected method: GetSgtReservedRanges")
 		queryParams1 := isegosdk.GetSgtReservedRangesQueryParams{}
 
 		if okPage {
@@ -129,7 +127,7 @@ func dataSourceReservationRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetSgtReservedRanges", err,
@@ -137,7 +135,7 @@ func dataSourceReservationRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenSgtRangeReservationGetSgtReservedRangesItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -152,14 +150,14 @@ func dataSourceReservationRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSgtReservedRange")
+		logDebugf(ctx, "Selected method: GetSgtReservedRange")
 		vvClientID := vClientID.(string)
 
 		response2, restyResp2, err := client.SgtRangeReservation.GetSgtReservedRange(vvClientID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetSgtReservedRange", err,
@@ -167,7 +165,7 @@ func dataSourceReservationRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSgtRangeReservationGetSgtReservedRangeItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
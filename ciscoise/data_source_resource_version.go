@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -76,14 +74,14 @@ func dataSourceResourceVersionRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetVersionInfo")
+		logDebugf(ctx, "Selected method: GetVersionInfo")
 		vvResource := vResource.(string)
 
 		response1, restyResp1, err := client.VersionInfo.GetVersionInfo(vvResource)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetVersionInfo", err,
@@ -91,7 +89,7 @@ func dataSourceResourceVersionRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenVersionInfoGetVersionInfoItem(response1.VersionInfo)
 		if err := d.Set("item", vItem1); err != nil {
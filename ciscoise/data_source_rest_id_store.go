@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -343,15 +341,15 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRestIDStore")
+		logDebugf(ctx, "Selected method: GetRestIDStore")
 		queryParams1 := isegosdk.GetRestIDStoreQueryParams{}
 
 		if okPage {
@@ -377,7 +375,7 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRestIDStore", err,
@@ -385,7 +383,7 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseRestidStoreGetRestIDStoreSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -420,14 +418,14 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetRestIDStoreByName")
+		logDebugf(ctx, "Selected method: GetRestIDStoreByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.RestidStore.GetRestIDStoreByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRestIDStoreByName", err,
@@ -435,7 +433,7 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenRestidStoreGetRestIDStoreByNameItemName(response2.ERSRestIDStore)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -449,14 +447,14 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetRestIDStoreByID")
+		logDebugf(ctx, "Selected method: GetRestIDStoreByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.RestidStore.GetRestIDStoreByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetRestIDStoreByID", err,
@@ -464,7 +462,7 @@ func dataSourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenRestidStoreGetRestIDStoreByIDItemID(response3.ERSRestIDStore)
 		if err := d.Set("item_id", vItemID3); err != nil {
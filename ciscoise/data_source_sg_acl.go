@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -231,13 +229,13 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSortasc, okSortdsc, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsACL")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsACL")
 		queryParams1 := isegosdk.GetSecurityGroupsACLQueryParams{}
 
 		if okPage {
@@ -263,7 +261,7 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroupsACL", err,
@@ -271,7 +269,7 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseSecurityGroupsACLsGetSecurityGroupsACLSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -306,14 +304,14 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsACLByID")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsACLByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.SecurityGroupsACLs.GetSecurityGroupsACLByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSecurityGroupsACLByID", err,
@@ -321,7 +319,7 @@ func dataSourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSecurityGroupsACLsGetSecurityGroupsACLByIDItem(response2.Sgacl)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,13 +58,13 @@ func dataSourceSgMappingDeployStatusInfoRead(ctx context.Context, d *schema.Reso
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeployStatusIPToSgtMapping")
+		logDebugf(ctx, "Selected method: GetDeployStatusIPToSgtMapping")
 
 		response1, restyResp1, err := client.IPToSgtMapping.GetDeployStatusIPToSgtMapping()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeployStatusIPToSgtMapping", err,
@@ -74,7 +72,7 @@ func dataSourceSgMappingDeployStatusInfoRead(ctx context.Context, d *schema.Reso
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenIPToSgtMappingGetDeployStatusIPToSgtMappingItem(response1.OperationResult)
 		if err := d.Set("item", vItem1); err != nil {
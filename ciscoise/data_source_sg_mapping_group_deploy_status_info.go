@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -60,13 +58,13 @@ func dataSourceSgMappingGroupDeployStatusInfoRead(ctx context.Context, d *schema
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeployStatusIPToSgtMappingGroup")
+		logDebugf(ctx, "Selected method: GetDeployStatusIPToSgtMappingGroup")
 
 		response1, restyResp1, err := client.IPToSgtMappingGroup.GetDeployStatusIPToSgtMappingGroup()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetDeployStatusIPToSgtMappingGroup", err,
@@ -74,7 +72,7 @@ func dataSourceSgMappingGroupDeployStatusInfoRead(ctx context.Context, d *schema
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenIPToSgtMappingGroupGetDeployStatusIPToSgtMappingGroupItem(response1.OperationResult)
 		if err := d.Set("item", vItem1); err != nil {
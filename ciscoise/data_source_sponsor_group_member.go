@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -150,7 +148,7 @@ func dataSourceSponsorGroupMemberRead(ctx context.Context, d *schema.ResourceDat
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSponsorGroupMember")
+		logDebugf(ctx, "Selected method: GetSponsorGroupMember")
 		queryParams1 := isegosdk.GetSponsorGroupMemberQueryParams{}
 
 		if okPage {
@@ -176,7 +174,7 @@ func dataSourceSponsorGroupMemberRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSponsorGroupMember", err,
@@ -184,7 +182,7 @@ func dataSourceSponsorGroupMemberRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseSponsorGroupMemberGetSponsorGroupMemberSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -44,13 +42,13 @@ func dataSourceStopReplicationRead(ctx context.Context, d *schema.ResourceData,
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetStopReplicationStatus")
+		logDebugf(ctx, "Selected method: GetStopReplicationStatus")
 
 		response1, restyResp1, err := client.EndpointStopReplicationService.GetStopReplicationStatus()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetStopReplicationStatus", err,
@@ -58,7 +56,7 @@ func dataSourceStopReplicationRead(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenEndpointStopReplicationServiceGetStopReplicationStatusItemResponse(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
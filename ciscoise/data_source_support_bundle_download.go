@@ -5,8 +5,6 @@ import (
 
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -48,7 +46,7 @@ func dataSourceSupportBundleDownloadRead(ctx context.Context, d *schema.Resource
 	response1, _, err := client.SupportBundleDownload.DownloadSupportBundle(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil {
@@ -57,7 +55,7 @@ func dataSourceSupportBundleDownloadRead(ctx context.Context, d *schema.Resource
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response")
+	logDebugf(ctx, "Retrieved response")
 
 	vvDirpath := d.Get("dirpath").(string)
 	err = response1.SaveDownload(vvDirpath)
@@ -66,7 +64,7 @@ func dataSourceSupportBundleDownloadRead(ctx context.Context, d *schema.Resource
 			"Failure when downloading file", err))
 		return diags
 	}
-	log.Printf("[DEBUG] Downloaded file %s", vvDirpath)
+	logDebugf(ctx, "Downloaded file %s", vvDirpath)
 
 	return diags
 }
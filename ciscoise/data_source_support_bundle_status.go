@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -159,13 +157,13 @@ func dataSourceSupportBundleStatusRead(ctx context.Context, d *schema.ResourceDa
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSupportBundleStatus")
+		logDebugf(ctx, "Selected method: GetSupportBundleStatus")
 		queryParams1 := isegosdk.GetSupportBundleStatusQueryParams{}
 
 		if okPage {
@@ -179,7 +177,7 @@ func dataSourceSupportBundleStatusRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSupportBundleStatus", err,
@@ -187,7 +185,7 @@ func dataSourceSupportBundleStatusRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseSupportBundleStatusGetSupportBundleStatusSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -222,14 +220,14 @@ func dataSourceSupportBundleStatusRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSupportBundleStatusByID")
+		logDebugf(ctx, "Selected method: GetSupportBundleStatusByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.SupportBundleStatus.GetSupportBundleStatusByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSupportBundleStatusByID", err,
@@ -237,7 +235,7 @@ func dataSourceSupportBundleStatusRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSupportBundleStatusGetSupportBundleStatusByIDItem(response2.SBStatus)
 		if err := d.Set("item", vItem2); err != nil {
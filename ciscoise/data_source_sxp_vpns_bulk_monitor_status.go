@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -108,14 +106,14 @@ func dataSourceSxpVpnsBulkMonitorStatusRead(ctx context.Context, d *schema.Resou
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: MonitorBulkStatusSxpVpns")
+		logDebugf(ctx, "Selected method: MonitorBulkStatusSxpVpns")
 		vvBulkid := vBulkid.(string)
 
 		response1, restyResp1, err := client.SxpVpns.MonitorBulkStatusSxpVpns(vvBulkid)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing MonitorBulkStatusSxpVpns", err,
@@ -123,7 +121,7 @@ func dataSourceSxpVpnsBulkMonitorStatusRead(ctx context.Context, d *schema.Resou
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenSxpVpnsMonitorBulkStatusSxpVpnsItem(response1.BulkStatus)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -380,13 +378,13 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName, okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSystemCertificates")
+		logDebugf(ctx, "Selected method: GetSystemCertificates")
 		vvHostName := vHostName.(string)
 		queryParams1 := isegosdk.GetSystemCertificatesQueryParams{}
 
@@ -413,7 +411,7 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSystemCertificates", err,
@@ -421,7 +419,7 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseCertificatesGetSystemCertificatesResponse
 		for response1.Response != nil && len(*response1.Response) > 0 {
@@ -456,7 +454,7 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSystemCertificateByID")
+		logDebugf(ctx, "Selected method: GetSystemCertificateByID")
 		vvHostName := vHostName.(string)
 		vvID := vID.(string)
 
@@ -464,7 +462,7 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetSystemCertificateByID", err,
@@ -472,7 +470,7 @@ func dataSourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenCertificatesGetSystemCertificateByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
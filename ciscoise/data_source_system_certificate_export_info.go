@@ -5,8 +5,6 @@ import (
 
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,13 +52,13 @@ func dataSourceSystemCertificateExportInfoRead(ctx context.Context, d *schema.Re
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: ExportSystemCert")
+		logDebugf(ctx, "Selected method: ExportSystemCert")
 		request1 := expandRequestSystemCertificateExportInfoExportSystemCert(ctx, "", d)
 
 		response1, _, err := client.Certificates.ExportSystemCert(request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil {
@@ -69,7 +67,7 @@ func dataSourceSystemCertificateExportInfoRead(ctx context.Context, d *schema.Re
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response")
+		logDebugf(ctx, "Retrieved response")
 
 		vvDirpath := d.Get("dirpath").(string)
 		err = response1.SaveDownload(vvDirpath)
@@ -78,7 +76,7 @@ func dataSourceSystemCertificateExportInfoRead(ctx context.Context, d *schema.Re
 				"Failure when downloading file", err))
 			return diags
 		}
-		log.Printf("[DEBUG] Downloaded file %s", vvDirpath)
+		logDebugf(ctx, "Downloaded file %s", vvDirpath)
 
 	}
 	return diags
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -255,15 +253,15 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsCommandSets")
+		logDebugf(ctx, "Selected method: GetTacacsCommandSets")
 		queryParams1 := isegosdk.GetTacacsCommandSetsQueryParams{}
 
 		if okPage {
@@ -277,7 +275,7 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsCommandSets", err,
@@ -285,7 +283,7 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseTacacsCommandSetsGetTacacsCommandSetsSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -320,14 +318,14 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsCommandSetsByName")
+		logDebugf(ctx, "Selected method: GetTacacsCommandSetsByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.TacacsCommandSets.GetTacacsCommandSetsByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsCommandSetsByName", err,
@@ -335,7 +333,7 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenTacacsCommandSetsGetTacacsCommandSetsByNameItemName(response2.TacacsCommandSets)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -349,14 +347,14 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetTacacsCommandSetsByID")
+		logDebugf(ctx, "Selected method: GetTacacsCommandSetsByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.TacacsCommandSets.GetTacacsCommandSetsByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsCommandSetsByID", err,
@@ -364,7 +362,7 @@ func dataSourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenTacacsCommandSetsGetTacacsCommandSetsByIDItemID(response3.TacacsCommandSets)
 		if err := d.Set("item_id", vItemID3); err != nil {
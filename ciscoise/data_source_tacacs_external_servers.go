@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -235,15 +233,15 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsExternalServers")
+		logDebugf(ctx, "Selected method: GetTacacsExternalServers")
 		queryParams1 := isegosdk.GetTacacsExternalServersQueryParams{}
 
 		if okPage {
@@ -257,7 +255,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServers", err,
@@ -265,7 +263,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseTacacsExternalServersGetTacacsExternalServersSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -300,14 +298,14 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsExternalServersByName")
+		logDebugf(ctx, "Selected method: GetTacacsExternalServersByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.TacacsExternalServers.GetTacacsExternalServersByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServersByName", err,
@@ -315,7 +313,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenTacacsExternalServersGetTacacsExternalServersByNameItemName(response2.TacacsExternalServer)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -329,14 +327,14 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetTacacsExternalServersByID")
+		logDebugf(ctx, "Selected method: GetTacacsExternalServersByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.TacacsExternalServers.GetTacacsExternalServersByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsExternalServersByID", err,
@@ -344,7 +342,7 @@ func dataSourceTacacsExternalServersRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenTacacsExternalServersGetTacacsExternalServersByIDItemID(response3.TacacsExternalServer)
 		if err := d.Set("item_id", vItemID3); err != nil {
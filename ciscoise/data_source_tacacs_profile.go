@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -249,15 +247,15 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsProfile")
+		logDebugf(ctx, "Selected method: GetTacacsProfile")
 		queryParams1 := isegosdk.GetTacacsProfileQueryParams{}
 
 		if okPage {
@@ -271,7 +269,7 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsProfile", err,
@@ -279,7 +277,7 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseTacacsProfileGetTacacsProfileSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -314,14 +312,14 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsProfileByName")
+		logDebugf(ctx, "Selected method: GetTacacsProfileByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.TacacsProfile.GetTacacsProfileByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsProfileByName", err,
@@ -329,7 +327,7 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenTacacsProfileGetTacacsProfileByNameItemName(response2.TacacsProfile)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -343,14 +341,14 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetTacacsProfileByID")
+		logDebugf(ctx, "Selected method: GetTacacsProfileByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.TacacsProfile.GetTacacsProfileByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsProfileByID", err,
@@ -358,7 +356,7 @@ func dataSourceTacacsProfileRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenTacacsProfileGetTacacsProfileByIDItemID(response3.TacacsProfile)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -253,15 +251,15 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	method3 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 3 %v", method3)
+	logDebugf(ctx, "Selecting method. Method 3 %v", method3)
 
 	selectedMethod := pickMethod([][]bool{method1, method2, method3})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsServerSequence")
+		logDebugf(ctx, "Selected method: GetTacacsServerSequence")
 		queryParams1 := isegosdk.GetTacacsServerSequenceQueryParams{}
 
 		if okPage {
@@ -275,7 +273,7 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsServerSequence", err,
@@ -283,7 +281,7 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseTacacsServerSequenceGetTacacsServerSequenceSearchResultResources
 		for response1.SearchResult != nil && response1.SearchResult.Resources != nil && len(*response1.SearchResult.Resources) > 0 {
@@ -318,14 +316,14 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsServerSequenceByName")
+		logDebugf(ctx, "Selected method: GetTacacsServerSequenceByName")
 		vvName := vName.(string)
 
 		response2, restyResp2, err := client.TacacsServerSequence.GetTacacsServerSequenceByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsServerSequenceByName", err,
@@ -333,7 +331,7 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemName2 := flattenTacacsServerSequenceGetTacacsServerSequenceByNameItemName(response2.TacacsServerSequence)
 		if err := d.Set("item_name", vItemName2); err != nil {
@@ -347,14 +345,14 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 
 	}
 	if selectedMethod == 3 {
-		log.Printf("[DEBUG] Selected method: GetTacacsServerSequenceByID")
+		logDebugf(ctx, "Selected method: GetTacacsServerSequenceByID")
 		vvID := vID.(string)
 
 		response3, restyResp3, err := client.TacacsServerSequence.GetTacacsServerSequenceByID(vvID)
 
 		if err != nil || response3 == nil {
 			if restyResp3 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp3.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp3.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTacacsServerSequenceByID", err,
@@ -362,7 +360,7 @@ func dataSourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceD
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response3))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response3))
 
 		vItemID3 := flattenTacacsServerSequenceGetTacacsServerSequenceByIDItemID(response3.TacacsServerSequence)
 		if err := d.Set("item_id", vItemID3); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -127,19 +125,19 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 	vTaskID, okTaskID := d.GetOk("task_id")
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okTaskID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAllTaskStatus")
+		logDebugf(ctx, "Selected method: GetAllTaskStatus")
 
 		response1, restyResp1, err := client.Tasks.GetAllTaskStatus()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetAllTaskStatus", err,
@@ -147,7 +145,7 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenTasksGetAllTaskStatusItems(response1)
 		if err := d.Set("items", vItems1); err != nil {
@@ -161,14 +159,14 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTaskStatus")
+		logDebugf(ctx, "Selected method: GetTaskStatus")
 		vvTaskID := vTaskID.(string)
 
 		response2, restyResp2, err := client.Tasks.GetTaskStatus(vvTaskID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTaskStatus", err,
@@ -176,7 +174,7 @@ func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenTasksGetTaskStatusItem(response2)
 		if err := d.Set("item", vItem2); err != nil {
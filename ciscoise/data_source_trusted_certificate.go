@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -567,13 +565,13 @@ func dataSourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceDat
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTrustedCertificates")
+		logDebugf(ctx, "Selected method: GetTrustedCertificates")
 		queryParams1 := isegosdk.GetTrustedCertificatesQueryParams{}
 
 		if okPage {
@@ -599,7 +597,7 @@ func dataSourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceDat
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTrustedCertificates", err,
@@ -607,7 +605,7 @@ func dataSourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseCertificatesGetTrustedCertificatesResponse
 		for response1.Response != nil && len(*response1.Response) > 0 {
@@ -642,14 +640,14 @@ func dataSourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTrustedCertificateByID")
+		logDebugf(ctx, "Selected method: GetTrustedCertificateByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.Certificates.GetTrustedCertificateByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing GetTrustedCertificateByID", err,
@@ -657,7 +655,7 @@ func dataSourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenCertificatesGetTrustedCertificateByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
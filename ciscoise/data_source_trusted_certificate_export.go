@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,7 +39,7 @@ func dataSourceTrustedCertificateExportRead(ctx context.Context, d *schema.Resou
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: ExportTrustedCert")
+		logDebugf(ctx, "Selected method: ExportTrustedCert")
 		vvID := vID.(string)
 
 		response1, _, err := client.Certificates.ExportTrustedCert(vvID)
@@ -52,7 +50,7 @@ func dataSourceTrustedCertificateExportRead(ctx context.Context, d *schema.Resou
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response")
+		logDebugf(ctx, "Retrieved response")
 
 		vvDirpath := d.Get("dirpath").(string)
 		err = response1.SaveDownload(vvDirpath)
@@ -61,7 +59,7 @@ func dataSourceTrustedCertificateExportRead(ctx context.Context, d *schema.Resou
 				"Failure when downloading file", err))
 			return diags
 		}
-		log.Printf("[DEBUG] Downloaded file %s", vvDirpath)
+		logDebugf(ctx, "Downloaded file %s", vvDirpath)
 
 	}
 	return diags
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -234,13 +232,13 @@ func dataSourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceDa
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSgVnMappingList")
+		logDebugf(ctx, "Selected method: GetSgVnMappingList")
 		queryParams1 := isegosdk.GetSgVnMappingListQueryParams{}
 
 		if okPage {
@@ -266,7 +264,7 @@ func dataSourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceDa
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetSgVnMappingList", err,
@@ -274,7 +272,7 @@ func dataSourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenSgVnMappingGetSgVnMappingListItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -289,14 +287,14 @@ func dataSourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSgVnMappingByID")
+		logDebugf(ctx, "Selected method: GetSgVnMappingByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.SgVnMapping.GetSgVnMappingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetSgVnMappingByID", err,
@@ -304,7 +302,7 @@ func dataSourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceDa
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSgVnMappingGetSgVnMappingByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
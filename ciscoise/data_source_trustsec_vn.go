@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -214,13 +212,13 @@ func dataSourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m int
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetVirtualNetworkList")
+		logDebugf(ctx, "Selected method: GetVirtualNetworkList")
 		queryParams1 := isegosdk.GetVirtualNetworkListQueryParams{}
 
 		if okPage {
@@ -246,7 +244,7 @@ func dataSourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m int
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetVirtualNetworkList", err,
@@ -254,7 +252,7 @@ func dataSourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenVirtualNetworkGetVirtualNetworkListItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -269,14 +267,14 @@ func dataSourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetVirtualNetworkByID")
+		logDebugf(ctx, "Selected method: GetVirtualNetworkByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.VirtualNetwork.GetVirtualNetworkByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetVirtualNetworkByID", err,
@@ -284,7 +282,7 @@ func dataSourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenVirtualNetworkGetVirtualNetworkByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -254,13 +252,13 @@ func dataSourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.Resource
 	vID, okID := d.GetOk("id")
 
 	method1 := []bool{okPage, okSize, okSort, okSortBy, okFilter, okFilterType}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetVnVLANMappingList")
+		logDebugf(ctx, "Selected method: GetVnVLANMappingList")
 		queryParams1 := isegosdk.GetVnVLANMappingListQueryParams{}
 
 		if okPage {
@@ -286,7 +284,7 @@ func dataSourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.Resource
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetVnVLANMappingList", err,
@@ -294,7 +292,7 @@ func dataSourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenVnVLANMappingGetVnVLANMappingListItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
@@ -309,14 +307,14 @@ func dataSourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.Resource
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetVnVLANMappingByID")
+		logDebugf(ctx, "Selected method: GetVnVLANMappingByID")
 		vvID := vID.(string)
 
 		response2, restyResp2, err := client.VnVLANMapping.GetVnVLANMappingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetVnVLANMappingByID", err,
@@ -324,7 +322,7 @@ func dataSourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenVnVLANMappingGetVnVLANMappingByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
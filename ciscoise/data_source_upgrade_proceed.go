@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -71,7 +69,7 @@ func dataSourceUpgradeProceedRead(ctx context.Context, d *schema.ResourceData, m
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: StageStatus")
+		logDebugf(ctx, "Selected method: StageStatus")
 		queryParams1 := isegosdk.StageStatusQueryParams{}
 
 		if okPreCheckReportID {
@@ -82,7 +80,7 @@ func dataSourceUpgradeProceedRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 StageStatus", err,
@@ -90,7 +88,7 @@ func dataSourceUpgradeProceedRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenFullUpgradeStageStatusItems(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
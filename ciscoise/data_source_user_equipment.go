@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -268,13 +266,13 @@ func dataSourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m
 	vUserEquipmentID, okUserEquipmentID := d.GetOk("user_equipment_id")
 
 	method1 := []bool{okPage, okSize, okFilter, okFilterType, okSort, okSortBy}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okUserEquipmentID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipments")
+		logDebugf(ctx, "Selected method: GetUserEquipments")
 		queryParams1 := isegosdk.GetUserEquipmentsQueryParams{}
 
 		if okPage {
@@ -300,7 +298,7 @@ func dataSourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetUserEquipments", err,
@@ -308,7 +306,7 @@ func dataSourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		var items1 []isegosdk.ResponseUserEquipmentGetUserEquipmentsResponse
 		for response1.Response != nil && len(*response1.Response) > 0 {
@@ -343,14 +341,14 @@ func dataSourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipmentByID")
+		logDebugf(ctx, "Selected method: GetUserEquipmentByID")
 		vvUserEquipmentID := vUserEquipmentID.(string)
 
 		response2, restyResp2, err := client.UserEquipment.GetUserEquipmentByID(vvUserEquipmentID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetUserEquipmentByID", err,
@@ -358,7 +356,7 @@ func dataSourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenUserEquipmentGetUserEquipmentByIDItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
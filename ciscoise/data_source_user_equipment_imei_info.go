@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -90,14 +88,14 @@ func dataSourceUserEquipmentImeiInfoRead(ctx context.Context, d *schema.Resource
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipmentByIMEI")
+		logDebugf(ctx, "Selected method: GetUserEquipmentByIMEI")
 		vvImei := vImei.(string)
 
 		response1, restyResp1, err := client.UserEquipment.GetUserEquipmentByIMEI(vvImei)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetUserEquipmentByIMEI", err,
@@ -105,7 +103,7 @@ func dataSourceUserEquipmentImeiInfoRead(ctx context.Context, d *schema.Resource
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenUserEquipmentGetUserEquipmentByIMEIItemResponse(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -94,14 +92,14 @@ func dataSourceUserEquipmentSubscriberInfoRead(ctx context.Context, d *schema.Re
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipmentsBySubscriberID")
+		logDebugf(ctx, "Selected method: GetUserEquipmentsBySubscriberID")
 		vvSubscriberID := vSubscriberID.(string)
 
 		response1, restyResp1, err := client.UserEquipment.GetUserEquipmentsBySubscriberID(vvSubscriberID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing 2 GetUserEquipmentsBySubscriberID", err,
@@ -109,7 +107,7 @@ func dataSourceUserEquipmentSubscriberInfoRead(ctx context.Context, d *schema.Re
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItems1 := flattenUserEquipmentGetUserEquipmentsBySubscriberIDItemsResponse(response1.Response)
 		if err := d.Set("items", vItems1); err != nil {
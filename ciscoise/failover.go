@@ -0,0 +1,189 @@
+package ciscoise
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// notPrimaryResponse matches the errors returned by a secondary admin node
+// for operations that are only allowed on the primary PAN.
+var notPrimaryResponse = regexp.MustCompile(`(?i)(not (the |a )?primary|secondary (admin|pan|node)|only (allowed|supported|permitted) on (the |a )?primary)`)
+
+// NOT_PRIMARY_BODY_LIMIT is the size of the start of an error response
+// searched for notPrimaryResponse.
+const NOT_PRIMARY_BODY_LIMIT = 64 * 1024
+
+// failoverTransport sends the SDK requests to the active admin node and moves
+// to the next one of base_urls when a node cannot be reached or answers
+// that it is not the primary.
+type failoverTransport struct {
+	transport http.RoundTripper
+	hosts     []string
+
+	mutex  sync.Mutex
+	active int
+}
+
+func baseURLHosts(baseURLs []string) ([]string, error) {
+	hosts := []string{}
+	for _, baseURL := range baseURLs {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Hostname() == "" {
+			return nil, fmt.Errorf("invalid base URL %q, it must include the scheme, like https://ise.example.com", baseURL)
+		}
+		hosts = append(hosts, u.Hostname())
+	}
+	return hosts, nil
+}
+
+func newFailoverTransport(transport http.RoundTripper, hosts []string) *failoverTransport {
+	return &failoverTransport{transport: transport, hosts: hosts}
+}
+
+// failoverClient routes the requests of a resty client through the base_urls
+// nodes. Like the limiter, it must be called after any TLS or proxy setting.
+func failoverClient(client *resty.Client, hosts []string) *failoverTransport {
+	if len(hosts) < 2 {
+		return nil
+	}
+	transport := client.GetClient().Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	failover := newFailoverTransport(transport, hosts)
+	client.SetTransport(failover)
+	return failover
+}
+
+// ActiveHost returns the admin node currently serving the requests.
+func (t *failoverTransport) ActiveHost() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.hosts[t.active]
+}
+
+func (t *failoverTransport) activeIndex() int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.active
+}
+
+func (t *failoverTransport) setActive(ctx context.Context, index int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.active != index {
+		logWarnf(ctx, "[FAILOVER] Switching admin node from %s to %s", t.hosts[t.active], t.hosts[index])
+		t.active = index
+	}
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests addressed to other hosts are not part of the failover group
+	if req.URL.Hostname() != t.hosts[0] {
+		return t.transport.RoundTrip(req)
+	}
+	start := t.activeIndex()
+	var resp *http.Response
+	var err error
+	for i := 0; i < len(t.hosts); i++ {
+		index := (start + i) % len(t.hosts)
+		attempt, errAttempt := requestForHost(req, t.hosts[index], i > 0)
+		if errAttempt != nil {
+			closeResponse(resp)
+			return nil, errAttempt
+		}
+		// The response of the previous node is discarded
+		closeResponse(resp)
+		resp, err = t.transport.RoundTrip(attempt)
+		if !shouldFailover(resp, err) {
+			t.setActive(req.Context(), index)
+			logDebugf(req.Context(), "[FAILOVER] %s %s served by %s", req.Method, req.URL.Path, t.hosts[index])
+			return resp, err
+		}
+		if err != nil {
+			logWarnf(req.Context(), "[FAILOVER] Admin node %s is not reachable: %v", t.hosts[index], err)
+		} else {
+			logWarnf(req.Context(), "[FAILOVER] Admin node %s is not the primary node, it returned %s", t.hosts[index], resp.Status)
+		}
+	}
+	return resp, err
+}
+
+// requestForHost clones req for another admin node, keeping the port chosen
+// by the SDK for the API group.
+func requestForHost(req *http.Request, host string, replay bool) (*http.Request, error) {
+	attempt, err := cloneRequest(req, replay)
+	if err != nil {
+		return nil, err
+	}
+	if port := req.URL.Port(); port != "" {
+		attempt.URL.Host = net.JoinHostPort(host, port)
+	} else {
+		attempt.URL.Host = host
+	}
+	attempt.Host = attempt.URL.Host
+	return attempt, nil
+}
+
+// cloneRequest clones req, with a fresh copy of its body when the request is
+// sent again.
+func cloneRequest(req *http.Request, replay bool) (*http.Request, error) {
+	attempt := req.Clone(req.Context())
+	if replay && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("request body of %s %s cannot be replayed", req.Method, req.URL.Path)
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attempt.Body = body
+	}
+	return attempt, nil
+}
+
+func shouldFailover(resp *http.Response, err error) bool {
+	if err != nil {
+		return isConnectionFailure(err)
+	}
+	if resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return false
+	}
+	// Only the start of the body is read, the caller reads it whole
+	body, errRead := io.ReadAll(io.LimitReader(resp.Body, NOT_PRIMARY_BODY_LIMIT))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+	if errRead != nil {
+		return false
+	}
+	return notPrimaryResponse.Match(body)
+}
+
+func closeResponse(resp *http.Response) {
+	if resp != nil && resp.Body != nil {
+		resp.Body.Close()
+	}
+}
+
+// isConnectionFailure reports whether the request never reached the node, so
+// it is safe to send it to another one whatever its method is.
+func isConnectionFailure(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
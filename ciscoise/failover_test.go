@@ -0,0 +1,162 @@
+package ciscoise
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type stubNodeTransport struct {
+	down      map[string]bool
+	secondary map[string]bool
+	served    []string
+	bodies    []string
+	closed    int
+}
+
+// stubBody counts the response bodies closed by the caller.
+type stubBody struct {
+	io.Reader
+	stub *stubNodeTransport
+}
+
+func (b stubBody) Close() error {
+	b.stub.closed++
+	return nil
+}
+
+func (t *stubNodeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Hostname()
+	t.served = append(t.served, req.URL.Host)
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		t.bodies = append(t.bodies, string(body))
+	}
+	if t.down[host] {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: io.EOF}
+	}
+	if t.secondary[host] {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Status:     "400 Bad Request",
+			Body:       stubBody{strings.NewReader(`{"message":"This operation is only allowed on the Primary PAN"}`), t},
+			Request:    req,
+		}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: io.NopCloser(strings.NewReader("{}")), Request: req}, nil
+}
+
+func TestFailoverTransportUnreachableNode(t *testing.T) {
+	stub := &stubNodeTransport{down: map[string]bool{"pan1.example.com": true}}
+	failover := newFailoverTransport(stub, []string{"pan1.example.com", "pan2.example.com"})
+
+	req, _ := http.NewRequest(http.MethodPost, "https://pan1.example.com:9060/ers/config/networkdevice", strings.NewReader(`{"name":"nad"}`))
+	resp, err := failover.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.Request.URL.Host != "pan2.example.com:9060" {
+		t.Errorf("bad: expect pan2.example.com:9060 to serve the request, got %s", resp.Request.URL.Host)
+	}
+	if len(stub.bodies) != 2 || stub.bodies[1] != `{"name":"nad"}` {
+		t.Errorf("bad: expect the request body to be replayed, got %v", stub.bodies)
+	}
+	if failover.ActiveHost() != "pan2.example.com" {
+		t.Errorf("bad: expect pan2.example.com to be the active node, got %s", failover.ActiveHost())
+	}
+
+	// The next request goes directly to the active node
+	stub.served = nil
+	req, _ = http.NewRequest(http.MethodGet, "https://pan1.example.com/api/v1/deployment/node", nil)
+	if _, err := failover.RoundTrip(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(stub.served) != 1 || stub.served[0] != "pan2.example.com" {
+		t.Errorf("bad: expect only pan2.example.com to be called, got %v", stub.served)
+	}
+}
+
+func TestFailoverTransportSecondaryNode(t *testing.T) {
+	stub := &stubNodeTransport{secondary: map[string]bool{"pan1.example.com": true}}
+	failover := newFailoverTransport(stub, []string{"pan1.example.com", "pan2.example.com"})
+
+	req, _ := http.NewRequest(http.MethodPut, "https://pan1.example.com:9060/ers/config/networkdevice/1", strings.NewReader("{}"))
+	resp, err := failover.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Request.URL.Hostname() != "pan2.example.com" {
+		t.Errorf("bad: expect pan2.example.com to serve the request, got %s from %s", resp.Status, resp.Request.URL.Host)
+	}
+}
+
+func TestFailoverTransportAllNodesDown(t *testing.T) {
+	stub := &stubNodeTransport{down: map[string]bool{"pan1.example.com": true, "pan2.example.com": true}}
+	failover := newFailoverTransport(stub, []string{"pan1.example.com", "pan2.example.com"})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://pan1.example.com/api/v1/deployment/node", nil)
+	if _, err := failover.RoundTrip(req); err == nil {
+		t.Errorf("bad: expect an error when every node is down")
+	}
+	if len(stub.served) != 2 {
+		t.Errorf("bad: expect each node to be tried once, got %v", stub.served)
+	}
+}
+
+func TestFailoverTransportReplayFailure(t *testing.T) {
+	stub := &stubNodeTransport{secondary: map[string]bool{"pan1.example.com": true}}
+	failover := newFailoverTransport(stub, []string{"pan1.example.com", "pan2.example.com"})
+
+	req, _ := http.NewRequest(http.MethodPut, "https://pan1.example.com:9060/ers/config/networkdevice/1", strings.NewReader("{}"))
+	req.GetBody = nil
+	resp, err := failover.RoundTrip(req)
+	if err == nil || !strings.Contains(err.Error(), "cannot be replayed") {
+		t.Errorf("bad: expect the replay error, got %v", err)
+	}
+	if resp != nil {
+		t.Errorf("bad: expect no response, got %s", resp.Status)
+	}
+	if stub.closed != 1 {
+		t.Errorf("bad: expect the response of pan1.example.com to be closed, got %d closed", stub.closed)
+	}
+}
+
+func TestShouldFailoverLargeBody(t *testing.T) {
+	body := strings.Repeat("x", NOT_PRIMARY_BODY_LIMIT) + "only allowed on the Primary PAN"
+	resp := &http.Response{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(strings.NewReader(body))}
+	if shouldFailover(resp, nil) {
+		t.Errorf("bad: expect only the start of the body to be searched")
+	}
+	restored, err := io.ReadAll(resp.Body)
+	if err != nil || string(restored) != body {
+		t.Errorf("bad: expect the whole body to be restored, got %d bytes, %v", len(restored), err)
+	}
+}
+
+func TestFailoverTransportOtherHost(t *testing.T) {
+	stub := &stubNodeTransport{}
+	failover := newFailoverTransport(stub, []string{"pan1.example.com", "pan2.example.com"})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://other.example.com/api/v1/deployment/node", nil)
+	if _, err := failover.RoundTrip(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(stub.served) != 1 || stub.served[0] != "other.example.com" {
+		t.Errorf("bad: expect requests to other hosts to pass through, got %v", stub.served)
+	}
+}
+
+func TestBaseURLHosts(t *testing.T) {
+	hosts, err := baseURLHosts([]string{"https://pan1.example.com", "https://10.0.0.2"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(hosts) != 2 || hosts[0] != "pan1.example.com" || hosts[1] != "10.0.0.2" {
+		t.Errorf("bad: unexpected hosts %v", hosts)
+	}
+	if _, err := baseURLHosts([]string{"pan1.example.com"}); err == nil {
+		t.Errorf("bad: expect an error for a base URL without scheme")
+	}
+}
@@ -0,0 +1,141 @@
+package ciscoise
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// httpLogSubsystem is the terraform-plugin-log subsystem of the ISE API
+// calls, its level is set with TF_LOG_PROVIDER_CISCOISE_HTTP.
+const httpLogSubsystem = "ise_http"
+
+const httpLogLevelEnv = "TF_LOG_PROVIDER_CISCOISE_HTTP"
+
+func newHTTPLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv(httpLogLevelEnv))
+}
+
+// operationLogContextKey marks the context of a resource operation, set up
+// by logOperation.
+type operationLogContextKey struct{}
+
+// loggingTransport logs every request sent to ISE with its method, path,
+// status and duration. The requests of a resource operation are logged with
+// its context, the others with the context of the provider configuration.
+type loggingTransport struct {
+	transport http.RoundTripper
+	ctx       context.Context
+}
+
+// logClient routes the requests of a resty client through the HTTP logger.
+// It must be called after any TLS or proxy setting, as resty only applies
+// them to an *http.Transport.
+func logClient(ctx context.Context, client *resty.Client) {
+	if ctx == nil {
+		return
+	}
+	transport := client.GetClient().Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.SetTransport(&loggingTransport{transport: transport, ctx: newHTTPLogContext(ctx)})
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.ctx
+	if req.Context().Value(operationLogContextKey{}) != nil {
+		ctx = req.Context()
+	}
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	fields := map[string]interface{}{
+		"method":      req.Method,
+		"path":        req.URL.Path,
+		"node":        req.URL.Host,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = redactSecrets(err.Error())
+		tflog.SubsystemError(ctx, httpLogSubsystem, "ISE request failed", fields)
+		return resp, err
+	}
+	fields["status"] = resp.StatusCode
+	if resp.StatusCode >= http.StatusInternalServerError {
+		tflog.SubsystemWarn(ctx, httpLogSubsystem, "ISE request returned a server error", fields)
+	} else {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "ISE request", fields)
+	}
+	return resp, err
+}
+
+// logOperation logs the start and the end of a resource or data source
+// operation in the ise_http subsystem. The operation gets its own SDK client,
+// so the ISE requests in between are logged with the resource type and ID,
+// and canceled with the operation.
+func logOperation(name string, operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		ctx = newHTTPLogContext(ctx)
+		ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "tf_resource_type", name)
+		ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "tf_operation", operation)
+		if d.Id() != "" {
+			ctx = tflog.SubsystemSetField(ctx, httpLogSubsystem, "tf_resource_id", d.Id())
+		}
+		ctx = context.WithValue(ctx, operationLogContextKey{}, true)
+		if clientConfig, ok := m.(ClientConfig); ok && clientConfig.Config != nil && clientConfig.Config.transport != nil {
+			client, err := clientConfig.Config.NewOperationClient(ctx)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to create the client of the operation, using the provider client", map[string]interface{}{
+					"error": err.Error(),
+				})
+			} else {
+				clientConfig.Client = client
+				m = clientConfig
+			}
+		}
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "Starting operation", map[string]interface{}{
+			"tf_resource_id": d.Id(),
+		})
+		start := time.Now()
+		diags := f(ctx, d, m)
+		fields := map[string]interface{}{
+			"tf_resource_id": d.Id(),
+			"duration_ms":    time.Since(start).Milliseconds(),
+		}
+		if diags.HasError() {
+			tflog.SubsystemWarn(ctx, httpLogSubsystem, "Operation failed", fields)
+		} else {
+			tflog.SubsystemDebug(ctx, httpLogSubsystem, "Finished operation", fields)
+		}
+		return diags
+	}
+}
+
+// logResourceOperations wraps the CRUD functions of every resource and the
+// read of every data source with logOperation.
+func logResourceOperations(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(logOperation(name, "create", r.CreateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(logOperation(name, "read", r.ReadContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(logOperation(name, "update", r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(logOperation(name, "delete", r.DeleteContext))
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(logOperation(name, "read", r.ReadContext))
+		}
+	}
+}
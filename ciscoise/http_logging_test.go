@@ -0,0 +1,126 @@
+package ciscoise
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	t.Setenv(httpLogLevelEnv, "TRACE")
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	transport := &loggingTransport{transport: &stubNodeTransport{}, ctx: newHTTPLogContext(ctx)}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://pan1.example.com:9060/ers/config/networkdevice?page=1", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("bad: expect 1 log entry, got %v", entries)
+	}
+	entry := entries[0]
+	if entry["@module"] != "provider."+httpLogSubsystem {
+		t.Errorf("bad: expect the entry in the %s subsystem, got %v", httpLogSubsystem, entry["@module"])
+	}
+	if entry["method"] != "GET" || entry["path"] != "/ers/config/networkdevice" || entry["status"] != float64(200) {
+		t.Errorf("bad: unexpected fields %v", entry)
+	}
+	if _, ok := entry["duration_ms"]; !ok {
+		t.Errorf("bad: expect a duration_ms field, got %v", entry)
+	}
+}
+
+func TestLoggingTransportLevel(t *testing.T) {
+	t.Setenv(httpLogLevelEnv, "WARN")
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	transport := &loggingTransport{transport: &stubNodeTransport{}, ctx: newHTTPLogContext(ctx)}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://pan1.example.com/api/v1/deployment/node", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if strings.TrimSpace(output.String()) != "" {
+		t.Errorf("bad: expect successful requests to be filtered out at WARN, got %s", output.String())
+	}
+}
+
+// testClientConfig returns the configuration of a provider sending its
+// requests to baseURL, logging them with ctx.
+func testClientConfig(t *testing.T, ctx context.Context, baseURL string) ClientConfig {
+	for _, env := range []string{"ISE_BASE_URL", "ISE_USERNAME", "ISE_PASSWORD", "ISE_DEBUG", "ISE_SSL_VERIFY", "ISE_USE_API_GATEWAY", "ISE_USE_CSRF_TOKEN"} {
+		t.Setenv(env, "")
+	}
+	config := &Config{BaseURL: baseURL, Username: "admin", Password: "C1sco12345", logCtx: ctx}
+	client, err := config.NewClient()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return ClientConfig{Client: client, Config: config}
+}
+
+func TestLogOperationClient(t *testing.T) {
+	t.Setenv(httpLogLevelEnv, "TRACE")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	client := clientConfig.Client
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+	d.SetId("v2:name=test")
+
+	operation := logOperation("ciscoise_network_device", "read", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		operationClient := m.(ClientConfig).Client
+		if operationClient == client {
+			t.Errorf("bad: expect an operation client")
+		}
+		if _, err := operationClient.RestyClient().R().Get(server.URL + "/ers/config/networkdevice"); err != nil {
+			t.Errorf("err: %s", err)
+		}
+		return nil
+	})
+	operation(ctx, d, clientConfig)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	found := false
+	for _, entry := range entries {
+		if entry["@message"] == "ISE request" {
+			found = true
+			if entry["tf_resource_type"] != "ciscoise_network_device" || entry["tf_resource_id"] != "v2:name=test" || entry["path"] != "/ers/config/networkdevice" {
+				t.Errorf("bad: expect the request logged with the operation fields, got %v", entry)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("bad: expect the request to be logged, got %v", entries)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	operation = logOperation("ciscoise_network_device", "read", func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if _, err := m.(ClientConfig).Client.RestyClient().R().Get(server.URL + "/ers/config/networkdevice"); err == nil {
+			t.Errorf("bad: expect the request to be canceled with the operation")
+		}
+		return nil
+	})
+	operation(canceled, d, clientConfig)
+}
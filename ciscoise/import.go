@@ -0,0 +1,245 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Separator of the values of an import ID, e.g. the policy set name and the
+// rule name of Default/Basic_Authenticated_Access.
+const POLICY_RULE_IMPORT_SEPARATOR = "/"
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func isUUID(value string) bool {
+	return uuidRegexp.MatchString(value)
+}
+
+// isResourceID reports whether ID is a resource ID built by joinResourceID or
+// a legacy key:=value resource ID.
+func isResourceID(ID string) bool {
+	return strings.HasPrefix(ID, RESOURCE_ID_PREFIX) || isLegacyResourceID(ID)
+}
+
+// importKey is an attribute of the natural key accepted by an importer: its
+// param in the resource ID and the path of its configured value.
+type importKey struct {
+	param string
+	path  string
+}
+
+// importerKeys records the natural key accepted by the importers built by
+// importerByNameOrID, importerPolicyRule, importerGlobalRule and
+// importerByPath. Auto import reads it from the configuration of an object
+// which already exists.
+var importerKeys = struct {
+	sync.Mutex
+	keys map[*schema.ResourceImporter][]importKey
+}{keys: make(map[*schema.ResourceImporter][]importKey)}
+
+func registerImporter(stateContext schema.StateContextFunc, keys []importKey) *schema.ResourceImporter {
+	importer := &schema.ResourceImporter{StateContext: stateContext}
+	importerKeys.Lock()
+	defer importerKeys.Unlock()
+	importerKeys.keys[importer] = keys
+	return importer
+}
+
+// importKeysOf returns the natural key accepted by importer, none when it
+// was not built by the functions below.
+func importKeysOf(importer *schema.ResourceImporter) []importKey {
+	importerKeys.Lock()
+	defer importerKeys.Unlock()
+	return importerKeys.keys[importer]
+}
+
+// importerByNameOrID returns the importer of importStateByNameOrID, whose
+// natural key is nameKey, or idKey when the resource has no name.
+func importerByNameOrID(read schema.ReadContextFunc, idKey string, nameKey string) *schema.ResourceImporter {
+	key := nameKey
+	if key == "" {
+		key = idKey
+	}
+	return registerImporter(importStateByNameOrID(read, idKey, nameKey), []importKey{{key, "parameters.0." + key}})
+}
+
+// importerPolicyRule returns the importer of importStatePolicyRule, whose
+// natural key is the policy set and the name of the rule.
+func importerPolicyRule(read schema.ReadContextFunc, policySets func(client *isegosdk.Client) (map[string]string, error)) *schema.ResourceImporter {
+	return registerImporter(importStatePolicyRule(read, policySets), []importKey{
+		{"policy_id", "parameters.0.policy_id"},
+		{"name", "parameters.0.rule.0.name"},
+	})
+}
+
+// importerGlobalRule returns the importer of importStateByNameOrID for a
+// global exception rule, whose natural key is the name of the rule.
+func importerGlobalRule(read schema.ReadContextFunc) *schema.ResourceImporter {
+	return registerImporter(importStateByNameOrID(read, "id", "name"), []importKey{{"name", "parameters.0.rule.0.name"}})
+}
+
+// importerByPath returns the importer of importStateByPath, whose natural key
+// is keys.
+func importerByPath(read schema.ReadContextFunc, keys []string, idKey string) *schema.ResourceImporter {
+	var naturalKey []importKey
+	for _, key := range keys {
+		naturalKey = append(naturalKey, importKey{key, "parameters.0." + key})
+	}
+	return registerImporter(importStateByPath(read, keys, idKey), naturalKey)
+}
+
+// importStateByNameOrID returns an importer accepting a resource ID, a UUID or
+// a name. A UUID is read as idKey, any other value as nameKey; either key can
+// be empty when the resource has none. The resource is read to check that it
+// exists and its ID is completed with the other key of the item read.
+func importStateByNameOrID(read schema.ReadContextFunc, idKey string, nameKey string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if isResourceID(importID) {
+			return []*schema.ResourceData{d}, nil
+		}
+		params := make(map[string]string)
+		if idKey != "" && (nameKey == "" || isUUID(importID)) {
+			params[idKey] = importID
+		} else if nameKey != "" {
+			params[nameKey] = importID
+		} else {
+			return nil, fmt.Errorf("unexpected import ID %s, expected a resource ID", importID)
+		}
+		return importStateRead(ctx, d, m, read, importID, params, map[string][]string{
+			idKey:   {"item.0." + idKey, "parameters.0." + idKey},
+			nameKey: {"item.0." + nameKey, "parameters.0." + nameKey},
+		})
+	}
+}
+
+// importStatePolicyRule returns an importer of policy rules accepting a
+// resource ID or a policy set name and a rule name or ID, separated by
+// POLICY_RULE_IMPORT_SEPARATOR.
+func importStatePolicyRule(read schema.ReadContextFunc, policySets func(client *isegosdk.Client) (map[string]string, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if isResourceID(importID) {
+			return []*schema.ResourceData{d}, nil
+		}
+		clientConfig := m.(ClientConfig)
+		client := clientConfig.Client
+
+		policySetIDs, err := policySets(client)
+		if err != nil {
+			return nil, err
+		}
+		// Names can hold the separator, so the first policy set found wins
+		params := make(map[string]string)
+		for i := 0; i < len(importID) && params["policy_id"] == ""; i++ {
+			if !strings.HasPrefix(importID[i:], POLICY_RULE_IMPORT_SEPARATOR) {
+				continue
+			}
+			policyID, ok := policySetIDs[importID[:i]]
+			if !ok {
+				continue
+			}
+			rule := importID[i+len(POLICY_RULE_IMPORT_SEPARATOR):]
+			params["policy_id"] = policyID
+			if isUUID(rule) {
+				params["id"] = rule
+			} else {
+				params["name"] = rule
+			}
+		}
+		if params["policy_id"] == "" {
+			return nil, fmt.Errorf("unexpected import ID %s, expected policy_set_name%srule_name or a resource ID", importID, POLICY_RULE_IMPORT_SEPARATOR)
+		}
+		return importStateRead(ctx, d, m, read, importID, params, map[string][]string{
+			"id":   {"parameters.0.rule.0.id"},
+			"name": {"parameters.0.rule.0.name"},
+		})
+	}
+}
+
+// importStateByPath returns an importer accepting a resource ID or the values
+// of keys separated by POLICY_RULE_IMPORT_SEPARATOR, the last value holding
+// the rest of the import ID. When the last value is a UUID it is read as
+// idKey, if not empty.
+func importStateByPath(read schema.ReadContextFunc, keys []string, idKey string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if isResourceID(importID) {
+			return []*schema.ResourceData{d}, nil
+		}
+		values := strings.SplitN(importID, POLICY_RULE_IMPORT_SEPARATOR, len(keys))
+		if len(values) != len(keys) {
+			return nil, fmt.Errorf("unexpected import ID %s, expected %s or a resource ID", importID, strings.Join(keys, POLICY_RULE_IMPORT_SEPARATOR))
+		}
+		params := make(map[string]string)
+		for i, key := range keys {
+			params[key] = values[i]
+		}
+		last := keys[len(keys)-1]
+		if idKey != "" && isUUID(params[last]) {
+			params[idKey] = params[last]
+			delete(params, last)
+		}
+		return importStateRead(ctx, d, m, read, importID, params, nil)
+	}
+}
+
+// importStateRead reads the resource identified by params, then sets its ID
+// from params completed with the attributes read at the paths of each key.
+func importStateRead(ctx context.Context, d *schema.ResourceData, m interface{}, read schema.ReadContextFunc, importID string, params map[string]string, paths map[string][]string) ([]*schema.ResourceData, error) {
+	d.SetId(joinResourceID(params))
+	if diags := read(ctx, d, m); diags.HasError() {
+		return nil, fmt.Errorf("failure when reading %s: %s", importID, diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("cannot import non-existent remote object %s", importID)
+	}
+	for key, keyPaths := range paths {
+		if key == "" || params[key] != "" {
+			continue
+		}
+		for _, path := range keyPaths {
+			if v, ok := d.GetOk(path); ok && interfaceToString(v) != "" {
+				params[key] = interfaceToString(v)
+				break
+			}
+		}
+	}
+	d.SetId(joinResourceID(params))
+	return []*schema.ResourceData{d}, nil
+}
+
+func networkAccessPolicySetIDs(client *isegosdk.Client) (map[string]string, error) {
+	response, _, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
+	if err != nil {
+		return nil, err
+	}
+	policySetIDs := make(map[string]string)
+	if response != nil && response.Response != nil {
+		for _, item := range *response.Response {
+			policySetIDs[item.Name] = item.ID
+		}
+	}
+	return policySetIDs, nil
+}
+
+func deviceAdministrationPolicySetIDs(client *isegosdk.Client) (map[string]string, error) {
+	response, _, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySets()
+	if err != nil {
+		return nil, err
+	}
+	policySetIDs := make(map[string]string)
+	if response != nil && response.Response != nil {
+		for _, item := range *response.Response {
+			policySetIDs[item.Name] = item.ID
+		}
+	}
+	return policySetIDs, nil
+}
@@ -0,0 +1,98 @@
+package ciscoise
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testImportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"item": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// testImportRead reads the item named Site1 with ID
+// 01234567-89ab-cdef-0123-456789abcdef.
+func testImportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	params := separateResourceID(d.Id())
+	if params["name"] != "Site1" && params["id"] != "01234567-89ab-cdef-0123-456789abcdef" {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("item", []interface{}{map[string]interface{}{"id": "01234567-89ab-cdef-0123-456789abcdef", "name": "Site1"}})
+	return nil
+}
+
+func TestImportStateByNameOrID(t *testing.T) {
+	importer := importStateByNameOrID(testImportRead, "id", "name")
+	cases := map[string]string{
+		"Site1":                                "v2:id=01234567-89ab-cdef-0123-456789abcdef&name=Site1",
+		"01234567-89ab-cdef-0123-456789abcdef": "v2:id=01234567-89ab-cdef-0123-456789abcdef&name=Site1",
+		`id:=1234\name:=Site1`:                 `id:=1234\name:=Site1`,
+	}
+	for importID, expected := range cases {
+		d := schema.TestResourceDataRaw(t, testImportSchema(), nil)
+		d.SetId(importID)
+		result, err := importer(context.Background(), d, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if result[0].Id() != expected {
+			t.Errorf("bad: expect %s for %s, got %s", expected, importID, result[0].Id())
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, testImportSchema(), nil)
+	d.SetId("Site2")
+	if _, err := importer(context.Background(), d, nil); err == nil {
+		t.Errorf("bad: expect an error for a missing object")
+	}
+}
+
+func TestImportStateByPath(t *testing.T) {
+	var read map[string]string
+	importer := importStateByPath(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		read = separateResourceID(d.Id())
+		return nil
+	}, []string{"host_name", "name"}, "id")
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil)
+	d.SetId("ise1/Default self-signed server certificate/2")
+	if _, err := importer(context.Background(), d, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if read["host_name"] != "ise1" || read["name"] != "Default self-signed server certificate/2" {
+		t.Errorf("bad: unexpected params %v", read)
+	}
+
+	d.SetId("ise1/01234567-89ab-cdef-0123-456789abcdef")
+	if _, err := importer(context.Background(), d, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if read["id"] != "01234567-89ab-cdef-0123-456789abcdef" || read["name"] != "" {
+		t.Errorf("bad: unexpected params %v", read)
+	}
+
+	d.SetId("ise1")
+	if _, err := importer(context.Background(), d, nil); err == nil {
+		t.Errorf("bad: expect an error without a name")
+	}
+}
@@ -0,0 +1,51 @@
+package ciscoise
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// itemsByName indexes the items of a set attribute by their name attribute.
+func itemsByName(items *schema.Set) map[string]map[string]interface{} {
+	byName := make(map[string]map[string]interface{})
+	for _, item := range items.List() {
+		values := item.(map[string]interface{})
+		byName[interfaceToString(values["name"])] = values
+	}
+	return byName
+}
+
+// diffItemsByName returns the sorted names of the items to create, update
+// and delete. An item without ID is created.
+func diffItemsByName(prior map[string]map[string]interface{}, planned map[string]map[string]interface{}, ids map[string]string) ([]string, []string, []string) {
+	var create, update, remove []string
+	for name, item := range planned {
+		if ids[name] == "" {
+			create = append(create, name)
+		} else if !reflect.DeepEqual(prior[name], item) {
+			update = append(update, name)
+		}
+	}
+	for name := range prior {
+		if _, ok := planned[name]; !ok && ids[name] != "" {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(create)
+	sort.Strings(update)
+	sort.Strings(remove)
+	return create, update, remove
+}
+
+// refreshDeclared sets key of item to the value read when item declares
+// one. It is used for the values ISE fills in by itself when they are not
+// sent, which would otherwise show as a change on every plan.
+func refreshDeclared(item map[string]interface{}, key string, value interface{}) {
+	v := reflect.ValueOf(item[key])
+	if !v.IsValid() || v.IsZero() || (v.Kind() == reflect.Slice && v.Len() == 0) {
+		return
+	}
+	item[key] = value
+}
@@ -0,0 +1,171 @@
+package ciscoise
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// requestLimiter bounds the number of in-flight requests and the request
+// rate for every ISE host, it is shared by all the clients of a provider.
+type requestLimiter struct {
+	maxConcurrentRequests int
+	requestsPerSecond     int
+
+	mutex sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+type hostLimiter struct {
+	slots    chan struct{}
+	interval time.Duration
+
+	mutex sync.Mutex
+	next  time.Time
+}
+
+func newRequestLimiter(maxConcurrentRequests int, requestsPerSecond int) *requestLimiter {
+	if maxConcurrentRequests <= 0 && requestsPerSecond <= 0 {
+		return nil
+	}
+	return &requestLimiter{
+		maxConcurrentRequests: maxConcurrentRequests,
+		requestsPerSecond:     requestsPerSecond,
+		hosts:                 make(map[string]*hostLimiter),
+	}
+}
+
+func (l *requestLimiter) host(host string) *hostLimiter {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	h, ok := l.hosts[host]
+	if !ok {
+		h = &hostLimiter{}
+		if l.maxConcurrentRequests > 0 {
+			h.slots = make(chan struct{}, l.maxConcurrentRequests)
+		}
+		if l.requestsPerSecond > 0 {
+			h.interval = time.Second / time.Duration(l.requestsPerSecond)
+		}
+		l.hosts[host] = h
+	}
+	return h
+}
+
+// acquire blocks until a request to host is allowed to start, the returned
+// function must be called once the request is done.
+func (l *requestLimiter) acquire(ctx context.Context, host string) (func(), error) {
+	h := l.host(host)
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if h.slots != nil {
+			<-h.slots
+		}
+	}
+	if h.interval > 0 {
+		h.mutex.Lock()
+		now := time.Now()
+		start := h.next
+		if start.Before(now) {
+			start = now
+		}
+		h.next = start.Add(h.interval)
+		h.mutex.Unlock()
+		if wait := start.Sub(now); wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	return release, nil
+}
+
+// limitClient routes the requests of a resty client through the limiter.
+// It must be called after any TLS or proxy setting, as resty only applies
+// them to an *http.Transport.
+func (l *requestLimiter) limitClient(client *resty.Client) {
+	if l == nil {
+		return
+	}
+	transport := client.GetClient().Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.SetTransport(&limitedTransport{transport: transport, limiter: l})
+}
+
+type limitedTransport struct {
+	transport http.RoundTripper
+	limiter   *requestLimiter
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	// Keep the slot until the body has been consumed, closed or the request
+	// is canceled
+	body := &releaseOnClose{ReadCloser: resp.Body, release: release, done: make(chan struct{})}
+	if ctx := req.Context(); ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				body.releaseOnce()
+			case <-body.done:
+			}
+		}()
+	}
+	resp.Body = body
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+	done    chan struct{}
+}
+
+func (b *releaseOnClose) releaseOnce() {
+	b.once.Do(func() {
+		b.release()
+		close(b.done)
+	})
+}
+
+// Read releases the slot once the body is fully read or fails, for the
+// callers that never close it.
+func (b *releaseOnClose) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.releaseOnce()
+	}
+	return n, err
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.releaseOnce()
+	return err
+}
@@ -0,0 +1,135 @@
+package ciscoise
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiterDisabled(t *testing.T) {
+	if newRequestLimiter(0, 0) != nil {
+		t.Errorf("bad: expect newRequestLimiter to return nil without limits")
+	}
+}
+
+func TestRequestLimiterMaxConcurrentRequests(t *testing.T) {
+	limiter := newRequestLimiter(2, 0)
+	var current, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background(), "ise.example.com:9060")
+			if err != nil {
+				t.Errorf("bad: unexpected error %v", err)
+				return
+			}
+			n := atomic.AddInt32(&current, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&current, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+	if peak > 2 {
+		t.Errorf("bad: expect at most 2 concurrent requests, got %d", peak)
+	}
+}
+
+func TestRequestLimiterPerHost(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+	release, err := limiter.acquire(context.Background(), "pan1.example.com:443")
+	if err != nil {
+		t.Fatalf("bad: unexpected error %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	releaseOther, err := limiter.acquire(ctx, "pan2.example.com:443")
+	if err != nil {
+		t.Fatalf("bad: expect a different host to have its own limit, got %v", err)
+	}
+	releaseOther()
+
+	if _, err := limiter.acquire(ctx, "pan1.example.com:443"); err == nil {
+		t.Errorf("bad: expect acquire to fail when the context is done")
+	}
+}
+
+func TestRequestLimiterRequestsPerSecond(t *testing.T) {
+	limiter := newRequestLimiter(0, 50)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		release, err := limiter.acquire(context.Background(), "ise.example.com:443")
+		if err != nil {
+			t.Fatalf("bad: unexpected error %v", err)
+		}
+		release()
+	}
+	// 5 requests at 50 per second are spaced by 20ms
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("bad: expect requests to be paced, took %s", elapsed)
+	}
+}
+
+type testRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLimitedTransportRelease(t *testing.T) {
+	limiter := newRequestLimiter(1, 0)
+	transport := &limitedTransport{limiter: limiter, transport: testRoundTripper(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	})}
+	acquired := func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		release, err := limiter.acquire(ctx, "ise.example.com:443")
+		if err != nil {
+			return false
+		}
+		release()
+		return true
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://ise.example.com:443/ers/config/op/systemconfig/iseversion", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("bad: unexpected error %v", err)
+	}
+	if acquired() {
+		t.Errorf("bad: expect the slot to be held until the body is read")
+	}
+	if _, err := io.ReadAll(resp.Body); err != nil {
+		t.Fatalf("bad: unexpected error %v", err)
+	}
+	if !acquired() {
+		t.Errorf("bad: expect the slot to be released once the body is read")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err = transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("bad: unexpected error %v", err)
+	}
+	cancel()
+	if !acquired() {
+		t.Errorf("bad: expect the slot to be released once the request is canceled")
+	}
+	resp.Body.Close()
+}
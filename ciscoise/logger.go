@@ -1,8 +1,12 @@
 package ciscoise
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 func createLogger() *logger {
@@ -10,18 +14,39 @@ func createLogger() *logger {
 	return l
 }
 
+// logger adapts resty to the standard logger. Resty and the transports log
+// below the resource operations, without their context.
 type logger struct {
 	l *log.Logger
 }
 
 func (l *logger) Errorf(format string, v ...interface{}) {
-	log.Printf("[ERROR] [RESTY] "+format, v...)
+	log.Printf("[ERROR] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
 }
 
 func (l *logger) Warnf(format string, v ...interface{}) {
-	log.Printf("[WARN] [RESTY] "+format, v...)
+	log.Printf("[WARN] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
 }
 
 func (l *logger) Debugf(format string, v ...interface{}) {
-	log.Printf("[DEBUG] [RESTY] "+format, v...)
+	log.Printf("[DEBUG] [RESTY] %s", redactSecrets(fmt.Sprintf(format, v...)))
+}
+
+// logDebugf writes to the provider logger of ctx, so the entry carries the
+// fields of the resource operation. Secrets are redacted as in the standard
+// logger.
+func logDebugf(ctx context.Context, format string, v ...interface{}) {
+	tflog.Debug(ctx, redactSecrets(fmt.Sprintf(format, v...)))
+}
+
+func logInfof(ctx context.Context, format string, v ...interface{}) {
+	tflog.Info(ctx, redactSecrets(fmt.Sprintf(format, v...)))
+}
+
+func logWarnf(ctx context.Context, format string, v ...interface{}) {
+	tflog.Warn(ctx, redactSecrets(fmt.Sprintf(format, v...)))
+}
+
+func logErrorf(ctx context.Context, format string, v ...interface{}) {
+	tflog.Error(ctx, redactSecrets(fmt.Sprintf(format, v...)))
 }
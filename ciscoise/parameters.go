@@ -0,0 +1,379 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Resources which manage objects with parameters, but set them on read
+// without configuredParameters.
+var unconfiguredParametersResources = map[string]bool{
+	"ciscoise_endpoints_bulk":       true,
+	"ciscoise_licensing_tier_state": true,
+	"ciscoise_node_group_node":      true,
+	"ciscoise_px_grid_node":         true,
+	"ciscoise_trusted_certificate":  true,
+}
+
+// configuredParametersResources adds configured_parameters to the resources
+// managing objects with parameters and records in it, on plan, the paths of
+// the parameters set in the configuration. ISE returns every attribute, so
+// the configuration is the only way to tell an explicit zero value from a
+// server default.
+func configuredParametersResources(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if r.Schema["parameters"] == nil || r.Importer == nil || r.UpdateContext == nil || unconfiguredParametersResources[name] {
+			continue
+		}
+		r.Schema["configured_parameters"] = &schema.Schema{
+			Description: `Paths of the parameters set in the configuration, the only ones refreshed on read.`,
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+		r.CustomizeDiff = configuredParametersCustomizeDiff(r.CustomizeDiff)
+	}
+}
+
+// configuredParametersCustomizeDiff plans the configured paths when they
+// change. A state written before configured_parameters keeps it empty until
+// the parameters change, its read falling back to the non-empty values.
+func configuredParametersCustomizeDiff(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+		var recorded []string
+		if set, ok := d.Get("configured_parameters").(*schema.Set); ok {
+			for _, path := range set.List() {
+				recorded = append(recorded, interfaceToString(path))
+			}
+		}
+		if d.Id() != "" && len(recorded) == 0 && !d.HasChange("parameters") {
+			return nil
+		}
+		paths := configuredPaths(config.GetAttr("parameters"), "parameters")
+		sort.Strings(paths)
+		sort.Strings(recorded)
+		if len(paths) == len(recorded) && (len(paths) == 0 || reflect.DeepEqual(paths, recorded)) {
+			return nil
+		}
+		logDebugf(ctx, "Configured parameters %v", paths)
+		return d.SetNew("configured_parameters", paths)
+	}
+}
+
+// configuredPaths returns the paths of the values set in value, the
+// configuration of the attribute at path. Unknown values count as set, and
+// sets, maps and lists of primitive values are recorded as a whole.
+func configuredPaths(value cty.Value, path string) []string {
+	if value.IsNull() {
+		return nil
+	}
+	if !value.IsKnown() {
+		return []string{path}
+	}
+	t := value.Type()
+	switch {
+	case t.IsObjectType():
+		var paths []string
+		for name := range t.AttributeTypes() {
+			paths = append(paths, configuredPaths(value.GetAttr(name), path+"."+name)...)
+		}
+		return paths
+	case t.IsListType() && t.ElementType().IsObjectType():
+		var paths []string
+		for i, item := range value.AsValueSlice() {
+			paths = append(paths, configuredPaths(item, fmt.Sprintf("%s.%d", path, i))...)
+		}
+		return paths
+	}
+	return []string{path}
+}
+
+// parameterPaths is a set of configured paths.
+type parameterPaths map[string]bool
+
+// covers reports whether path or one of its parents was configured.
+func (p parameterPaths) covers(path string) bool {
+	for {
+		if p[path] {
+			return true
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
+
+// configured reports whether any value at or below path was configured.
+func (p parameterPaths) configured(path string) bool {
+	if p.covers(path) {
+		return true
+	}
+	for configured := range p {
+		if strings.HasPrefix(configured, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// configuredParameters returns the parameters to set on read from the
+// flattened response. Only the configured attributes are taken from the
+// response, and a value equal to the prior one, like True for true, keeps the
+// prior value; the attributes left to the server defaults keep their prior
+// value. A configured attribute the response does not hold is unset, unless
+// it is a write-only secret, which keeps the prior value. Without configured
+// parameters, after an import, the whole response is returned.
+func configuredParameters(d *schema.ResourceData, remote interface{}) interface{} {
+	remote = normalizeFlattened(remote)
+	prior := d.Get("parameters")
+	paths := make(parameterPaths)
+	if set, ok := d.Get("configured_parameters").(*schema.Set); ok {
+		for _, path := range set.List() {
+			paths[interfaceToString(path)] = true
+		}
+	}
+	if len(paths) == 0 {
+		// State written before configured_parameters
+		for _, path := range nonEmptyPaths(prior, "parameters") {
+			paths[path] = true
+		}
+	}
+	if len(paths) == 0 {
+		return remote
+	}
+	return mergeConfigured(prior, remote, "parameters", paths)
+}
+
+func mergeConfigured(prior interface{}, remote interface{}, path string, paths parameterPaths) interface{} {
+	if _, ok := leafString(remote); !ok {
+		if isWriteOnlyParameter(path) {
+			return prior
+		}
+		return remote
+	}
+	switch p := prior.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+		merged := make(map[string]interface{}, len(p))
+		for key, value := range p {
+			if !paths.configured(path + "." + key) {
+				merged[key] = value
+				continue
+			}
+			merged[key] = mergeConfigured(value, r[key], path+"."+key, paths)
+		}
+		return merged
+	case *schema.Set:
+		if semanticEqual(p.List(), remote) {
+			return p
+		}
+		return remote
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok {
+			return remote
+		}
+		if semanticEqual(p, r) {
+			return p
+		}
+		merged := make([]interface{}, 0, len(r))
+		for i, value := range r {
+			if i < len(p) {
+				value = mergeConfigured(p[i], value, fmt.Sprintf("%s.%d", path, i), paths)
+			}
+			merged = append(merged, value)
+		}
+		return merged
+	}
+	if semanticEqual(prior, remote) {
+		return prior
+	}
+	return remote
+}
+
+// isWriteOnlyParameter reports whether the attribute at path is named like
+// one of writeOnlySecrets, which ISE never returns.
+func isWriteOnlyParameter(path string) bool {
+	name := path[strings.LastIndex(path, ".")+1:]
+	for _, secrets := range writeOnlySecrets {
+		for _, secret := range secrets {
+			if strings.HasSuffix(secret, "."+name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nonEmptyPaths returns the paths of the non-empty values within value, as
+// returned by d.Get for the attribute at path.
+func nonEmptyPaths(value interface{}, path string) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var paths []string
+		for key, item := range v {
+			paths = append(paths, nonEmptyPaths(item, path+"."+key)...)
+		}
+		return paths
+	case []interface{}:
+		var paths []string
+		for i, item := range v {
+			if _, ok := item.(map[string]interface{}); !ok {
+				return []string{path}
+			}
+			paths = append(paths, nonEmptyPaths(item, fmt.Sprintf("%s.%d", path, i))...)
+		}
+		return paths
+	}
+	if !isConfigured(value) {
+		return nil
+	}
+	return []string{path}
+}
+
+// isConfigured reports whether value holds any non-empty value.
+func isConfigured(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case map[string]interface{}:
+		for _, item := range v {
+			if isConfigured(item) {
+				return true
+			}
+		}
+		return false
+	case *schema.Set:
+		return v.Len() > 0
+	case []interface{}:
+		return len(v) > 0
+	}
+	return !reflect.ValueOf(value).IsZero()
+}
+
+// semanticEqual compares values the way ISE returns them: booleans and
+// numbers whatever their type and case, and MAC addresses whatever their
+// format.
+func semanticEqual(prior interface{}, remote interface{}) bool {
+	prior, remote = normalizeFlattened(prior), normalizeFlattened(remote)
+	switch p := prior.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range p {
+			if isConfigured(value) && !semanticEqual(value, r[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(p) != len(r) {
+			return false
+		}
+		for i := range p {
+			if !semanticEqual(p[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	priorValue, okPrior := leafString(prior)
+	remoteValue, okRemote := leafString(remote)
+	if !okPrior || !okRemote {
+		return okPrior == okRemote
+	}
+	if priorValue == remoteValue {
+		return true
+	}
+	if priorBool, err := strconv.ParseBool(priorValue); err == nil {
+		remoteBool, err := strconv.ParseBool(remoteValue)
+		return err == nil && priorBool == remoteBool
+	}
+	if priorNumber, err := strconv.ParseFloat(priorValue, 64); err == nil {
+		remoteNumber, err := strconv.ParseFloat(remoteValue, 64)
+		return err == nil && priorNumber == remoteNumber
+	}
+	if macRegexp.MatchString(normalizeMacAddress(priorValue)) {
+		return normalizeMacAddress(priorValue) == normalizeMacAddress(remoteValue)
+	}
+	return strings.TrimSpace(priorValue) == strings.TrimSpace(remoteValue)
+}
+
+var macRegexp = regexp.MustCompile(`^[0-9a-f]{12}$`)
+
+// leafString formats a value, dereferencing pointers. It returns false for
+// nil values.
+func leafString(value interface{}) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	return fmt.Sprint(v.Interface()), true
+}
+
+// normalizeFlattened converts the slices and maps returned by the flatten
+// functions, like []map[string]interface{}, to []interface{} and
+// map[string]interface{}, the types of d.Get.
+func normalizeFlattened(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if set, ok := value.(*schema.Set); ok {
+		return normalizeFlattened(set.List())
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return value
+		}
+		list := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			list = append(list, normalizeFlattened(v.Index(i).Interface()))
+		}
+		return list
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return value
+		}
+		m := make(map[string]interface{}, v.Len())
+		for _, key := range v.MapKeys() {
+			m[key.String()] = normalizeFlattened(v.MapIndex(key).Interface())
+		}
+		return m
+	}
+	return value
+}
@@ -0,0 +1,203 @@
+package ciscoise
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testParametersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"description": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"enabled": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"coa_port": &schema.Schema{
+						Type:     schema.TypeInt,
+						Optional: true,
+						Computed: true,
+					},
+					"password": &schema.Schema{
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"ip_list": &schema.Schema{
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ipaddress": &schema.Schema{
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"mask": &schema.Schema{
+									Type:     schema.TypeInt,
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConfiguredParameters(t *testing.T) {
+	coaPort := 1700
+	remote := []map[string]interface{}{{
+		"name":        "switch1",
+		"description": "set in the GUI",
+		"enabled":     "True",
+		"coa_port":    &coaPort,
+		"ip_list":     []map[string]interface{}{{"ipaddress": "10.0.0.2", "mask": 32}},
+	}}
+
+	d := schema.TestResourceDataRaw(t, testParametersSchema(), map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{
+			"name":     "switch1",
+			"enabled":  "true",
+			"password": "secret",
+			"ip_list":  []interface{}{map[string]interface{}{"ipaddress": "10.0.0.1"}},
+		}},
+	})
+	if err := d.Set("parameters", configuredParameters(d, remote)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := map[string]interface{}{
+		"name":        "switch1",
+		"description": "",
+		"enabled":     "true",
+		"coa_port":    0,
+		"password":    "secret",
+		"ip_list":     []interface{}{map[string]interface{}{"ipaddress": "10.0.0.2", "mask": 0}},
+	}
+	if parameters := d.Get("parameters.0"); !reflect.DeepEqual(parameters, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, parameters)
+	}
+
+	imported := schema.TestResourceDataRaw(t, testParametersSchema(), nil)
+	if err := imported.Set("parameters", configuredParameters(imported, remote)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if description := imported.Get("parameters.0.description"); description != "set in the GUI" {
+		t.Errorf("bad: expect the whole response after an import, got %v", description)
+	}
+}
+
+func TestConfiguredParametersPaths(t *testing.T) {
+	coaPort := 1700
+	remote := []map[string]interface{}{{
+		"name":     "switch1",
+		"enabled":  "True",
+		"coa_port": &coaPort,
+	}}
+
+	parametersSchema := testParametersSchema()
+	parametersSchema["configured_parameters"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	d := schema.TestResourceDataRaw(t, parametersSchema, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{
+			"name":        "switch1",
+			"description": "configured",
+			"coa_port":    0,
+			"password":    "secret",
+		}},
+	})
+	if err := d.Set("configured_parameters", []interface{}{
+		"parameters.0.name", "parameters.0.description", "parameters.0.coa_port", "parameters.0.password",
+	}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := d.Set("parameters", configuredParameters(d, remote)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := map[string]interface{}{
+		// Configured to zero, refreshed
+		"coa_port": 1700,
+		// Configured, but no longer returned
+		"description": "",
+		// Write-only
+		"password": "secret",
+		"name":     "switch1",
+		"enabled":  "",
+		"ip_list":  []interface{}{},
+	}
+	if parameters := d.Get("parameters.0"); !reflect.DeepEqual(parameters, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, parameters)
+	}
+}
+
+func TestConfiguredPaths(t *testing.T) {
+	config := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("switch1"),
+		"coa_port": cty.NumberIntVal(0),
+		"enabled":  cty.NullVal(cty.String),
+		"groups":   cty.ListVal([]cty.Value{cty.StringVal("Location#All Locations")}),
+		"profile":  cty.UnknownVal(cty.String),
+		"ip_list": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"ipaddress": cty.StringVal("10.0.0.1"),
+			"mask":      cty.NullVal(cty.Number),
+		})}),
+	})})
+	paths := configuredPaths(config, "parameters")
+	sort.Strings(paths)
+	expected := []string{
+		"parameters.0.coa_port",
+		"parameters.0.groups",
+		"parameters.0.ip_list.0.ipaddress",
+		"parameters.0.name",
+		"parameters.0.profile",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, paths)
+	}
+}
+
+func TestSemanticEqual(t *testing.T) {
+	port := 1812
+	cases := []struct {
+		prior, remote interface{}
+		expected      bool
+	}{
+		{"true", "True", true},
+		{"true", "false", false},
+		{1812, &port, true},
+		{"1812", 1813, false},
+		{"00:11:22:33:44:AA", "00-11-22-33-44-aa", true},
+		{"Site1", "Site2", false},
+		{[]interface{}{"a", "b"}, []string{"a", "b"}, true},
+		{[]interface{}{"a"}, []string{"a", "b"}, false},
+	}
+	for _, c := range cases {
+		if semanticEqual(c.prior, c.remote) != c.expected {
+			t.Errorf("bad: expect %v comparing %v and %v", c.expected, c.prior, c.remote)
+		}
+	}
+}
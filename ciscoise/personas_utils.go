@@ -3,10 +3,9 @@ package ciscoise
 import (
 	"archive/zip"
 	"bytes"
-	"crypto/tls"
+	"context"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"strings"
 	"unicode/utf8"
 
@@ -30,19 +29,29 @@ type Node struct {
 	Roles    []string `json:"roles,omitempty"`    // Roles can be empty or have many values for a node.
 	Services []string `json:"services,omitempty"` // Services can be empty or have many values for a node.
 	UserName string   `json:"userName,omitempty"` //
+
+	config *Config         // Provider configuration shared by the clients created for this node.
+	ctx    context.Context // Context of the resource operation, for the requests and logs of this node.
 }
 
 // *********************************************Node Methods*******************************************************
+func (node Node) context() context.Context {
+	if node.ctx == nil {
+		return context.Background()
+	}
+	return node.ctx
+}
+
 func (node Node) IsStandAlone() (bool, error) {
 	path := fmt.Sprintf("https://%s/api/v1/deployment/node/%s", node.Ip, node.HostName)
 
-	log.Printf("[DEBUG] My Path %s", path)
+	logDebugf(node.context(), "My Path %s", path)
 
-	response, resty, err := customGetNode(path, node.UserName, node.Password, true)
+	response, resty, err := customGetNode(node.context(), node.config, path, node.UserName, node.Password, true)
 
 	if err != nil || response == nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			logDebugf(node.context(), "Retrieved error response %s", resty.String())
 		}
 		return false, err
 	}
@@ -57,11 +66,11 @@ func (node Node) IsStandAlone() (bool, error) {
 
 func (node Node) AppServerIsRunning() (bool, error) {
 	path := fmt.Sprintf("https://%s/ers/config/op/systemconfig/iseversion", node.Ip)
-	_, resty, err := customGet(path, node.UserName, node.Password, false)
+	_, resty, err := customGet(node.context(), node.config, path, node.UserName, node.Password, false)
 
 	if err != nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			logDebugf(node.context(), "Retrieved error response %s", resty.String())
 		}
 		return false, err
 	}
@@ -71,11 +80,11 @@ func (node Node) AppServerIsRunning() (bool, error) {
 
 func (node Node) ReturnIdOfCertificate() (*string, error) {
 	path := fmt.Sprintf("https://%s/api/v1/certs/system-certificate/%s", node.Ip, node.HostName)
-	_, resty, err := customGetCerts(path, node.UserName, node.Password, false)
+	_, resty, err := customGetCerts(node.context(), node.config, path, node.UserName, node.Password, false)
 
 	if err != nil {
 		if resty != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", resty.String())
+			logDebugf(node.context(), "Retrieved error response %s", resty.String())
 		}
 		return nil, err
 	}
@@ -106,7 +115,7 @@ func (node Node) RegisterToPrimary(primary Node) error {
 		Roles:           node.Roles,
 		Services:        node.Services,
 	}
-	_, err := customPost(path, node.UserName, node.Password, request)
+	_, err := customPost(node.context(), node.config, path, node.UserName, node.Password, request)
 
 	return err
 }
@@ -116,13 +125,13 @@ func (node Node) UpdateRolesServices() error {
 	request := isegosdk.RequestNodeDeploymentRegisterNode{}
 	request.Roles = node.Roles
 	request.Services = node.Services
-	_, err := customPut(path, node.UserName, node.HostName, request)
+	_, err := customPut(node.context(), node.config, path, node.UserName, node.HostName, request)
 
 	return err
 }
 
 func (node Node) ImportCertificateIntoPrimary(primary Node) error {
-	log.Printf("[DEBUG] ImportCertificateIntoPrimary 1")
+	logDebugf(node.context(), "ImportCertificateIntoPrimary 1")
 	certId, err := node.ReturnIdOfCertificate()
 	if err != nil && certId != nil {
 		return err
@@ -134,7 +143,7 @@ func (node Node) ImportCertificateIntoPrimary(primary Node) error {
 
 	path := fmt.Sprintf("https://%s/api/v1/certs/system-certificate/export", node.Ip)
 
-	response, err := customPost(path, node.UserName, node.Password, exportRequest)
+	response, err := customPost(node.context(), node.config, path, node.UserName, node.Password, exportRequest)
 
 	fdownload := isegosdk.FileDownload{}
 	if err != nil {
@@ -179,7 +188,7 @@ func (node Node) ImportCertificateIntoPrimary(primary Node) error {
 		ValidateCertificateExtensions:     &validateCertificateExtensions,
 	}
 	path = fmt.Sprintf("https://%s/api/v1/certs/trusted-certificate/import", primary.Ip)
-	_, err = customPost(path, node.UserName, node.Password, request)
+	_, err = customPost(node.context(), node.config, path, node.UserName, node.Password, request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +199,7 @@ func (node Node) ImportCertificateIntoPrimary(primary Node) error {
 func (node Node) PromoteToPrimary() error {
 	path := fmt.Sprintf("https://%s/api/v1/deployment/primary", node.Ip)
 
-	_, err := customPostWithNoBody(path, node.UserName, node.Password)
+	_, err := customPostWithNoBody(node.context(), node.config, path, node.UserName, node.Password)
 	if err != nil {
 		if err.Error() == "error with operation customPost." {
 			return fmt.Errorf("Could not update node to PRIMARY")
@@ -212,12 +221,22 @@ func decodeUtf8(b []byte) string {
 }
 
 // *********************************************API FUNCS********************************************************
-func customGet(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+func newPersonasRestyClient(config *Config, username string, password string) (*resty.Client, error) {
+	client, err := config.NewNodeClient()
+	if err != nil {
+		return nil, err
+	}
 	client.SetBasicAuth(username, password)
+	return client, nil
+}
+
+func customGet(ctx context.Context, config *Config, path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetError(&Error).
@@ -233,12 +252,13 @@ func customGet(path string, username string, password string, castResult bool) (
 	return nil, response, err
 }
 
-func customGetCerts(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
+func customGetCerts(ctx context.Context, config *Config, path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetResult(&isegosdk.ResponseCertificatesGetSystemCertificates{}).
@@ -256,12 +276,13 @@ func customGetCerts(path string, username string, password string, castResult bo
 	return nil, response, err
 }
 
-func customGetNode(path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
+func customGetNode(ctx context.Context, config *Config, path string, username string, password string, castResult bool) (*Node, *resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetResult(&NodeR{}).
@@ -283,12 +304,13 @@ func customGetNode(path string, username string, password string, castResult boo
 	return &result.Response, response, err
 }
 
-func customPost(path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
+func customPost(ctx context.Context, config *Config, path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetBody(requestBody).
@@ -305,12 +327,13 @@ func customPost(path string, username string, password string, requestBody inter
 	return response, err
 }
 
-func customPostWithNoBody(path string, username string, password string) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
+func customPostWithNoBody(ctx context.Context, config *Config, path string, username string, password string) (*resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetError(&Error).
@@ -326,12 +349,13 @@ func customPostWithNoBody(path string, username string, password string) (*resty
 	return response, err
 }
 
-func customPut(path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
-	client := resty.New()
-	client.SetDebug(true)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	client.SetBasicAuth(username, password)
+func customPut(ctx context.Context, config *Config, path string, username string, password string, requestBody interface{}) (*resty.Response, error) {
+	client, err := newPersonasRestyClient(config, username, password)
+	if err != nil {
+		return nil, err
+	}
 	response, err := client.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json").
 		SetBody(requestBody).
@@ -0,0 +1,72 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// policyAPIRequest sends a request of the ISE policy API at path with body,
+// and decodes the response into result when not nil. The SDK types hold only
+// part of nested conditions, so the policy objects with conditions are sent
+// and read in the JSON form of the API rather than through the SDK.
+func policyAPIRequest(ctx context.Context, m interface{}, method string, path string, body interface{}, result interface{}) (*resty.Response, error) {
+	clientConfig := m.(ClientConfig)
+	request := clientConfig.Client.RestyClient().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json")
+	if body != nil {
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(body))
+		request.SetBody(body)
+	}
+	response, err := request.Execute(method, clientConfig.Config.APIURL(OPEN_API_PORT, path))
+	if err != nil {
+		return nil, err
+	}
+	if response.IsError() {
+		return response, fmt.Errorf("error with operation %s %s", method, path)
+	}
+	if result != nil && len(response.Body()) > 0 {
+		if err := json.Unmarshal(response.Body(), result); err != nil {
+			return response, fmt.Errorf("unexpected response %s: %s", response.Body(), err)
+		}
+	}
+	return response, nil
+}
+
+// getPolicyAPIObject returns the response object of the policy API at path,
+// or nil if it does not exist.
+func getPolicyAPIObject(ctx context.Context, m interface{}, path string) (map[string]interface{}, error) {
+	var result struct {
+		Response map[string]interface{} `json:"response"`
+	}
+	response, err := policyAPIRequest(ctx, m, resty.MethodGet, path, nil, &result)
+	if response != nil && response.StatusCode() == 404 {
+		return nil, nil
+	}
+	if err != nil && response != nil {
+		return nil, fmt.Errorf("%s: %s", err, response.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.Response, nil
+}
+
+// getPolicyAPIList decodes the response list of the policy API at path into
+// target.
+func getPolicyAPIList(ctx context.Context, m interface{}, path string, target interface{}) (*resty.Response, error) {
+	var result struct {
+		Response json.RawMessage `json:"response"`
+	}
+	response, err := policyAPIRequest(ctx, m, resty.MethodGet, path, nil, &result)
+	if err != nil || len(result.Response) == 0 {
+		return response, err
+	}
+	if err := json.Unmarshal(result.Response, target); err != nil {
+		return response, fmt.Errorf("unexpected response %s: %s", result.Response, err)
+	}
+	return response, nil
+}
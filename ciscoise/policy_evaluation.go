@@ -0,0 +1,435 @@
+package ciscoise
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Scopes of the authorization rule matched by a policy evaluation, in the
+// order ISE evaluates them.
+const (
+	POLICY_EVALUATION_LOCAL_EXCEPTION  = "local_exception"
+	POLICY_EVALUATION_GLOBAL_EXCEPTION = "global_exception"
+	POLICY_EVALUATION_AUTHORIZATION    = "authorization"
+)
+
+// Nested library conditions deeper than this are reported as a loop.
+const POLICY_EVALUATION_MAX_DEPTH = 32
+
+// policyEvaluationPolicySet, policyEvaluationRule and the rules below hold
+// the ISE policy API responses as read by getPolicyAPIList. The SDK response
+// types would drop the attributes and children of nested conditions.
+type policyEvaluationPolicySet struct {
+	ID          string         `json:"id,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	ServiceName string         `json:"serviceName,omitempty"`
+	State       string         `json:"state,omitempty"`
+	Rank        *int           `json:"rank,omitempty"`
+	Default     *bool          `json:"default,omitempty"`
+	Condition   *conditionNode `json:"condition,omitempty"`
+}
+
+type policyEvaluationRule struct {
+	ID        string         `json:"id,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	State     string         `json:"state,omitempty"`
+	Rank      *int           `json:"rank,omitempty"`
+	Default   *bool          `json:"default,omitempty"`
+	Condition *conditionNode `json:"condition,omitempty"`
+}
+
+type policyEvaluationAuthenticationRule struct {
+	Rule               *policyEvaluationRule `json:"rule,omitempty"`
+	IdentitySourceName string                `json:"identitySourceName,omitempty"`
+	IfAuthFail         string                `json:"ifAuthFail,omitempty"`
+	IfUserNotFound     string                `json:"ifUserNotFound,omitempty"`
+	IfProcessFail      string                `json:"ifProcessFail,omitempty"`
+}
+
+type policyEvaluationAuthorizationRule struct {
+	Rule          *policyEvaluationRule `json:"rule,omitempty"`
+	Profile       []string              `json:"profile,omitempty"`
+	SecurityGroup string                `json:"securityGroup,omitempty"`
+}
+
+// policyEvaluator evaluates policy conditions against the attributes of a
+// request, the way ISE does.
+type policyEvaluator struct {
+	// Values of the attributes by lower case Dictionary:Attribute
+	attributes map[string][]string
+	// Time of the request, for time and date conditions
+	now time.Time
+	// Library conditions by ID
+	conditions map[string]*conditionNode
+}
+
+func newPolicyEvaluator(attributes map[string][]string, now time.Time, conditions []conditionNode) *policyEvaluator {
+	e := &policyEvaluator{
+		attributes: make(map[string][]string, len(attributes)),
+		now:        now,
+		conditions: make(map[string]*conditionNode, len(conditions)),
+	}
+	for name, values := range attributes {
+		key := strings.ToLower(name)
+		e.attributes[key] = append(e.attributes[key], values...)
+	}
+	for i := range conditions {
+		e.conditions[conditions[i].ID] = &conditions[i]
+	}
+	return e
+}
+
+// matchPolicySet returns the first enabled policy set, by rank, whose
+// condition matches, or nil.
+func (e *policyEvaluator) matchPolicySet(policySets []policyEvaluationPolicySet) (*policyEvaluationPolicySet, error) {
+	rules := make([]policyEvaluationRule, 0, len(policySets))
+	for _, policySet := range policySets {
+		rules = append(rules, policyEvaluationRule{
+			ID:        policySet.ID,
+			Name:      policySet.Name,
+			State:     policySet.State,
+			Rank:      policySet.Rank,
+			Default:   policySet.Default,
+			Condition: policySet.Condition,
+		})
+	}
+	i, err := e.matchRule("policy set", rules)
+	if err != nil || i < 0 {
+		return nil, err
+	}
+	return &policySets[i], nil
+}
+
+// matchAuthenticationRule returns the first enabled authentication rule, by
+// rank, whose condition matches, or nil.
+func (e *policyEvaluator) matchAuthenticationRule(authenticationRules []policyEvaluationAuthenticationRule) (*policyEvaluationAuthenticationRule, error) {
+	rules := make([]policyEvaluationRule, 0, len(authenticationRules))
+	for _, rule := range authenticationRules {
+		rules = append(rules, ruleOrEmpty(rule.Rule))
+	}
+	i, err := e.matchRule("authentication rule", rules)
+	if err != nil || i < 0 {
+		return nil, err
+	}
+	return &authenticationRules[i], nil
+}
+
+// matchAuthorizationRule returns the first matching rule of the local
+// exceptions, then of the global exceptions, then of the authorization
+// rules, and its scope.
+func (e *policyEvaluator) matchAuthorizationRule(localExceptionRules, globalExceptionRules, authorizationRules []policyEvaluationAuthorizationRule) (*policyEvaluationAuthorizationRule, string, error) {
+	scopes := []struct {
+		scope string
+		rules []policyEvaluationAuthorizationRule
+	}{
+		{POLICY_EVALUATION_LOCAL_EXCEPTION, localExceptionRules},
+		{POLICY_EVALUATION_GLOBAL_EXCEPTION, globalExceptionRules},
+		{POLICY_EVALUATION_AUTHORIZATION, authorizationRules},
+	}
+	for _, scope := range scopes {
+		rules := make([]policyEvaluationRule, 0, len(scope.rules))
+		for _, rule := range scope.rules {
+			rules = append(rules, ruleOrEmpty(rule.Rule))
+		}
+		i, err := e.matchRule(strings.ReplaceAll(scope.scope, "_", " ")+" rule", rules)
+		if err != nil {
+			return nil, "", err
+		}
+		if i >= 0 {
+			return &scope.rules[i], scope.scope, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func ruleOrEmpty(rule *policyEvaluationRule) policyEvaluationRule {
+	if rule == nil {
+		return policyEvaluationRule{}
+	}
+	return *rule
+}
+
+// matchRule returns the index of the first enabled rule, by rank with the
+// default rule last, whose condition matches, or -1. Rules in monitor mode are
+// evaluated by ISE without being applied, so they never match.
+func (e *policyEvaluator) matchRule(kind string, rules []policyEvaluationRule) (int, error) {
+	order := make([]int, len(rules))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := rules[order[i]], rules[order[j]]
+		aDefault, bDefault := a.Default != nil && *a.Default, b.Default != nil && *b.Default
+		if aDefault != bDefault {
+			return bDefault
+		}
+		if a.Rank == nil || b.Rank == nil {
+			return a.Rank != nil
+		}
+		return *a.Rank < *b.Rank
+	})
+	for _, i := range order {
+		rule := rules[i]
+		if state := strings.ToLower(rule.State); state == "disabled" || state == "monitor" {
+			continue
+		}
+		matched, err := e.matchCondition(rule.Condition, 0)
+		if err != nil {
+			return -1, fmt.Errorf("%s %s: %s", kind, rule.Name, err)
+		}
+		if matched {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// matchCondition reports whether node matches. An empty condition, like the
+// one of a default rule, always matches.
+func (e *policyEvaluator) matchCondition(node *conditionNode, depth int) (bool, error) {
+	if node == nil || node.ConditionType == "" {
+		return true, nil
+	}
+	if depth > POLICY_EVALUATION_MAX_DEPTH {
+		return false, fmt.Errorf("library conditions nested too deep, %s", node.Name)
+	}
+	var matched bool
+	var err error
+	switch conditionType := strings.TrimPrefix(node.ConditionType, CONDITION_TYPE_LIBRARY); conditionType {
+	case CONDITION_TYPE_ATTRIBUTES:
+		matched, err = e.matchAttribute(node)
+	case CONDITION_TYPE_REFERENCE:
+		id := node.referenceID()
+		library, ok := e.conditions[id]
+		if !ok {
+			return false, fmt.Errorf("library condition %s not found", id)
+		}
+		matched, err = e.matchCondition(library, depth+1)
+	case CONDITION_TYPE_AND_BLOCK, CONDITION_TYPE_OR_BLOCK:
+		if len(node.Children) == 0 {
+			return false, fmt.Errorf("condition block %s without children", node.Name)
+		}
+		matched = conditionType == CONDITION_TYPE_AND_BLOCK
+		for i := range node.Children {
+			childMatched, childErr := e.matchCondition(&node.Children[i], depth+1)
+			if childErr != nil {
+				return false, childErr
+			}
+			if childMatched != matched {
+				matched = childMatched
+				break
+			}
+		}
+	case CONDITION_TYPE_TIME_AND_DATE:
+		matched, err = e.matchTimeAndDate(node)
+	default:
+		return false, fmt.Errorf("unexpected condition type %s", node.ConditionType)
+	}
+	if err != nil {
+		return false, err
+	}
+	return matched != node.negated(), nil
+}
+
+// matchAttribute applies the operator of node to the values of its
+// attribute. A condition on an attribute missing from the request does not
+// match, whatever its operator. The negative operators match when no value
+// matches the positive one.
+func (e *policyEvaluator) matchAttribute(node *conditionNode) (bool, error) {
+	if node.DictionaryName == "" || node.AttributeName == "" {
+		return false, fmt.Errorf("condition %s without attribute", node.Name)
+	}
+	values, ok := e.attributes[strings.ToLower(node.DictionaryName+":"+node.AttributeName)]
+	if !ok || len(values) == 0 {
+		return false, nil
+	}
+	operator := node.Operator
+	mac := false
+	if strings.HasPrefix(operator, "mac") {
+		mac = true
+		operator = strings.ToLower(operator[3:4]) + operator[4:]
+	}
+	negative := false
+	if strings.HasPrefix(operator, "not") {
+		negative = true
+		operator = strings.ToLower(operator[3:4]) + operator[4:]
+	}
+	if operator == "ipNotEquals" {
+		negative = true
+		operator = "ipEquals"
+	}
+	expected := node.AttributeValue
+	if mac {
+		expected = normalizeMacAddress(expected)
+	}
+	for _, value := range values {
+		if mac {
+			value = normalizeMacAddress(value)
+		}
+		matched, err := matchConditionOperator(operator, value, expected)
+		if err != nil {
+			return false, err
+		}
+		if matched {
+			return !negative, nil
+		}
+	}
+	return negative, nil
+}
+
+func matchConditionOperator(operator string, value string, expected string) (bool, error) {
+	switch operator {
+	case "equals":
+		return value == expected, nil
+	case "contains":
+		return strings.Contains(value, expected), nil
+	case "startsWith":
+		return strings.HasPrefix(value, expected), nil
+	case "endsWith":
+		return strings.HasSuffix(value, expected), nil
+	case "in":
+		for _, item := range strings.Split(expected, ",") {
+			if value == strings.TrimSpace(item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "matches":
+		expression, err := regexp.Compile(expected)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %s: %s", expected, err)
+		}
+		return expression.MatchString(value), nil
+	case "greaterThan", "greaterOrEquals", "lessThan", "lessOrEquals":
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false, nil
+		}
+		expectedNumber, err := strconv.ParseFloat(strings.TrimSpace(expected), 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid number %s", expected)
+		}
+		switch operator {
+		case "greaterThan":
+			return number > expectedNumber, nil
+		case "greaterOrEquals":
+			return number >= expectedNumber, nil
+		case "lessThan":
+			return number < expectedNumber, nil
+		}
+		return number <= expectedNumber, nil
+	case "ipEquals", "ipGreaterThan", "ipLessThan":
+		ip := net.ParseIP(strings.TrimSpace(value))
+		if ip == nil {
+			return false, nil
+		}
+		if operator == "ipEquals" {
+			if _, network, err := net.ParseCIDR(strings.TrimSpace(expected)); err == nil {
+				return network.Contains(ip), nil
+			}
+		}
+		expectedIP := net.ParseIP(strings.TrimSpace(expected))
+		if expectedIP == nil {
+			return false, fmt.Errorf("invalid IP address %s", expected)
+		}
+		compared := bytes.Compare(ip.To16(), expectedIP.To16())
+		switch operator {
+		case "ipGreaterThan":
+			return compared > 0, nil
+		case "ipLessThan":
+			return compared < 0, nil
+		}
+		return compared == 0, nil
+	}
+	return false, fmt.Errorf("unexpected operator %s", operator)
+}
+
+// matchTimeAndDate reports whether the time of the request is within the
+// dates, hours and week days of node, and not within its exceptions. Dates
+// are yyyy-mm-dd and hours hh:mm, in the time zone of the request time; a
+// range of hours ending before it starts spans midnight.
+func (e *policyEvaluator) matchTimeAndDate(node *conditionNode) (bool, error) {
+	if node.DatesRange != nil {
+		within, err := withinDates(e.now, node.DatesRange)
+		if err != nil || !within {
+			return false, err
+		}
+	}
+	if node.HoursRange != nil {
+		within, err := withinHours(e.now, node.HoursRange)
+		if err != nil || !within {
+			return false, err
+		}
+	}
+	if len(node.WeekDays) > 0 && !withinWeekDays(e.now, node.WeekDays) {
+		return false, nil
+	}
+	if node.DatesRangeException != nil {
+		within, err := withinDates(e.now, node.DatesRangeException)
+		if err != nil || within {
+			return false, err
+		}
+	}
+	if node.HoursRangeException != nil {
+		within, err := withinHours(e.now, node.HoursRangeException)
+		if err != nil || within {
+			return false, err
+		}
+	}
+	return !withinWeekDays(e.now, node.WeekDaysException), nil
+}
+
+func withinDates(now time.Time, dates *conditionDatesRange) (bool, error) {
+	day := now.Format("2006-01-02")
+	for _, date := range []string{dates.StartDate, dates.EndDate} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return false, fmt.Errorf("invalid date %s", date)
+		}
+	}
+	return (dates.StartDate == "" || day >= dates.StartDate) && (dates.EndDate == "" || day <= dates.EndDate), nil
+}
+
+func withinHours(now time.Time, hours *conditionHoursRange) (bool, error) {
+	minute := now.Hour()*60 + now.Minute()
+	start, err := minuteOfDay(hours.StartTime, 0)
+	if err != nil {
+		return false, err
+	}
+	end, err := minuteOfDay(hours.EndTime, 24*60-1)
+	if err != nil {
+		return false, err
+	}
+	if start <= end {
+		return minute >= start && minute <= end, nil
+	}
+	return minute >= start || minute <= end, nil
+}
+
+func minuteOfDay(hour string, empty int) (int, error) {
+	if hour == "" {
+		return empty, nil
+	}
+	parsed, err := time.Parse("15:04", hour)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s", hour)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func withinWeekDays(now time.Time, weekDays []string) bool {
+	for _, weekDay := range weekDays {
+		if strings.EqualFold(weekDay, now.Weekday().String()) {
+			return true
+		}
+	}
+	return false
+}
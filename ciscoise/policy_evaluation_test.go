@@ -0,0 +1,337 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Responses of the ISE policy API
+const testPolicyEvaluationConditions = `[
+	{"conditionType": "LibraryConditionAttributes", "id": "c0000000-0000-0000-0000-000000000001", "name": "Wireless_802.1X",
+	 "dictionaryName": "Radius", "attributeName": "NAS-Port-Type", "operator": "equals", "attributeValue": "Wireless - IEEE 802.11"},
+	{"conditionType": "LibraryConditionOrBlock", "id": "c0000000-0000-0000-0000-000000000002", "name": "Corporate_Devices",
+	 "children": [
+		{"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "Calling-Station-ID", "operator": "macStartsWith", "attributeValue": "00-11-22"},
+		{"conditionType": "ConditionAttributes", "dictionaryName": "Network Access", "attributeName": "EapAuthentication", "operator": "in", "attributeValue": "EAP-TLS,TEAP"}
+	 ]},
+	{"conditionType": "TimeAndDateCondition", "id": "c0000000-0000-0000-0000-000000000003", "name": "Business_Hours",
+	 "hoursRange": {"startTime": "08:00", "endTime": "18:00"}, "weekDays": ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"],
+	 "datesRangeException": {"startDate": "2026-12-24", "endDate": "2026-12-26"}}
+]`
+
+const testPolicyEvaluationPolicySets = `[
+	{"id": "p3", "name": "Default", "rank": 2, "default": true, "state": "enabled", "serviceName": "Default Network Access"},
+	{"id": "p2", "name": "Wireless", "rank": 1, "state": "enabled", "serviceName": "Default Network Access",
+	 "condition": {"conditionType": "ConditionReference", "isNegate": false, "link": {"href": "https://ise/api/v1/policy/network-access/condition/c0000000-0000-0000-0000-000000000001"}}},
+	{"id": "p1", "name": "Lab", "rank": 0, "state": "disabled",
+	 "condition": {"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "NAS-Port-Type", "operator": "equals", "attributeValue": "Wireless - IEEE 802.11"}}
+]`
+
+const testPolicyEvaluationAuthenticationRules = `[
+	{"rule": {"id": "a2", "name": "Default", "rank": 1, "default": true, "state": "enabled"}, "identitySourceName": "All_User_ID_Stores"},
+	{"rule": {"id": "a1", "name": "Dot1X", "rank": 0, "state": "enabled",
+	  "condition": {"conditionType": "ConditionAttributes", "dictionaryName": "Network Access", "attributeName": "Protocol", "operator": "equals", "attributeValue": "RADIUS"}},
+	 "identitySourceName": "AD1", "ifAuthFail": "REJECT", "ifUserNotFound": "REJECT", "ifProcessFail": "DROP"}
+]`
+
+const testPolicyEvaluationLocalExceptionRules = `[
+	{"rule": {"id": "l1", "name": "Quarantine", "rank": 0, "state": "enabled",
+	  "condition": {"conditionType": "ConditionAttributes", "dictionaryName": "Session", "attributeName": "ANCPolicy", "operator": "equals", "attributeValue": "Quarantine"}},
+	 "profile": ["DenyAccess"]}
+]`
+
+const testPolicyEvaluationGlobalExceptionRules = `[
+	{"rule": {"id": "g1", "name": "Blocked", "rank": 0, "state": "enabled",
+	  "condition": {"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "Framed-IP-Address", "operator": "ipEquals", "attributeValue": "192.0.2.0/24"}},
+	 "profile": ["DenyAccess"]}
+]`
+
+const testPolicyEvaluationAuthorizationRules = `[
+	{"rule": {"id": "z9", "name": "Default", "rank": 3, "default": true, "state": "enabled"}, "profile": ["DenyAccess"]},
+	{"rule": {"id": "z1", "name": "Monitored", "rank": 0, "state": "monitor"}, "profile": ["PermitAccess"]},
+	{"rule": {"id": "z2", "name": "Staff", "rank": 1, "state": "enabled",
+	  "condition": {"conditionType": "ConditionAndBlock", "isNegate": false, "children": [
+		{"conditionType": "ConditionReference", "id": "c0000000-0000-0000-0000-000000000002"},
+		{"conditionType": "ConditionReference", "id": "c0000000-0000-0000-0000-000000000003"},
+		{"conditionType": "ConditionAttributes", "dictionaryName": "AD1", "attributeName": "ExternalGroups", "operator": "matches", "attributeValue": "^corp/(Staff|IT)$"}
+	  ]}},
+	 "profile": ["PermitAccess"], "securityGroup": "Employees"},
+	{"rule": {"id": "z3", "name": "Contractors", "rank": 2, "state": "enabled",
+	  "condition": {"conditionType": "ConditionAttributes", "isNegate": true, "dictionaryName": "AD1", "attributeName": "ExternalGroups", "operator": "notEquals", "attributeValue": "corp/Contractors"}},
+	 "profile": ["Internet_Only"], "securityGroup": "Contractors"}
+]`
+
+type testPolicyEvaluationFixture struct {
+	conditions           []conditionNode
+	policySets           []policyEvaluationPolicySet
+	authenticationRules  []policyEvaluationAuthenticationRule
+	localExceptionRules  []policyEvaluationAuthorizationRule
+	globalExceptionRules []policyEvaluationAuthorizationRule
+	authorizationRules   []policyEvaluationAuthorizationRule
+}
+
+func testLoadPolicyEvaluationFixture(t *testing.T) testPolicyEvaluationFixture {
+	var fixture testPolicyEvaluationFixture
+	for fixtureJSON, target := range map[string]interface{}{
+		testPolicyEvaluationConditions:           &fixture.conditions,
+		testPolicyEvaluationPolicySets:           &fixture.policySets,
+		testPolicyEvaluationAuthenticationRules:  &fixture.authenticationRules,
+		testPolicyEvaluationLocalExceptionRules:  &fixture.localExceptionRules,
+		testPolicyEvaluationGlobalExceptionRules: &fixture.globalExceptionRules,
+		testPolicyEvaluationAuthorizationRules:   &fixture.authorizationRules,
+	} {
+		if err := json.Unmarshal([]byte(fixtureJSON), target); err != nil {
+			t.Fatalf("bad: invalid fixture %s", err)
+		}
+	}
+	return fixture
+}
+
+func TestPolicyEvaluation(t *testing.T) {
+	fixture := testLoadPolicyEvaluationFixture(t)
+	// A Monday
+	businessHours := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+	wireless := map[string][]string{
+		"Radius:NAS-Port-Type":             {"Wireless - IEEE 802.11"},
+		"Network Access:Protocol":          {"RADIUS"},
+		"Radius:Calling-Station-ID":        {"00:11:22:33:44:55"},
+		"Radius:Framed-IP-Address":         {"10.1.2.3"},
+		"network access:EapAuthentication": {"PEAP"},
+	}
+
+	cases := map[string]struct {
+		attributes         map[string]string
+		groups             []string
+		now                time.Time
+		policySet          string
+		authenticationRule string
+		authorizationRule  string
+		scope              string
+	}{
+		"staff": {
+			groups: []string{"corp/Sales", "corp/Staff"}, now: businessHours,
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Staff", scope: POLICY_EVALUATION_AUTHORIZATION,
+		},
+		"out_of_hours": {
+			groups: []string{"corp/Staff"}, now: businessHours.Add(10 * time.Hour),
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Default", scope: POLICY_EVALUATION_AUTHORIZATION,
+		},
+		"holiday": {
+			groups: []string{"corp/Staff"}, now: time.Date(2026, 12, 24, 10, 0, 0, 0, time.UTC),
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Default", scope: POLICY_EVALUATION_AUTHORIZATION,
+		},
+		"contractor": {
+			groups: []string{"corp/Contractors"}, now: businessHours,
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Contractors", scope: POLICY_EVALUATION_AUTHORIZATION,
+		},
+		"quarantine": {
+			attributes: map[string]string{"Session:ANCPolicy": "Quarantine"}, now: businessHours,
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Quarantine", scope: POLICY_EVALUATION_LOCAL_EXCEPTION,
+		},
+		"blocked": {
+			attributes: map[string]string{"Radius:Framed-IP-Address": "192.0.2.10"}, groups: []string{"corp/Staff"}, now: businessHours,
+			policySet: "Wireless", authenticationRule: "Dot1X", authorizationRule: "Blocked", scope: POLICY_EVALUATION_GLOBAL_EXCEPTION,
+		},
+		"wired": {
+			attributes: map[string]string{"Radius:NAS-Port-Type": "Ethernet", "Network Access:Protocol": "TACACS", "Radius:Calling-Station-ID": "AA-BB-CC-00-11-22"}, groups: []string{"corp/Staff"}, now: businessHours,
+			policySet: "Default", authenticationRule: "Default", authorizationRule: "Default", scope: POLICY_EVALUATION_AUTHORIZATION,
+		},
+	}
+	for name, c := range cases {
+		attributes := make(map[string][]string)
+		for attribute, values := range wireless {
+			attributes[attribute] = values
+		}
+		for attribute, value := range c.attributes {
+			attributes[attribute] = []string{value}
+		}
+		if c.groups != nil {
+			attributes["AD1:ExternalGroups"] = c.groups
+		}
+		evaluator := newPolicyEvaluator(attributes, c.now, fixture.conditions)
+
+		policySet, err := evaluator.matchPolicySet(fixture.policySets)
+		if err != nil || policySet == nil || policySet.Name != c.policySet {
+			t.Errorf("%s: expect policy set %s, got %+v, %v", name, c.policySet, policySet, err)
+			continue
+		}
+		authenticationRule, err := evaluator.matchAuthenticationRule(fixture.authenticationRules)
+		if err != nil || authenticationRule == nil || authenticationRule.Rule.Name != c.authenticationRule {
+			t.Errorf("%s: expect authentication rule %s, got %+v, %v", name, c.authenticationRule, authenticationRule, err)
+		}
+		authorizationRule, scope, err := evaluator.matchAuthorizationRule(fixture.localExceptionRules, fixture.globalExceptionRules, fixture.authorizationRules)
+		if err != nil || authorizationRule == nil || authorizationRule.Rule.Name != c.authorizationRule || scope != c.scope {
+			t.Errorf("%s: expect %s rule %s, got %s %+v, %v", name, c.scope, c.authorizationRule, scope, authorizationRule, err)
+		}
+	}
+}
+
+func TestPolicyEvaluationDataSource(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/policy/network-access/condition":                    testPolicyEvaluationConditions,
+		"/api/v1/policy/network-access/policy-set":                   testPolicyEvaluationPolicySets,
+		"/api/v1/policy/network-access/policy-set/p2/authentication": testPolicyEvaluationAuthenticationRules,
+		"/api/v1/policy/network-access/policy-set/p2/exception":      testPolicyEvaluationLocalExceptionRules,
+		"/api/v1/policy/network-access/policy-set/global-exception":  testPolicyEvaluationGlobalExceptionRules,
+		"/api/v1/policy/network-access/policy-set/p2/authorization":  testPolicyEvaluationAuthorizationRules,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version": "1.0.0", "response": ` + response + `}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	clientConfig.Config.UseAPIGateway = "true"
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkAccessPolicyEvaluation().Schema, map[string]interface{}{
+		"time": "2026-10-19T10:30:00Z",
+		"attribute": []interface{}{
+			map[string]interface{}{"name": "Radius:NAS-Port-Type", "value": "Wireless - IEEE 802.11"},
+			map[string]interface{}{"name": "Network Access:Protocol", "value": "RADIUS"},
+			map[string]interface{}{"name": "Radius:Calling-Station-ID", "value": "00:11:22:33:44:55"},
+			map[string]interface{}{"name": "Radius:Framed-IP-Address", "value": "10.1.2.3"},
+			map[string]interface{}{"name": "AD1:ExternalGroups", "value": "corp/Staff"},
+		},
+	})
+	if diags := dataSourceNetworkAccessPolicyEvaluationRead(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	expected := map[string]string{
+		"policy_set_name":          "Wireless",
+		"authentication_rule_name": "Dot1X",
+		"authorization_rule_name":  "Staff",
+		"authorization_rule_scope": POLICY_EVALUATION_AUTHORIZATION,
+		"security_group":           "Employees",
+	}
+	for key, value := range expected {
+		if got := d.Get("item.0." + key); got != value {
+			t.Errorf("bad: expect %s %s, got %v", key, value, got)
+		}
+	}
+}
+
+// The SDK response types hold only part of the conditions of the fixture, like
+// the library conditions referenced by ID in children, so the data source
+// reads the policy API JSON itself.
+func TestPolicyEvaluationSDKTypes(t *testing.T) {
+	var response []isegosdk.ResponseNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRulesResponse
+	if err := json.Unmarshal([]byte(testPolicyEvaluationAuthorizationRules), &response); err != nil {
+		t.Fatalf("bad: invalid fixture %s", err)
+	}
+	var rules []policyEvaluationAuthorizationRule
+	if err := responseToJSONTypes(response, &rules); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	fixture := testLoadPolicyEvaluationFixture(t)
+	for i, rule := range fixture.authorizationRules {
+		if rule.Rule.Name != "Staff" {
+			continue
+		}
+		children := rules[i].Rule.Condition.Children
+		if len(children) != 3 || children[0].ID != "" || children[1].ID != "" {
+			t.Errorf("bad: expect the SDK types to drop the library conditions of the children, got %+v", children)
+		}
+		if fixture.authorizationRules[i].Rule.Condition.Children[0].ID == "" {
+			t.Errorf("bad: expect the fixture to reference the library conditions")
+		}
+	}
+}
+
+func TestPolicyEvaluationErrors(t *testing.T) {
+	cases := map[string]string{
+		"unknown_reference": `{"conditionType": "ConditionReference", "id": "missing"}`,
+		"unknown_operator":  `{"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "User-Name", "operator": "like", "attributeValue": "x"}`,
+		"invalid_regexp":    `{"conditionType": "ConditionAttributes", "dictionaryName": "Radius", "attributeName": "User-Name", "operator": "matches", "attributeValue": "("}`,
+		"empty_block":       `{"conditionType": "ConditionOrBlock"}`,
+		"loop":              `{"conditionType": "ConditionReference", "id": "loop"}`,
+	}
+	loop := conditionNode{ConditionType: "LibraryConditionAndBlock", ID: "loop", Children: []conditionNode{{ConditionType: CONDITION_TYPE_REFERENCE, ID: "loop"}}}
+	evaluator := newPolicyEvaluator(map[string][]string{"Radius:User-Name": {"alice"}}, time.Now(), []conditionNode{loop})
+	for name, conditionJSON := range cases {
+		var condition conditionNode
+		if err := json.Unmarshal([]byte(conditionJSON), &condition); err != nil {
+			t.Fatalf("%s: invalid fixture %s", name, err)
+		}
+		rules := []policyEvaluationAuthorizationRule{{Rule: &policyEvaluationRule{Name: name, Condition: &condition}}}
+		if _, _, err := evaluator.matchAuthorizationRule(nil, nil, rules); err == nil {
+			t.Errorf("%s: expect an error", name)
+		}
+	}
+}
+
+func TestMatchConditionOperator(t *testing.T) {
+	cases := []struct {
+		operator string
+		value    string
+		expected string
+		matched  bool
+	}{
+		{"equals", "RADIUS", "RADIUS", true},
+		{"equals", "radius", "RADIUS", false},
+		{"contains", "corp/Staff", "Staff", true},
+		{"startsWith", "corp/Staff", "corp/", true},
+		{"endsWith", "corp/Staff", "corp/", false},
+		{"in", "TEAP", "EAP-TLS, TEAP", true},
+		{"in", "PEAP", "EAP-TLS,TEAP", false},
+		{"matches", "alice@example.com", `@example\.com$`, true},
+		{"greaterThan", "10", "9", true},
+		{"lessOrEquals", "10", "10", true},
+		{"lessThan", "ten", "10", false},
+		{"ipEquals", "10.1.2.3", "10.0.0.0/8", true},
+		{"ipEquals", "10.1.2.3", "10.1.2.4", false},
+		{"ipGreaterThan", "10.1.2.10", "10.1.2.9", true},
+		{"ipLessThan", "10.1.2.10", "10.1.2.9", false},
+	}
+	for _, c := range cases {
+		matched, err := matchConditionOperator(c.operator, c.value, c.expected)
+		if err != nil || matched != c.matched {
+			t.Errorf("bad: expect %s %s %s to be %v, got %v, %v", c.value, c.operator, c.expected, c.matched, matched, err)
+		}
+	}
+
+	evaluator := newPolicyEvaluator(map[string][]string{"Radius:Calling-Station-ID": {"00-11-22-33-44-55"}}, time.Now(), nil)
+	for operator, matched := range map[string]bool{"macEquals": true, "macNotEquals": false, "macNotIn": true, "ipNotEquals": true} {
+		node := &conditionNode{ConditionType: CONDITION_TYPE_ATTRIBUTES, DictionaryName: "Radius", AttributeName: "Calling-Station-ID", Operator: operator, AttributeValue: "00:11:22:33:44:55"}
+		if operator == "macNotIn" {
+			node.AttributeValue = "aa:bb:cc:dd:ee:ff,00:11:22:33:44:66"
+		}
+		got, err := evaluator.matchCondition(node, 0)
+		if err != nil || got != matched {
+			t.Errorf("bad: expect %s to be %v, got %v, %v", operator, matched, got, err)
+		}
+	}
+}
+
+func TestMatchTimeAndDate(t *testing.T) {
+	night := &conditionNode{ConditionType: CONDITION_TYPE_TIME_AND_DATE, HoursRange: &conditionHoursRange{StartTime: "22:00", EndTime: "06:00"}, WeekDaysException: []string{"Sunday"}}
+	cases := map[time.Time]bool{
+		time.Date(2026, 10, 19, 23, 0, 0, 0, time.UTC): true,
+		time.Date(2026, 10, 19, 5, 59, 0, 0, time.UTC): true,
+		time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC): false,
+		time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC): false,
+	}
+	for now, matched := range cases {
+		got, err := newPolicyEvaluator(nil, now, nil).matchCondition(night, 0)
+		if err != nil || got != matched {
+			t.Errorf("bad: expect %s to be %v, got %v, %v", now, matched, got, err)
+		}
+	}
+	invalid := &conditionNode{ConditionType: CONDITION_TYPE_TIME_AND_DATE, DatesRange: &conditionDatesRange{StartDate: "19/10/2026"}}
+	if _, err := newPolicyEvaluator(nil, time.Now(), nil).matchCondition(invalid, 0); err == nil {
+		t.Errorf("bad: expect an error for an invalid date")
+	}
+}
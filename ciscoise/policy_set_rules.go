@@ -0,0 +1,769 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Actions applying the rules of a policy set. ISE has no move, a move is an
+// update of the rank.
+const (
+	POLICY_SET_RULE_CREATE = "create"
+	POLICY_SET_RULE_UPDATE = "update"
+	POLICY_SET_RULE_MOVE   = "move"
+	POLICY_SET_RULE_DELETE = "delete"
+)
+
+// policySetRulesDomain describes the policy sets of network access or of
+// device administration.
+type policySetRulesDomain struct {
+	name       string
+	library    conditionLibrary
+	policySets func(client *isegosdk.Client) (map[string]string, error)
+	// Kinds of rules, in the order they are applied
+	kinds []policySetRuleKind
+}
+
+// policySetRuleKind describes a list of rules of a policy set. Requests and
+// responses are maps in the JSON form of the ISE policy API.
+type policySetRuleKind struct {
+	key string
+	// Attributes besides the ones of every rule, named after their JSON key
+	attributes map[string]*schema.Schema
+	// Path of the rules under the API path of the library
+	path func(policyID string) string
+}
+
+// policySetRule is a rule read from ISE, its values keyed as in the schema.
+type policySetRule struct {
+	rank      int
+	values    map[string]interface{}
+	condition *conditionNode
+}
+
+func (r policySetRule) name() string {
+	name, _ := r.values["name"].(string)
+	return name
+}
+
+func (r policySetRule) isDefault() bool {
+	isDefault, _ := r.values["default"].(bool)
+	return isDefault
+}
+
+type policySetRuleOperation struct {
+	action string
+	name   string
+	id     string
+	rank   int
+	// Index of the planned rule, -1 for a delete
+	index int
+}
+
+func (o policySetRuleOperation) String() string {
+	return fmt.Sprintf("%s %s at %d", o.action, o.name, o.rank)
+}
+
+// cachedConditionLibrary returns library resolving each name and ID once, for
+// the many conditions of the rules of a policy set.
+func cachedConditionLibrary(library conditionLibrary) conditionLibrary {
+	ids := make(map[string]string)
+	names := make(map[string]string)
+	return conditionLibrary{
+		apiPath: library.apiPath,
+		idByName: func(client *isegosdk.Client, name string) (string, error) {
+			if id, ok := ids[name]; ok {
+				return id, nil
+			}
+			id, err := library.idByName(client, name)
+			if err == nil {
+				ids[name], names[id] = id, name
+			}
+			return id, err
+		},
+		nameByID: func(client *isegosdk.Client, id string) (string, error) {
+			if name, ok := names[id]; ok {
+				return name, nil
+			}
+			name, err := library.nameByID(client, id)
+			if err == nil {
+				ids[name], names[id] = id, name
+			}
+			return name, err
+		},
+	}
+}
+
+func resourcePolicySetRules(domain policySetRulesDomain, description string) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"last_updated": &schema.Schema{
+			Description: `Unix timestamp records the last time that the resource was updated.`,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"policy_id": &schema.Schema{
+			Description: `Policy set id`,
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"managed_kinds": &schema.Schema{
+			Description: `Kinds of rules managed, the ones declared once. A kind whose rules are all removed stays managed, its rules being deleted.`,
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+	keys := make([]string, 0, len(domain.kinds))
+	for _, kind := range domain.kinds {
+		keys = append(keys, kind.key)
+	}
+	for _, kind := range domain.kinds {
+		ruleSchema := map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Description: `Rule name, unique in the list`,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"state": &schema.Schema{
+				Description: `The state that the rule is in. A disabled rule cannot be matched.`,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "enabled",
+				ValidateFunc: validateStringHasValueFunc([]string{
+					"enabled", "disabled", "monitor",
+				}),
+			},
+			"default": &schema.Schema{
+				Description: `Indicates if this rule is the default one, always last`,
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"condition_expression": &schema.Schema{
+				Description: `Condition of the rule, e.g. Radius:NAS-Port-Type EQUALS "Wireless - IEEE 802.11" AND NOT Lib:Compliant.
+Operators are written in upper snake case, e.g. NOT_STARTS_WITH, and library conditions as Lib:name.`,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateConditionExpression,
+				DiffSuppressFunc: diffSuppressConditionExpression(),
+			},
+		}
+		for key, attribute := range kind.attributes {
+			ruleSchema[key] = attribute
+		}
+		s[kind.key] = &schema.Schema{
+			Description:  fmt.Sprintf(`The %s, in rank order. Rules not declared are deleted, unless no %s was ever declared.`, strings.ReplaceAll(kind.key, "_", " ")+"s", kind.key),
+			Type:         schema.TypeList,
+			Optional:     true,
+			AtLeastOneOf: keys,
+			Elem: &schema.Resource{
+				Schema: ruleSchema,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Description: description,
+
+		CreateContext: policySetRulesCreate(domain),
+		ReadContext:   policySetRulesRead(domain),
+		UpdateContext: policySetRulesUpdate(domain),
+		DeleteContext: policySetRulesDelete(domain),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStatePolicySetRules(domain),
+		},
+		CustomizeDiff: policySetRulesCustomizeDiff(domain),
+		Schema:        s,
+	}
+}
+
+func policySetRulesCreate(domain policySetRulesDomain) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		logDebugf(ctx, "Beginning %s create", domain.name)
+		policyID := d.Get("policy_id").(string)
+		d.SetId(joinResourceID(map[string]string{"policy_id": policyID}))
+		diags := policySetRulesApply(ctx, d, m, domain)
+		_ = d.Set("last_updated", getUnixTimeString())
+		return append(diags, policySetRulesRead(domain)(ctx, d, m)...)
+	}
+}
+
+func policySetRulesRead(domain policySetRulesDomain) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		logDebugf(ctx, "Beginning %s read for id=[%s]", domain.name, d.Id())
+		clientConfig := m.(ClientConfig)
+		client := clientConfig.Client
+
+		var diags diag.Diagnostics
+		policyID := separateResourceID(d.Id())["policy_id"]
+		policySetIDs, err := domain.policySets(client)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when reading the policy sets", err))
+			return diags
+		}
+		found := false
+		for _, id := range policySetIDs {
+			found = found || id == policyID
+		}
+		if !found {
+			logDebugf(ctx, "Policy set %s not found, removing the rules from the state", policyID)
+			d.SetId("")
+			return diags
+		}
+		if err := d.Set("policy_id", policyID); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting policy_id", err))
+			return diags
+		}
+
+		// Kinds never declared are not managed, unless none is, after an
+		// import
+		managed := policySetRulesManaged(d)
+		imported := len(managed) == 0
+		for _, kind := range domain.kinds {
+			imported = imported && len(d.Get(kind.key).([]interface{})) == 0
+		}
+		library := cachedConditionLibrary(domain.library)
+		for _, kind := range domain.kinds {
+			prior := d.Get(kind.key).([]interface{})
+			if len(prior) == 0 && !imported && !managed[kind.key] {
+				continue
+			}
+			remote, err := readPolicySetRules(ctx, m, library, kind, policyID)
+			if err != nil {
+				diags = append(diags, diagError(
+					fmt.Sprintf("Failure when reading the %ss", kind.key), err))
+				return diags
+			}
+			declared := make(map[string]bool)
+			for _, rule := range prior {
+				if ruleMap, ok := rule.(map[string]interface{}); ok {
+					declared[interfaceToString(ruleMap["name"])] = true
+				}
+			}
+			rules := make([]interface{}, 0, len(remote))
+			for _, rule := range remote {
+				// The default rule is only managed when declared
+				if rule.isDefault() && !declared[rule.name()] && !imported {
+					continue
+				}
+				rules = append(rules, rule.values)
+			}
+			value := interface{}(rules)
+			if !imported {
+				paths := make(parameterPaths)
+				for _, path := range nonEmptyPaths(prior, kind.key) {
+					paths[path] = true
+				}
+				value = mergeConfigured(prior, rules, kind.key, paths)
+			}
+			if err := d.Set(kind.key, value); err != nil {
+				diags = append(diags, diagError(
+					fmt.Sprintf("Failure when setting the %ss", kind.key), err))
+				return diags
+			}
+			if len(prior) > 0 || len(rules) > 0 {
+				managed[kind.key] = true
+			}
+		}
+		kinds := make([]string, 0, len(managed))
+		for key := range managed {
+			kinds = append(kinds, key)
+		}
+		if err := d.Set("managed_kinds", kinds); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting managed_kinds", err))
+			return diags
+		}
+		return diags
+	}
+}
+
+// policySetRulesManaged returns the kinds of rules recorded as managed.
+func policySetRulesManaged(d *schema.ResourceData) map[string]bool {
+	managed := make(map[string]bool)
+	if set, ok := d.Get("managed_kinds").(*schema.Set); ok {
+		for _, key := range set.List() {
+			managed[interfaceToString(key)] = true
+		}
+	}
+	return managed
+}
+
+func policySetRulesUpdate(domain policySetRulesDomain) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		logDebugf(ctx, "Beginning %s update for id=[%s]", domain.name, d.Id())
+		var diags diag.Diagnostics
+		for _, kind := range domain.kinds {
+			if d.HasChange(kind.key) {
+				diags = policySetRulesApply(ctx, d, m, domain)
+				_ = d.Set("last_updated", getUnixTimeString())
+				break
+			}
+		}
+		return append(diags, policySetRulesRead(domain)(ctx, d, m)...)
+	}
+}
+
+// policySetRulesDelete deletes the rules declared, except the default rules
+// which ISE keeps.
+func policySetRulesDelete(domain policySetRulesDomain) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		logDebugf(ctx, "Beginning %s delete for id=[%s]", domain.name, d.Id())
+
+		var diags diag.Diagnostics
+		policyID := d.Get("policy_id").(string)
+		for _, kind := range domain.kinds {
+			for _, rule := range d.Get(kind.key).([]interface{}) {
+				ruleMap, ok := rule.(map[string]interface{})
+				if !ok || ruleMap["default"] == true || interfaceToString(ruleMap["id"]) == "" {
+					continue
+				}
+				path := domain.library.apiPath + kind.path(policyID) + "/" + interfaceToString(ruleMap["id"])
+				if err := policySetRuleError(policyAPIRequest(ctx, m, resty.MethodDelete, path, nil, nil)); err != nil {
+					diags = append(diags, diagError(
+						fmt.Sprintf("Failure when deleting the %s %s", kind.key, ruleMap["name"]), err))
+					return diags
+				}
+			}
+		}
+
+		// d.SetId("") is automatically called assuming delete returns no errors, but
+		// it is added here for explicitness.
+		d.SetId("")
+
+		return diags
+	}
+}
+
+func policySetRulesCustomizeDiff(domain policySetRulesDomain) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		for _, kind := range domain.kinds {
+			names := make(map[string]bool)
+			for _, rule := range diff.Get(kind.key).([]interface{}) {
+				ruleMap, ok := rule.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				name := interfaceToString(ruleMap["name"])
+				if names[name] {
+					return fmt.Errorf("%s %s is declared more than once", kind.key, name)
+				}
+				names[name] = true
+			}
+		}
+		return nil
+	}
+}
+
+// importStatePolicySetRules returns an importer accepting a resource ID, a
+// policy set ID or a policy set name.
+func importStatePolicySetRules(domain policySetRulesDomain) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if isResourceID(importID) {
+			return []*schema.ResourceData{d}, nil
+		}
+		policyID := importID
+		if !isUUID(importID) {
+			clientConfig := m.(ClientConfig)
+			policySetIDs, err := domain.policySets(clientConfig.Client)
+			if err != nil {
+				return nil, err
+			}
+			if policyID = policySetIDs[importID]; policyID == "" {
+				return nil, fmt.Errorf("cannot import non-existent policy set %s", importID)
+			}
+		}
+		return importStateRead(ctx, d, m, policySetRulesRead(domain), importID, map[string]string{"policy_id": policyID}, nil)
+	}
+}
+
+// policySetRulesApply applies the changes of each kind of rules declared.
+// It stops at the first failure, the read that follows records what was
+// applied.
+func policySetRulesApply(ctx context.Context, d *schema.ResourceData, m interface{}, domain policySetRulesDomain) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	policyID := d.Get("policy_id").(string)
+	library := cachedConditionLibrary(domain.library)
+	managed := policySetRulesManaged(d)
+	for _, kind := range domain.kinds {
+		var planned []map[string]interface{}
+		for _, rule := range d.Get(kind.key).([]interface{}) {
+			if ruleMap, ok := rule.(map[string]interface{}); ok {
+				planned = append(planned, ruleMap)
+			}
+		}
+		// A kind whose rules are all removed has its rules deleted, but the
+		// default one
+		if prior, _ := d.GetChange(kind.key); len(planned) == 0 && len(prior.([]interface{})) == 0 && !managed[kind.key] {
+			continue
+		}
+		remote, err := readPolicySetRules(ctx, m, library, kind, policyID)
+		if err != nil {
+			diags = append(diags, diagError(
+				fmt.Sprintf("Failure when reading the %ss", kind.key), err))
+			return diags
+		}
+		operations, err := planPolicySetRules(remote, planned)
+		if err != nil {
+			diags = append(diags, diagError(
+				fmt.Sprintf("Failure when planning the %ss", kind.key), err))
+			return diags
+		}
+		logDebugf(ctx, "Applying the %ss of %s: %v", kind.key, policyID, operations)
+
+		remoteByName := make(map[string]policySetRule, len(remote))
+		for _, rule := range remote {
+			remoteByName[rule.name()] = rule
+		}
+		path := library.apiPath + kind.path(policyID)
+		for _, operation := range operations {
+			if operation.action == POLICY_SET_RULE_DELETE {
+				err = policySetRuleError(policyAPIRequest(ctx, m, resty.MethodDelete, path+"/"+operation.id, nil, nil))
+			} else {
+				var request map[string]interface{}
+				request, err = policySetRuleRequest(client, library, planned[operation.index], remoteByName[operation.name], operation.rank)
+				if err == nil && operation.action == POLICY_SET_RULE_CREATE {
+					err = policySetRuleError(policyAPIRequest(ctx, m, resty.MethodPost, path, request, nil))
+				} else if err == nil {
+					err = policySetRuleError(policyAPIRequest(ctx, m, resty.MethodPut, path+"/"+operation.id, request, nil))
+				}
+			}
+			if err != nil {
+				diags = append(diags, diagError(
+					fmt.Sprintf("Failure when applying %s %s", kind.key, operation), err))
+				return diags
+			}
+		}
+	}
+	return diags
+}
+
+// planPolicySetRules returns the operations making remote, the rules in rank
+// order, match planned. Rules are matched by name. The rules which keep their
+// relative order, the longest increasing subsequence of their remote ranks,
+// are not moved; every other rule is moved right after the rule planned
+// before it, so that the number of moves is minimal. The default rule stays
+// last and is only updated.
+func planPolicySetRules(remote []policySetRule, planned []map[string]interface{}) ([]policySetRuleOperation, error) {
+	remoteByName := make(map[string]policySetRule, len(remote))
+	var current []string
+	for _, rule := range remote {
+		remoteByName[rule.name()] = rule
+		if !rule.isDefault() {
+			current = append(current, rule.name())
+		}
+	}
+
+	plannedIndexes := make(map[string]int, len(planned))
+	var names []string
+	defaultIndex := -1
+	for i, rule := range planned {
+		name := interfaceToString(rule["name"])
+		if _, ok := plannedIndexes[name]; ok {
+			return nil, fmt.Errorf("rule %s is declared more than once", name)
+		}
+		plannedIndexes[name] = i
+		if remoteRule, ok := remoteByName[name]; ok && remoteRule.isDefault() {
+			if i != len(planned)-1 {
+				return nil, fmt.Errorf("the default rule %s must be last", name)
+			}
+			defaultIndex = i
+			continue
+		}
+		names = append(names, name)
+	}
+
+	var operations []policySetRuleOperation
+	kept := current[:0:0]
+	for _, name := range current {
+		if _, ok := plannedIndexes[name]; ok {
+			kept = append(kept, name)
+			continue
+		}
+		rule := remoteByName[name]
+		operations = append(operations, policySetRuleOperation{
+			action: POLICY_SET_RULE_DELETE, name: name, id: interfaceToString(rule.values["id"]), rank: rule.rank, index: -1,
+		})
+	}
+	current = kept
+
+	positions := make(map[string]int, len(current))
+	for i, name := range current {
+		positions[name] = i
+	}
+	var matched []string
+	var matchedPositions []int
+	for _, name := range names {
+		if position, ok := positions[name]; ok {
+			matched = append(matched, name)
+			matchedPositions = append(matchedPositions, position)
+		}
+	}
+	stable := make(map[string]bool, len(matched))
+	for _, i := range longestIncreasingSubsequence(matchedPositions) {
+		stable[matched[i]] = true
+	}
+
+	for i, name := range names {
+		rule, exists := remoteByName[name]
+		operation := policySetRuleOperation{name: name, id: interfaceToString(rule.values["id"]), index: plannedIndexes[name]}
+		changed := exists && policySetRuleChanged(planned[operation.index], rule.values)
+		if exists && stable[name] {
+			if !changed {
+				continue
+			}
+			operation.action = POLICY_SET_RULE_UPDATE
+			operation.rank = indexOfString(current, name)
+			operations = append(operations, operation)
+			continue
+		}
+		switch {
+		case !exists:
+			operation.action, operation.id = POLICY_SET_RULE_CREATE, ""
+		case changed:
+			operation.action = POLICY_SET_RULE_UPDATE
+		default:
+			operation.action = POLICY_SET_RULE_MOVE
+		}
+		if exists {
+			current = append(current[:indexOfString(current, name)], current[indexOfString(current, name)+1:]...)
+		}
+		if i > 0 {
+			operation.rank = indexOfString(current, names[i-1]) + 1
+		}
+		current = append(current[:operation.rank], append([]string{name}, current[operation.rank:]...)...)
+		operations = append(operations, operation)
+	}
+
+	if defaultIndex >= 0 {
+		name := interfaceToString(planned[defaultIndex]["name"])
+		rule := remoteByName[name]
+		if policySetRuleChanged(planned[defaultIndex], rule.values) {
+			operations = append(operations, policySetRuleOperation{
+				action: POLICY_SET_RULE_UPDATE, name: name, id: interfaceToString(rule.values["id"]), rank: len(current), index: defaultIndex,
+			})
+		}
+	}
+	return operations, nil
+}
+
+// policySetRuleChanged reports whether a value declared in planned differs
+// from remote.
+func policySetRuleChanged(planned map[string]interface{}, remote map[string]interface{}) bool {
+	for key, value := range planned {
+		if key == "id" || key == "default" || !isConfigured(value) {
+			continue
+		}
+		if key == "condition_expression" {
+			if normalizeConditionExpression(interfaceToString(value)) != normalizeConditionExpression(interfaceToString(remote[key])) {
+				return true
+			}
+			continue
+		}
+		if !semanticEqual(value, remote[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// longestIncreasingSubsequence returns the indexes of a longest strictly
+// increasing subsequence of values.
+func longestIncreasingSubsequence(values []int) []int {
+	// tails[k] is the index of the smallest last value of the subsequences
+	// of length k+1
+	var tails []int
+	previous := make([]int, len(values))
+	for i, value := range values {
+		k := sort.Search(len(tails), func(k int) bool { return values[tails[k]] >= value })
+		previous[i] = -1
+		if k > 0 {
+			previous[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	indexes := make([]int, len(tails))
+	for k, i := len(tails)-1, -1; k >= 0; k-- {
+		if i < 0 {
+			i = tails[k]
+		} else {
+			i = previous[i]
+		}
+		indexes[k] = i
+	}
+	return indexes
+}
+
+func indexOfString(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// readPolicySetRules returns the rules of kind in rank order, the default
+// rule last. The rules are read in the JSON form of the ISE policy API, the
+// SDK types hold only part of nested conditions.
+func readPolicySetRules(ctx context.Context, m interface{}, library conditionLibrary, kind policySetRuleKind, policyID string) ([]policySetRule, error) {
+	client := m.(ClientConfig).Client
+	var items []map[string]interface{}
+	if err := policySetRuleError(getPolicyAPIList(ctx, m, library.apiPath+kind.path(policyID), &items)); err != nil {
+		return nil, err
+	}
+	rules := make([]policySetRule, 0, len(items))
+	for _, item := range items {
+		rules = append(rules, flattenPolicySetRule(ctx, client, library, kind, item))
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].isDefault() != rules[j].isDefault() {
+			return rules[j].isDefault()
+		}
+		return rules[i].rank < rules[j].rank
+	})
+	return rules, nil
+}
+
+func flattenPolicySetRule(ctx context.Context, client *isegosdk.Client, library conditionLibrary, kind policySetRuleKind, item map[string]interface{}) policySetRule {
+	ruleItem, _ := item["rule"].(map[string]interface{})
+	rule := policySetRule{values: make(map[string]interface{})}
+	for _, key := range []string{"id", "name", "state"} {
+		value, _ := ruleItem[key].(string)
+		rule.values[key] = value
+	}
+	isDefault, _ := ruleItem["default"].(bool)
+	rule.values["default"] = isDefault
+	if rank, ok := ruleItem["rank"].(float64); ok {
+		rule.rank = int(rank)
+	}
+
+	expression := ""
+	if condition, ok := ruleItem["condition"].(map[string]interface{}); ok {
+		node, err := conditionFromResponse(condition)
+		if err == nil && node != nil {
+			rule.condition = node
+			if err := nameConditionReferences(client, library, node); err != nil {
+				logDebugf(ctx, "Failure when naming the library conditions: %s", err)
+			}
+			expression, err = renderConditionExpression(node)
+		}
+		if err != nil {
+			logDebugf(ctx, "Condition of %s without expression: %s", rule.name(), err)
+		}
+	}
+	rule.values["condition_expression"] = expression
+
+	for key, attribute := range kind.attributes {
+		value := item[policySetRuleJSONKey(key)]
+		if attribute.Type == schema.TypeList {
+			values, _ := value.([]interface{})
+			if values == nil {
+				values = []interface{}{}
+			}
+			rule.values[key] = values
+			continue
+		}
+		stringValue, _ := value.(string)
+		rule.values[key] = stringValue
+	}
+	return rule
+}
+
+// policySetRuleRequest returns the request of a rule at rank, in the JSON
+// form of the ISE policy API. The values not declared are the remote ones,
+// since ISE replaces the whole rule.
+func policySetRuleRequest(client *isegosdk.Client, library conditionLibrary, planned map[string]interface{}, remote policySetRule, rank int) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(planned))
+	for key, value := range planned {
+		if remoteValue, ok := remote.values[key]; ok && !isConfigured(value) {
+			value = remoteValue
+		}
+		values[key] = value
+	}
+
+	rule := map[string]interface{}{
+		"name": values["name"],
+		"rank": rank,
+	}
+	if state := interfaceToString(values["state"]); state != "" {
+		rule["state"] = state
+	}
+	if id := interfaceToString(remote.values["id"]); id != "" {
+		rule["id"] = id
+	}
+	if remote.isDefault() {
+		rule["default"] = true
+	}
+	expression := interfaceToString(values["condition_expression"])
+	switch {
+	case expression != "":
+		node, err := parseConditionExpression(expression)
+		if err != nil {
+			return nil, err
+		}
+		if err := resolveConditionReferences(client, library, node); err != nil {
+			return nil, err
+		}
+		rule["condition"] = node
+	case remote.condition != nil:
+		return nil, fmt.Errorf("the condition of %s cannot be written as an expression, declare its condition_expression", remote.name())
+	}
+
+	request := map[string]interface{}{"rule": rule}
+	for key, value := range values {
+		switch key {
+		case "id", "name", "state", "default", "condition_expression":
+			continue
+		}
+		if isConfigured(value) {
+			request[policySetRuleJSONKey(key)] = value
+		}
+	}
+	return request, nil
+}
+
+// policySetRuleJSONKey returns the JSON key of an attribute, e.g.
+// identitySourceName for identity_source_name.
+func policySetRuleJSONKey(key string) string {
+	parts := strings.Split(key, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// policySetRuleError returns err with the response of ISE, if any.
+func policySetRuleError(restyResp *resty.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+	if restyResp != nil {
+		return fmt.Errorf("%s: %s", err, restyResp.String())
+	}
+	return err
+}
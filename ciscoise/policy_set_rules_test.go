@@ -0,0 +1,362 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testPolicySetRules(names ...string) []policySetRule {
+	rules := make([]policySetRule, 0, len(names))
+	for i, name := range names {
+		rules = append(rules, policySetRule{rank: i, values: map[string]interface{}{
+			"id":                   "id-" + name,
+			"name":                 name,
+			"state":                "enabled",
+			"default":              name == "Default",
+			"condition_expression": `Radius:User-Name EQUALS "` + name + `"`,
+			"profile":              []interface{}{"PermitAccess"},
+		}})
+	}
+	return rules
+}
+
+func testPlannedRules(names ...string) []map[string]interface{} {
+	planned := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		planned = append(planned, map[string]interface{}{
+			"id":                   "",
+			"name":                 name,
+			"state":                "enabled",
+			"condition_expression": `Radius:User-Name  equals "` + name + `"`,
+			"profile":              []interface{}{},
+		})
+	}
+	return planned
+}
+
+// testApplyPolicySetRules applies operations to the names of remote the way
+// ISE ranks rules, and returns the resulting order.
+func testApplyPolicySetRules(remote []policySetRule, operations []policySetRuleOperation) []string {
+	var order []string
+	for _, rule := range remote {
+		order = append(order, rule.name())
+	}
+	for _, operation := range operations {
+		if i := indexOfString(order, operation.name); i >= 0 {
+			order = append(order[:i], order[i+1:]...)
+		}
+		if operation.action != POLICY_SET_RULE_DELETE {
+			order = append(order[:operation.rank], append([]string{operation.name}, order[operation.rank:]...)...)
+		}
+	}
+	return order
+}
+
+func TestPlanPolicySetRules(t *testing.T) {
+	cases := map[string]struct {
+		remote   []string
+		planned  []string
+		expected []string
+	}{
+		"unchanged":     {[]string{"A", "B", "Default"}, []string{"A", "B"}, nil},
+		"insert":        {[]string{"A", "B", "C", "Default"}, []string{"A", "X", "B", "C", "Default"}, []string{"create X at 1"}},
+		"move_to_first": {[]string{"A", "B", "C"}, []string{"C", "A", "B"}, []string{"move C at 0"}},
+		"move_to_last":  {[]string{"A", "B", "C"}, []string{"B", "C", "A"}, []string{"move A at 2"}},
+		"delete":        {[]string{"A", "B", "C", "Default"}, []string{"A", "C"}, []string{"delete B at 1"}},
+		"swap":          {[]string{"A", "B", "C", "D"}, []string{"A", "C", "B", "D"}, []string{"move C at 1"}},
+		"replace":       {[]string{"A", "B"}, []string{"X", "B", "Y"}, []string{"delete A at 0", "create X at 0", "create Y at 2"}},
+	}
+	for name, c := range cases {
+		remote := testPolicySetRules(c.remote...)
+		operations, err := planPolicySetRules(remote, testPlannedRules(c.planned...))
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", name, err)
+			continue
+		}
+		var got []string
+		for _, operation := range operations {
+			got = append(got, operation.String())
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expect %v, got %v", name, c.expected, got)
+		}
+	}
+
+	planned := testPlannedRules("A", "B", "Default")
+	planned[0]["state"] = "disabled"
+	planned[2]["profile"] = []interface{}{"DenyAccess"}
+	operations, err := planPolicySetRules(testPolicySetRules("A", "B", "Default"), planned)
+	if err != nil {
+		t.Fatalf("bad: unexpected error %s", err)
+	}
+	expected := []policySetRuleOperation{
+		{action: POLICY_SET_RULE_UPDATE, name: "A", id: "id-A", rank: 0, index: 0},
+		{action: POLICY_SET_RULE_UPDATE, name: "Default", id: "id-Default", rank: 2, index: 2},
+	}
+	if !reflect.DeepEqual(operations, expected) {
+		t.Errorf("bad: expect %v, got %v", expected, operations)
+	}
+
+	if _, err := planPolicySetRules(testPolicySetRules("A", "Default"), testPlannedRules("Default", "A")); err == nil || !strings.Contains(err.Error(), "must be last") {
+		t.Errorf("bad: expect the default rule to be last, got %v", err)
+	}
+	if _, err := planPolicySetRules(nil, testPlannedRules("A", "A")); err == nil {
+		t.Errorf("bad: expect an error for a duplicate rule")
+	}
+}
+
+func TestPlanPolicySetRulesPermutations(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	names := []string{"A", "B", "C", "D", "E", "F", "G", "H"}
+	for i := 0; i < 200; i++ {
+		remoteNames := names[:random.Intn(len(names))]
+		remote := testPolicySetRules(remoteNames...)
+		var plannedNames []string
+		for _, j := range random.Perm(len(names)) {
+			if random.Intn(4) > 0 {
+				plannedNames = append(plannedNames, names[j])
+			}
+		}
+		operations, err := planPolicySetRules(remote, testPlannedRules(plannedNames...))
+		if err != nil {
+			t.Fatalf("bad: unexpected error %s", err)
+		}
+		if got := testApplyPolicySetRules(remote, operations); !reflect.DeepEqual(got, plannedNames) && len(got)+len(plannedNames) > 0 {
+			t.Fatalf("bad: expect %v from %v, got %v with %v", plannedNames, remoteNames, got, operations)
+		}
+
+		// Every rule kept out of the longest increasing subsequence is moved
+		var positions []int
+		for _, name := range plannedNames {
+			if j := indexOfString(remoteNames, name); j >= 0 {
+				positions = append(positions, j)
+			}
+		}
+		moves := 0
+		for _, operation := range operations {
+			if operation.action == POLICY_SET_RULE_MOVE {
+				moves++
+			}
+		}
+		if expected := len(positions) - len(longestIncreasingSubsequence(positions)); moves != expected {
+			t.Fatalf("bad: expect %d moves from %v to %v, got %v", expected, remoteNames, plannedNames, operations)
+		}
+	}
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	cases := []struct {
+		values   []int
+		expected []int
+	}{
+		{nil, []int{}},
+		{[]int{0, 1, 2}, []int{0, 1, 2}},
+		{[]int{2, 1, 0}, []int{2}},
+		{[]int{3, 0, 1, 4, 2}, []int{1, 2, 4}},
+	}
+	for _, c := range cases {
+		if got := longestIncreasingSubsequence(c.values); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("bad: expect %v for %v, got %v", c.expected, c.values, got)
+		}
+	}
+}
+
+func TestPolicySetRuleRequest(t *testing.T) {
+	remote := testPolicySetRules("Staff")[0]
+	remote.values["security_group"] = "Employees"
+	planned := testPlannedRules("Staff")[0]
+	planned["condition_expression"] = `Radius:NAS-Port-Type EQUALS "Ethernet" AND NOT Lib:01234567-89ab-cdef-0123-456789abcdef`
+	planned["profile"] = []interface{}{"DenyAccess"}
+	planned["security_group"] = ""
+
+	request, err := policySetRuleRequest(nil, networkAccessConditionLibrary, planned, remote, 3)
+	if err != nil {
+		t.Fatalf("bad: unexpected error %s", err)
+	}
+	requestRule := &isegosdk.RequestNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByID{}
+	if err := responseToJSONTypes(request, requestRule); err != nil {
+		t.Fatalf("bad: unexpected error %s", err)
+	}
+	if requestRule.Rule == nil || requestRule.Rule.ID != "id-Staff" || *requestRule.Rule.Rank != 3 || requestRule.Rule.State != "enabled" {
+		t.Errorf("bad: unexpected rule %+v", requestRule.Rule)
+	}
+	if !reflect.DeepEqual(requestRule.Profile, []string{"DenyAccess"}) || requestRule.SecurityGroup != "Employees" {
+		t.Errorf("bad: expect the declared profile and the remote security group, got %v %s", requestRule.Profile, requestRule.SecurityGroup)
+	}
+	condition := requestRule.Rule.Condition
+	if condition == nil || condition.ConditionType != CONDITION_TYPE_AND_BLOCK || condition.Children == nil || len(*condition.Children) != 2 ||
+		(*condition.Children)[1].ID != "01234567-89ab-cdef-0123-456789abcdef" {
+		t.Errorf("bad: unexpected condition %+v", condition)
+	}
+
+	planned["condition_expression"] = ""
+	remote.condition = &conditionNode{ConditionType: "ConditionAttributes"}
+	remote.values["condition_expression"] = ""
+	if _, err := policySetRuleRequest(nil, networkAccessConditionLibrary, planned, remote, 3); err == nil {
+		t.Errorf("bad: expect an error for a condition without expression")
+	}
+}
+
+func TestPolicySetRulesApply(t *testing.T) {
+	const path = "/api/v1/policy/network-access/policy-set/ps1/authorization"
+	rules := `[{"rule": {"id": "r1", "name": "Staff", "rank": 0, "state": "enabled", "default": false, "condition": {"conditionType": "ConditionOrBlock", "isNegate": false, "children": [
+		{"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "Radius", "attributeName": "User-Name", "operator": "equals", "attributeValue": "alice"},
+		{"conditionType": "ConditionAndBlock", "isNegate": false, "children": [
+			{"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "Radius", "attributeName": "NAS-Port-Type", "operator": "equals", "attributeValue": "Ethernet"},
+			{"conditionType": "ConditionAttributes", "isNegate": true, "dictionaryName": "Radius", "attributeName": "User-Name", "operator": "equals", "attributeValue": "bob"}
+		]}
+	]}}, "profile": ["PermitAccess"]}]`
+	var sent map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			w.Write([]byte(`{"response": ` + rules + `}`))
+		case r.Method == http.MethodPut && r.URL.Path == path+"/r1":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("err: %s", err)
+			}
+			w.Write([]byte(`{"response": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	clientConfig.Config.UseAPIGateway = "true"
+
+	kind := networkAccessPolicySetRules.kinds[3]
+	remote, err := readPolicySetRules(ctx, clientConfig, networkAccessConditionLibrary, kind, "ps1")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expected := `Radius:User-Name EQUALS "alice" OR (Radius:NAS-Port-Type EQUALS "Ethernet" AND NOT Radius:User-Name EQUALS "bob")`
+	if len(remote) != 1 || remote[0].values["condition_expression"] != expected {
+		t.Fatalf("bad: expect the nested condition %s, got %v", expected, remote)
+	}
+
+	expression := `(Radius:NAS-Port-Type EQUALS "Ethernet" OR Radius:NAS-Port-Type EQUALS "Virtual") AND Radius:User-Name EQUALS "alice"`
+	d := schema.TestResourceDataRaw(t, resourceNetworkAccessPolicySetRules().Schema, map[string]interface{}{
+		"policy_id": "ps1",
+		"authorization_rule": []interface{}{map[string]interface{}{
+			"name":                 "Staff",
+			"condition_expression": expression,
+			"profile":              []interface{}{"PermitAccess"},
+		}},
+	})
+	if diags := policySetRulesApply(ctx, d, clientConfig, networkAccessPolicySetRules); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	rule, _ := sent["rule"].(map[string]interface{})
+	node, err := conditionFromResponse(rule["condition"].(map[string]interface{}))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got, err := renderConditionExpression(node); err != nil || got != expression {
+		t.Errorf("bad: expect the nested condition %s to be sent, got %s %v", expression, got, err)
+	}
+}
+
+func TestPolicySetRuleJSONKey(t *testing.T) {
+	cases := map[string]string{
+		"profile":              "profile",
+		"security_group":       "securityGroup",
+		"identity_source_name": "identitySourceName",
+		"if_user_not_found":    "ifUserNotFound",
+	}
+	for key, expected := range cases {
+		if got := policySetRuleJSONKey(key); got != expected {
+			t.Errorf("bad: expect %s for %s, got %s", expected, key, got)
+		}
+	}
+}
+
+func TestPolicySetRulesRemoveKind(t *testing.T) {
+	const path = "/api/v1/policy/network-access/policy-set/ps1/authorization"
+	staff := `{"rule": {"id": "r1", "name": "Staff", "rank": 0, "state": "enabled", "default": false, "condition": {"conditionType": "ConditionAttributes", "isNegate": false, "dictionaryName": "Radius", "attributeName": "User-Name", "operator": "equals", "attributeValue": "alice"}}, "profile": ["PermitAccess"]}`
+	defaultRule := `{"rule": {"id": "rd", "name": "Default", "rank": 1, "state": "enabled", "default": true}, "profile": ["DenyAccess"]}`
+	rules := []string{staff, defaultRule}
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/policy/network-access/policy-set":
+			w.Write([]byte(`{"response": [{"id": "ps1", "name": "Wired"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			w.Write([]byte(`{"response": [` + strings.Join(rules, ",") + `]}`))
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, path+"/"):
+			deleted = append(deleted, strings.TrimPrefix(r.URL.Path, path+"/"))
+			w.Write([]byte(`{"response": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	clientConfig.Config.UseAPIGateway = "true"
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	// Every authorization rule is removed from the configuration
+	s := resourceNetworkAccessPolicySetRules().Schema
+	d, err := schema.InternalMap(s).Data(&terraform.InstanceState{ID: "v2:policy_id=ps1", Attributes: map[string]string{
+		"id":                                        "v2:policy_id=ps1",
+		"policy_id":                                 "ps1",
+		"managed_kinds.#":                           "1",
+		"managed_kinds.1234":                        "authorization_rule",
+		"authorization_rule.#":                      "1",
+		"authorization_rule.0.id":                   "r1",
+		"authorization_rule.0.name":                 "Staff",
+		"authorization_rule.0.state":                "enabled",
+		"authorization_rule.0.profile.#":            "1",
+		"authorization_rule.0.profile.0":            "PermitAccess",
+		"authorization_rule.0.condition_expression": `Radius:User-Name EQUALS "alice"`,
+	}}, &terraform.InstanceDiff{Attributes: map[string]*terraform.ResourceAttrDiff{
+		"authorization_rule.#": {Old: "1", New: "0"},
+	}})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diags := policySetRulesApply(ctx, d, clientConfig, networkAccessPolicySetRules); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if !reflect.DeepEqual(deleted, []string{"r1"}) {
+		t.Errorf("bad: expect the rules but the default one to be deleted, got %v", deleted)
+	}
+
+	// The kind stays managed, a rule created on ISE is read
+	d, err = schema.InternalMap(s).Data(&terraform.InstanceState{ID: "v2:policy_id=ps1", Attributes: map[string]string{
+		"id":                   "v2:policy_id=ps1",
+		"policy_id":            "ps1",
+		"managed_kinds.#":      "1",
+		"managed_kinds.1234":   "authorization_rule",
+		"authorization_rule.#": "0",
+	}}, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diags := policySetRulesRead(networkAccessPolicySetRules)(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if names := d.Get("authorization_rule.#"); names != 1 || d.Get("authorization_rule.0.name") != "Staff" {
+		t.Errorf("bad: expect the rule created on ISE to be read, got %v", d.Get("authorization_rule"))
+	}
+	if kinds := d.Get("managed_kinds").(*schema.Set); kinds.Len() != 1 || !kinds.Contains("authorization_rule") {
+		t.Errorf("bad: expect the kind to stay managed, got %v", kinds.List())
+	}
+}
@@ -0,0 +1,36 @@
+package ciscoise
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyFunc selects the proxy of every outgoing request. proxy_url and
+// no_proxy take precedence over the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+// environment variables.
+func (c *Config) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	proxyConfig := httpproxy.FromEnvironment()
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %v", err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy_url %q, the scheme must be http, https or socks5", c.ProxyURL)
+		}
+		proxyConfig.HTTPProxy = c.ProxyURL
+		proxyConfig.HTTPSProxy = c.ProxyURL
+	}
+	if c.NoProxy != "" {
+		proxyConfig.NoProxy = c.NoProxy
+	}
+	proxy := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
@@ -0,0 +1,48 @@
+package ciscoise
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestConfigProxyFunc(t *testing.T) {
+	config := Config{
+		ProxyURL: "http://proxy.example.com:3128",
+		NoProxy:  "10.0.0.0/8,.lab.example.com",
+	}
+	proxy, err := config.proxyFunc()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cases := map[string]struct {
+		URL         string
+		ExpectProxy string
+	}{
+		"proxied ers":             {URL: "https://ise.example.com:9060/ers/config/networkdevice", ExpectProxy: "proxy.example.com:3128"},
+		"proxied openapi":         {URL: "https://ise.example.com/api/v1/deployment/node", ExpectProxy: "proxy.example.com:3128"},
+		"excluded ip range":       {URL: "https://10.1.2.3/api/v1/deployment/node", ExpectProxy: ""},
+		"excluded domain":         {URL: "https://pan1.lab.example.com/api/v1/deployment/node", ExpectProxy: ""},
+		"not excluded ip address": {URL: "https://192.168.1.10/api/v1/deployment/node", ExpectProxy: "proxy.example.com:3128"},
+	}
+	for tn, tc := range cases {
+		req, _ := http.NewRequest(http.MethodGet, tc.URL, nil)
+		proxyURL, err := proxy(req)
+		if err != nil {
+			t.Fatalf("bad: %s, unexpected error %v", tn, err)
+		}
+		result := ""
+		if proxyURL != nil {
+			result = proxyURL.Host
+		}
+		if result != tc.ExpectProxy {
+			t.Errorf("bad: %s, '%s' expect proxy '%s', got '%s'", tn, tc.URL, tc.ExpectProxy, result)
+		}
+	}
+}
+
+func TestConfigProxyFuncInvalidURL(t *testing.T) {
+	config := Config{ProxyURL: "ftp://proxy.example.com"}
+	if _, err := config.proxyFunc(); err == nil {
+		t.Errorf("bad: expect proxyFunc to reject an ftp proxy")
+	}
+}
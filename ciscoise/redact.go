@@ -0,0 +1,99 @@
+package ciscoise
+
+import (
+	"io"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const redactedValue = "********"
+
+// sensitiveKeys are the request and response attributes holding secrets
+// that do not end with password, secret or passphrase.
+var sensitiveKeys = map[string]bool{
+	"skey":                        true,
+	"token":                       true,
+	"privatekeydata":              true,
+	"encryptionkey":               true,
+	"backupencryptionkey":         true,
+	"keyencryptionkey":            true,
+	"messageauthenticatorcodekey": true,
+	"authenticatorkey":            true,
+}
+
+var sensitiveKeySuffixes = []string{"password", "secret", "passphrase"}
+
+var (
+	// "key": "value", as in JSON documents
+	redactJSONPattern = regexp.MustCompile(`"([A-Za-z0-9_\-]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+	// \"key\": \"value\", as in JSON documents embedded in a string
+	redactEscapedJSONPattern = regexp.MustCompile(`\\"([A-Za-z0-9_\-]+)\\"(\s*:\s*)\\"((?:[^"\\]|\\[^"])*)\\"`)
+	// key:value, as in Go maps and structs printed with %v
+	redactGoPattern = regexp.MustCompile(`([\[{ ,]|^)([A-Za-z0-9_]+):([^\s\]}\[{,"]+)`)
+	// Authorization: Basic dXNlcjpwYXNz
+	redactAuthorizationPattern = regexp.MustCompile(`(?i)(authorization["']?\s*[:=]\s*\[?["']?\s*(?:basic|bearer)\s+)[A-Za-z0-9+/=._\-]+`)
+)
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	if sensitiveKeys[normalized] {
+		return true
+	}
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactSecrets masks the values of sensitive attributes and the
+// Authorization header in s, it is applied to every log line and diagnostic.
+func redactSecrets(s string) string {
+	s = redactAuthorizationPattern.ReplaceAllString(s, "${1}"+redactedValue)
+	s = redactJSONPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := redactJSONPattern.FindStringSubmatch(match)
+		if !isSensitiveKey(groups[1]) || groups[3] == "" {
+			return match
+		}
+		return `"` + groups[1] + `"` + groups[2] + `"` + redactedValue + `"`
+	})
+	s = redactEscapedJSONPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := redactEscapedJSONPattern.FindStringSubmatch(match)
+		if !isSensitiveKey(groups[1]) || groups[3] == "" {
+			return match
+		}
+		return `\"` + groups[1] + `\"` + groups[2] + `\"` + redactedValue + `\"`
+	})
+	s = redactGoPattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := redactGoPattern.FindStringSubmatch(match)
+		if !isSensitiveKey(groups[2]) {
+			return match
+		}
+		return groups[1] + groups[2] + ":" + redactedValue
+	})
+	return s
+}
+
+type redactingWriter struct {
+	w io.Writer
+}
+
+func (r *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := r.w.Write([]byte(redactSecrets(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+var redactLogOnce sync.Once
+
+// redactLogOutput masks secrets in everything written through the standard
+// logger, which is where the provider debug output goes.
+func redactLogOutput() {
+	redactLogOnce.Do(func() {
+		log.SetOutput(&redactingWriter{w: log.Writer()})
+	})
+}
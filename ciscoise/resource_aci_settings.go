@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -299,8 +297,8 @@ func resourceAciSettings() *schema.Resource {
 }
 
 func resourceAciSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AciSettings create")
-	log.Printf("[DEBUG] Missing AciSettings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning AciSettings create")
+	logDebugf(ctx, "Missing AciSettings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -309,15 +307,15 @@ func resourceAciSettingsCreate(ctx context.Context, d *schema.ResourceData, m in
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
 	vvID := interfaceToString(resourceItem["id"])
-	log.Printf("[DEBUG] ID used for update operation %s", vvID)
+	logDebugf(ctx, "ID used for update operation %s", vvID)
 	request1 := expandRequestAciSettingsUpdateAciSettingsByID(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.AciSettings.UpdateAciSettingsByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateAciSettingsByID", err, restyResp1.String(),
 				"Failure at UpdateAciSettingsByID, unexpected response", ""))
@@ -335,7 +333,7 @@ func resourceAciSettingsCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AciSettings read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AciSettings read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -343,19 +341,19 @@ func resourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAciSettings")
+		logDebugf(ctx, "Selected method: GetAciSettings")
 
 		response1, restyResp1, err := client.AciSettings.GetAciSettings()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenAciSettingsGetAciSettingsItem(response1.AciSettings)
 		if err := d.Set("item", vItem1); err != nil {
@@ -364,7 +362,7 @@ func resourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAciSettings response to item",
 				err))
@@ -377,7 +375,7 @@ func resourceAciSettingsRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceAciSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AciSettings update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AciSettings update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -388,15 +386,15 @@ func resourceAciSettingsUpdate(ctx context.Context, d *schema.ResourceData, m in
 	vID, _ := resourceMap["id"]
 	vvID := vID
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestAciSettingsUpdateAciSettingsByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.AciSettings.UpdateAciSettingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAciSettingsByID", err, restyResp1.String(),
 					"Failure at UpdateAciSettingsByID, unexpected response", ""))
@@ -414,9 +412,9 @@ func resourceAciSettingsUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAciSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AciSettings delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AciSettings delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing AciSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing AciSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestAciSettingsUpdateAciSettingsByID(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestAciSettingsUpdateAciSettingsByID {
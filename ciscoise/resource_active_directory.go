@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceActiveDirectory() *schema.Resource {
 		ReadContext:   resourceActiveDirectoryRead,
 		UpdateContext: resourceActiveDirectoryUpdate,
 		DeleteContext: resourceActiveDirectoryDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceActiveDirectoryRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -746,45 +742,21 @@ default value if schema is ACTIVE_DIRECTORY. Values can be changed only for CUST
 }
 
 func resourceActiveDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectory create")
+	logDebugf(ctx, "Beginning ActiveDirectory create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestActiveDirectoryCreateActiveDirectory(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.ActiveDirectory.GetActiveDirectoryByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceActiveDirectoryRead(ctx, d, m)
-			}
-		} else {
-			if okName && vvName != "" {
-				getResponse2, _, err := client.ActiveDirectory.GetActiveDirectoryByName(vvName)
-				if err == nil && getResponse2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = getResponse2.ERSActiveDirectory.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceActiveDirectoryRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.ActiveDirectory.CreateActiveDirectory(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -808,7 +780,7 @@ func resourceActiveDirectoryCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectory read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectory read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -820,26 +792,26 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectoryByName")
+		logDebugf(ctx, "Selected method: GetActiveDirectoryByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.ActiveDirectory.GetActiveDirectoryByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenActiveDirectoryGetActiveDirectoryByNameItemName(response1.ERSActiveDirectory)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -848,7 +820,7 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetActiveDirectoryByName response to item",
 				err))
@@ -858,20 +830,20 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetActiveDirectoryByID")
+		logDebugf(ctx, "Selected method: GetActiveDirectoryByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.ActiveDirectory.GetActiveDirectoryByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenActiveDirectoryGetActiveDirectoryByIDItemID(response2.ERSActiveDirectory)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -880,7 +852,7 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetActiveDirectoryByID response",
 				err))
@@ -893,7 +865,7 @@ func resourceActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectory update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectory update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -908,9 +880,9 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 					vName, okName := resourceMap["name"]
 					vID, okID := resourceMap["id"]
 					method1 := []bool{okID}
-					log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+					logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 					method2 := []bool{okName}
-					log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+					logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 					selectedMethod := pickMethod([][]bool{method1, method2})
 					var vvID string
@@ -930,11 +902,11 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 					}
 
 					if vvID != "" {
-						log.Printf("[DEBUG] Selected method: LoadGroupsFromDomain")
+						logDebugf(ctx, "Selected method: LoadGroupsFromDomain")
 						request1 := expandRequestActiveDirectoryAddGroupsLoadGroupsFromDomain(ctx, "parameters.0", d)
 
 						if request1 != nil {
-							log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+							logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 						}
 						if request1 != nil && request1.ERSActiveDirectory != nil {
 							request1.ERSActiveDirectory.ID = vvID
@@ -943,7 +915,7 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 
 						if err != nil || response1 == nil {
 							if response1 != nil {
-								log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+								logDebugf(ctx, "Retrieved error response %s", response1.String())
 								diags = append(diags, diagErrorWithAltAndResponse(
 									"Failure when executing LoadGroupsFromDomain", err, response1.String(),
 									"Failure at LoadGroupsFromDomain, unexpected response", ""))
@@ -955,20 +927,20 @@ func resourceActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData,
 							return diags
 						}
 
-						log.Printf("[DEBUG] Retrieved response %s", response1.String())
+						logDebugf(ctx, "Retrieved response %s", response1.String())
 						_ = d.Set("last_updated", getUnixTimeString())
 					}
 				}
 			}
 		}
 	} else {
-		log.Printf("[DEBUG] Missing ActiveDirectory update on Cisco ISE. It will only be update it on Terraform. Resource only performs update if it has changes in adgroups.")
+		logDebugf(ctx, "Missing ActiveDirectory update on Cisco ISE. It will only be update it on Terraform. Resource only performs update if it has changes in adgroups.")
 	}
 	return resourceActiveDirectoryRead(ctx, d, m)
 }
 
 func resourceActiveDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectory delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectory delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -980,9 +952,9 @@ func resourceActiveDirectoryDelete(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1010,7 +982,7 @@ func resourceActiveDirectoryDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.ActiveDirectory.DeleteActiveDirectoryByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteActiveDirectoryByID", err, restyResp1.String(),
 				"Failure at DeleteActiveDirectoryByID, unexpected response", ""))
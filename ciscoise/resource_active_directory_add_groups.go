@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -434,7 +432,7 @@ func resourceActiveDirectoryAddGroupsCreate(ctx context.Context, d *schema.Resou
 	response1, err := client.ActiveDirectory.LoadGroupsFromDomain(vvID, request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
@@ -444,7 +442,7 @@ func resourceActiveDirectoryAddGroupsCreate(ctx context.Context, d *schema.Resou
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +21,9 @@ func resourceActiveDirectoryJoinDomain() *schema.Resource {
 		CreateContext: resourceActiveDirectoryJoinDomainCreate,
 		ReadContext:   resourceActiveDirectoryJoinDomainRead,
 		DeleteContext: resourceActiveDirectoryJoinDomainDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ACTIVE_DIRECTORY_JOIN_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -74,19 +76,27 @@ func resourceActiveDirectoryJoinDomain() *schema.Resource {
 }
 
 func resourceActiveDirectoryJoinDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning JoinDomain create")
-	log.Printf("[DEBUG] Missing JoinDomain create on Cisco ISE. It will only be create it on Terraform")
-	clientConfig := m.(ClientConfig)
-	client := clientConfig.Client
+	logDebugf(ctx, "Beginning JoinDomain create")
+	logDebugf(ctx, "Missing JoinDomain create on Cisco ISE. It will only be create it on Terraform")
 	vID := d.Get("parameters.0.id")
 	var diags diag.Diagnostics
 
 	request1 := expandRequestActiveDirectoryJoinDomainJoinDomain(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	vvID := vID.(string)
-	response1, err := client.ActiveDirectory.JoinDomain(vvID, request1)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, err = client.ActiveDirectory.JoinDomain(vvID, request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for JoinDomain", errWait))
+		return diags
+	}
 	if err != nil || response1 == nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing JoinDomain", err,
@@ -94,7 +104,7 @@ func resourceActiveDirectoryJoinDomainCreate(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -114,9 +124,9 @@ func resourceActiveDirectoryJoinDomainRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceActiveDirectoryJoinDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectoryJoinDomain delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectoryJoinDomain delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing ActiveDirectoryJoinDomain delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing ActiveDirectoryJoinDomain delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestActiveDirectoryJoinDomainJoinDomain(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestActiveDirectoryJoinDomain {
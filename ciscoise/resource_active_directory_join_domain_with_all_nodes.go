@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +21,9 @@ func resourceActiveDirectoryJoinDomainWithAllNodes() *schema.Resource {
 		CreateContext: resourceActiveDirectoryJoinDomainWithAllNodesCreate,
 		ReadContext:   resourceActiveDirectoryJoinDomainWithAllNodesRead,
 		DeleteContext: resourceActiveDirectoryJoinDomainWithAllNodesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ACTIVE_DIRECTORY_JOIN_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -74,10 +76,8 @@ func resourceActiveDirectoryJoinDomainWithAllNodes() *schema.Resource {
 }
 
 func resourceActiveDirectoryJoinDomainWithAllNodesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning JoinDomainWithAllNodes create")
-	log.Printf("[DEBUG] Missing JoinDomainWithAllNodes create on Cisco ISE. It will only be create it on Terraform")
-	clientConfig := m.(ClientConfig)
-	client := clientConfig.Client
+	logDebugf(ctx, "Beginning JoinDomainWithAllNodes create")
+	logDebugf(ctx, "Missing JoinDomainWithAllNodes create on Cisco ISE. It will only be create it on Terraform")
 
 	var diags diag.Diagnostics
 	resourceItem := *getResourceItem(d.Get("parameters"))
@@ -85,12 +85,22 @@ func resourceActiveDirectoryJoinDomainWithAllNodesCreate(ctx context.Context, d
 	vvID := vID.(string)
 	request1 := expandRequestActiveDirectoryJoinDomainWithAllNodesJoinDomainWithAllNodes(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, err = client.ActiveDirectory.JoinDomainWithAllNodes(vvID, request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for JoinDomainWithAllNodes", errWait))
+		return diags
 	}
-	response1, err := client.ActiveDirectory.JoinDomainWithAllNodes(vvID, request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing JoinDomainWithAllNodes", err, response1.String(),
 				"Failure at JoinDomainWithAllNodes, unexpected response", ""))
@@ -101,7 +111,7 @@ func resourceActiveDirectoryJoinDomainWithAllNodesCreate(ctx context.Context, d
 			"Failure at JoinDomainWithAllNodes, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -121,9 +131,9 @@ func resourceActiveDirectoryJoinDomainWithAllNodesRead(ctx context.Context, d *s
 }
 
 func resourceActiveDirectoryJoinDomainWithAllNodesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectoryJoinDomainWithAllNodes delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectoryJoinDomainWithAllNodes delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing ActiveDirectoryJoinDomainWithAllNodes delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing ActiveDirectoryJoinDomainWithAllNodes delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +21,9 @@ func resourceActiveDirectoryLeaveDomainWithAllNodes() *schema.Resource {
 		CreateContext: resourceActiveDirectoryLeaveDomainWithAllNodesCreate,
 		ReadContext:   resourceActiveDirectoryLeaveDomainWithAllNodesRead,
 		DeleteContext: resourceActiveDirectoryLeaveDomainWithAllNodesDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ACTIVE_DIRECTORY_JOIN_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -71,19 +73,27 @@ func resourceActiveDirectoryLeaveDomainWithAllNodes() *schema.Resource {
 }
 
 func resourceActiveDirectoryLeaveDomainWithAllNodesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LeaveDomainWithAllNodes create")
-	log.Printf("[DEBUG] Missing LeaveDomainWithAllNodes create on Cisco ISE. It will only be create it on Terraform")
-	clientConfig := m.(ClientConfig)
-	client := clientConfig.Client
+	logDebugf(ctx, "Beginning LeaveDomainWithAllNodes create")
+	logDebugf(ctx, "Missing LeaveDomainWithAllNodes create on Cisco ISE. It will only be create it on Terraform")
 	vID := d.Get("parameters.0.id")
 	var diags diag.Diagnostics
 
 	request1 := expandRequestActiveDirectoryLeaveDomainWithAllNodesLeaveDomainWithAllNodes(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	vvID := vID.(string)
-	response1, err := client.ActiveDirectory.LeaveDomainWithAllNodes(vvID, request1)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, err = client.ActiveDirectory.LeaveDomainWithAllNodes(vvID, request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for LeaveDomainWithAllNodes", errWait))
+		return diags
+	}
 	if err != nil || response1 == nil {
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing LeaveDomainWithAllNodes", err,
@@ -91,7 +101,7 @@ func resourceActiveDirectoryLeaveDomainWithAllNodesCreate(ctx context.Context, d
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -111,9 +121,9 @@ func resourceActiveDirectoryLeaveDomainWithAllNodesRead(ctx context.Context, d *
 }
 
 func resourceActiveDirectoryLeaveDomainWithAllNodesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ActiveDirectoryLeaveDomainWithAllNodes delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ActiveDirectoryLeaveDomainWithAllNodes delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing ActiveDirectoryLeaveDomainWithAllNodes delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing ActiveDirectoryLeaveDomainWithAllNodes delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestActiveDirectoryLeaveDomainWithAllNodesLeaveDomainWithAllNodes(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestActiveDirectoryLeaveDomainWithAllNodes {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceAllowedProtocols() *schema.Resource {
 		ReadContext:   resourceAllowedProtocolsRead,
 		UpdateContext: resourceAllowedProtocolsUpdate,
 		DeleteContext: resourceAllowedProtocolsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceAllowedProtocolsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1211,44 +1207,21 @@ Valid range is 0-3`,
 }
 
 func resourceAllowedProtocolsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AllowedProtocols create")
+	logDebugf(ctx, "Beginning AllowedProtocols create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestAllowedProtocolsCreateAllowedProtocol(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.AllowedProtocols.GetAllowedProtocolByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAllowedProtocolsRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.AllowedProtocols.GetAllowedProtocolByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.AllowedProtocols.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAllowedProtocolsRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.AllowedProtocols.CreateAllowedProtocol(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1272,7 +1245,7 @@ func resourceAllowedProtocolsCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AllowedProtocols read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AllowedProtocols read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1284,26 +1257,26 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAllowedProtocolByName")
+		logDebugf(ctx, "Selected method: GetAllowedProtocolByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.AllowedProtocols.GetAllowedProtocolByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenAllowedProtocolsGetAllowedProtocolByNameItemName(response1.AllowedProtocols)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -1312,7 +1285,7 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAllowedProtocolByName response",
 				err))
@@ -1322,20 +1295,20 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAllowedProtocolByID")
+		logDebugf(ctx, "Selected method: GetAllowedProtocolByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.AllowedProtocols.GetAllowedProtocolByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenAllowedProtocolsGetAllowedProtocolByIDItemID(response2.AllowedProtocols)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -1344,7 +1317,7 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAllowedProtocolByID response",
 				err))
@@ -1357,7 +1330,7 @@ func resourceAllowedProtocolsRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAllowedProtocolsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AllowedProtocols update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AllowedProtocols update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1369,9 +1342,9 @@ func resourceAllowedProtocolsUpdate(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1394,15 +1367,15 @@ func resourceAllowedProtocolsUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestAllowedProtocolsUpdateAllowedProtocolByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.AllowedProtocols.UpdateAllowedProtocolByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAllowedProtocolByID", err, restyResp1.String(),
 					"Failure at UpdateAllowedProtocolByID, unexpected response", ""))
@@ -1420,7 +1393,7 @@ func resourceAllowedProtocolsUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAllowedProtocolsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AllowedProtocols delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AllowedProtocols delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1432,9 +1405,9 @@ func resourceAllowedProtocolsDelete(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1462,7 +1435,7 @@ func resourceAllowedProtocolsDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.AllowedProtocols.DeleteAllowedProtocolByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAllowedProtocolByID", err, restyResp1.String(),
 				"Failure at DeleteAllowedProtocolByID, unexpected response", ""))
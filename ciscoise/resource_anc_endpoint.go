@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,9 +23,7 @@ func resourceAncEndpoint() *schema.Resource {
 		ReadContext:   resourceAncEndpointRead,
 		UpdateContext: resourceAncEndpointUpdate,
 		DeleteContext: resourceAncEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceAncEndpointRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -134,11 +130,10 @@ func resourceAncEndpoint() *schema.Resource {
 }
 
 func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncEndpoint create")
+	logDebugf(ctx, "Beginning AncEndpoint create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
@@ -162,43 +157,6 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 	if okPolicyName {
 		vvPolicyName = vPolicyName.(string)
 	}
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			response2, _, err := client.AncEndpoint.GetAncEndpointByID(vvID)
-			if err == nil && response2 != nil && response2.ErsAncEndpoint != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				if vvMacAddress == "" {
-					vvMacAddress = response2.ErsAncEndpoint.MacAddress
-				}
-				if vvPolicyName == "" {
-					vvPolicyName = response2.ErsAncEndpoint.PolicyName
-				}
-				resourceMap["ip_address"] = vvIpAddress
-				resourceMap["mac_address"] = vvMacAddress
-				resourceMap["policy_name"] = vvPolicyName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAncEndpointRead(ctx, d, m)
-			}
-		} else {
-			queryParams1 := isegosdk.GetAncEndpointQueryParams{}
-			queryParams1.Filter = []string{fmt.Sprintf("name.EQ.%s", vvPolicyName)}
-			response1, _, err := client.AncEndpoint.GetAncEndpoint(&queryParams1)
-			if err == nil && response1 != nil {
-				items1 := getAllItemsAncEndpointGetAncEndpoint(m, response1, &queryParams1)
-				item1, err := searchAncEndpointGetAncEndpoint(m, items1, vvPolicyName, vvMacAddress, vvID)
-				if err == nil && item1 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item1.ID
-					resourceMap["ip_address"] = vvIpAddress
-					resourceMap["mac_address"] = vvMacAddress
-					resourceMap["policy_name"] = vvPolicyName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceAncEndpointRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	additional_data := []isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{}
 	if vvIpAddress != "" {
 		ip_address_additional_data := isegosdk.RequestAncEndpointApplyAncEndpointOperationAdditionalDataAdditionalData{
@@ -228,12 +186,12 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 	response1, err := client.AncEndpoint.ApplyAncEndpoint(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ApplyAncEndpoint", err, response1.String(),
 				"Failure at ApplyAncEndpoint, unexpected response", ""))
@@ -245,7 +203,7 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	resourceMap := make(map[string]string)
 	resourceMap["id"] = vvID
@@ -257,7 +215,7 @@ func resourceAncEndpointCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncEndpoint read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncEndpoint read for id=[%s]", d.Id())
 
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
@@ -270,19 +228,19 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 	vvMacAddress, _ := resourceMap["mac_address"]
 	vvPolicyName, _ := resourceMap["policy_name"]
 	if !okID {
-		log.Printf("[DEBUG] Selected method: GetAncEndpoint")
+		logDebugf(ctx, "Selected method: GetAncEndpoint")
 		queryParams1 := isegosdk.GetAncEndpointQueryParams{}
 		queryParams1.Filter = []string{fmt.Sprintf("name.EQ.%s", vvPolicyName)}
 		response1, restyResp1, err := client.AncEndpoint.GetAncEndpoint(&queryParams1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsAncEndpointGetAncEndpoint(m, response1, &queryParams1)
 		item1, err := searchAncEndpointGetAncEndpoint(m, items1, vvPolicyName, vvMacAddress, vvID)
@@ -298,7 +256,7 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAncEndpoint response",
 				err))
@@ -307,19 +265,19 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diags
 	}
 	if okID && vvID != "" {
-		log.Printf("[DEBUG] Selected method: GetAncEndpointByID")
+		logDebugf(ctx, "Selected method: GetAncEndpointByID")
 
 		response2, restyResp2, err := client.AncEndpoint.GetAncEndpointByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenAncEndpointGetAncEndpointByIDItem(response2.ErsAncEndpoint)
 		if err := d.Set("item", vItem2); err != nil {
@@ -328,7 +286,7 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAncEndpointByID response",
 				err))
@@ -340,14 +298,14 @@ func resourceAncEndpointRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceAncEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncEndpoint update for id=[%s]", d.Id())
-	log.Printf("[DEBUG] Missing AncEndpoint update on Cisco ISE. It will only be update it on Terraform")
+	logDebugf(ctx, "Beginning AncEndpoint update for id=[%s]", d.Id())
+	logDebugf(ctx, "Missing AncEndpoint update on Cisco ISE. It will only be update it on Terraform")
 	// d.Set("last_updated", getUnixTimeString())
 	return resourceAncEndpointRead(ctx, d, m)
 }
 
 func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncEndpoint delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncEndpoint delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -369,7 +327,7 @@ func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m in
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsAncEndpointGetAncEndpoint(m, response1, &queryParams1)
 		item1, err := searchAncEndpointGetAncEndpoint(m, items1, vvPolicyName, vvMacAddress, vvID)
@@ -413,12 +371,12 @@ func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m in
 	response1, err := client.AncEndpoint.ClearAncEndpoint(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ClearAncEndpoint", err, response1.String(),
 				"Failure at ClearAncEndpoint, unexpected response", ""))
@@ -430,7 +388,7 @@ func resourceAncEndpointDelete(ctx context.Context, d *schema.ResourceData, m in
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
 	// it is added here for explicitness.
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceAncPolicy() *schema.Resource {
 		ReadContext:   resourceAncPolicyRead,
 		UpdateContext: resourceAncPolicyUpdate,
 		DeleteContext: resourceAncPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceAncPolicyRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -148,44 +144,21 @@ func resourceAncPolicy() *schema.Resource {
 }
 
 func resourceAncPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncPolicy create")
+	logDebugf(ctx, "Beginning AncPolicy create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestAncPolicyCreateAncPolicy(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.AncPolicy.GetAncPolicyByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAncPolicyRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.AncPolicy.GetAncPolicyByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.ErsAncPolicy.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAncPolicyRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.AncPolicy.CreateAncPolicy(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -209,7 +182,7 @@ func resourceAncPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncPolicy read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncPolicy read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -221,26 +194,26 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAncPolicyByName")
+		logDebugf(ctx, "Selected method: GetAncPolicyByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.AncPolicy.GetAncPolicyByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenAncPolicyGetAncPolicyByNameItemName(response1.ErsAncPolicy)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -249,7 +222,7 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAncPolicyByName response",
 				err))
@@ -259,20 +232,20 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAncPolicyByID")
+		logDebugf(ctx, "Selected method: GetAncPolicyByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.AncPolicy.GetAncPolicyByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenAncPolicyGetAncPolicyByIDItemID(response2.ErsAncPolicy)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -281,7 +254,7 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAncPolicyByID response",
 				err))
@@ -294,7 +267,7 @@ func resourceAncPolicyRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceAncPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncPolicy update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncPolicy update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -306,9 +279,9 @@ func resourceAncPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -331,15 +304,15 @@ func resourceAncPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestAncPolicyUpdateAncPolicyByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.AncPolicy.UpdateAncPolicyByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAncPolicyByID", err, restyResp1.String(),
 					"Failure at UpdateAncPolicyByID, unexpected response", ""))
@@ -357,7 +330,7 @@ func resourceAncPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceAncPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncPolicy delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncPolicy delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -369,9 +342,9 @@ func resourceAncPolicyDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -399,7 +372,7 @@ func resourceAncPolicyDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.AncPolicy.DeleteAncPolicyByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAncPolicyByID", err, restyResp1.String(),
 				"Failure at DeleteAncPolicyByID, unexpected response", ""))
@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceAncPolicyBulkRequest() *schema.Resource {
 		CreateContext: resourceAncPolicyBulkRequestCreate,
 		ReadContext:   resourceAncPolicyBulkRequestRead,
 		DeleteContext: resourceAncPolicyBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceAncPolicyBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceAncPolicyBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForAncPolicy create")
-	log.Printf("[DEBUG] Missing BulkRequestForAncPolicy create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForAncPolicy create")
+	logDebugf(ctx, "Missing BulkRequestForAncPolicy create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -73,17 +75,23 @@ func resourceAncPolicyBulkRequestCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting BulkRequestForAncPolicy response",
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForAncPolicy", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.AncPolicy.MonitorBulkStatusAncPolicy(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceAncPolicyBulkRequestRead(ctx, d, m)
+	return append(diags, resourceAncPolicyBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceAncPolicyBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -92,9 +100,9 @@ func resourceAncPolicyBulkRequestRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAncPolicyBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AncPolicyBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AncPolicyBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing AncPolicyBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing AncPolicyBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestAncPolicyBulkRequestBulkRequestForAncPolicy(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestAncPolicyBulkRequestForAncPolicy {
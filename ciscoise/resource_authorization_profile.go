@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceAuthorizationProfile() *schema.Resource {
 		ReadContext:   resourceAuthorizationProfileRead,
 		UpdateContext: resourceAuthorizationProfileUpdate,
 		DeleteContext: resourceAuthorizationProfileDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceAuthorizationProfileRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -697,46 +693,23 @@ For all other 'WebRedirectionType' values the field must be ignored`,
 }
 
 func resourceAuthorizationProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AuthorizationProfile create")
+	logDebugf(ctx, "Beginning AuthorizationProfile create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestAuthorizationProfileCreateAuthorizationProfile(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
 
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.AuthorizationProfile.GetAuthorizationProfileByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAuthorizationProfileRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.AuthorizationProfile.GetAuthorizationProfileByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.AuthorizationProfile.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceAuthorizationProfileRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.AuthorizationProfile.CreateAuthorizationProfile(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -760,7 +733,7 @@ func resourceAuthorizationProfileCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AuthorizationProfile read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AuthorizationProfile read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -772,26 +745,26 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetAuthorizationProfileByName")
+		logDebugf(ctx, "Selected method: GetAuthorizationProfileByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.AuthorizationProfile.GetAuthorizationProfileByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenAuthorizationProfileGetAuthorizationProfileByNameItemName(response1.AuthorizationProfile)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -800,7 +773,7 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAuthorizationProfileByName response",
 				err))
@@ -810,20 +783,20 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetAuthorizationProfileByID")
+		logDebugf(ctx, "Selected method: GetAuthorizationProfileByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.AuthorizationProfile.GetAuthorizationProfileByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenAuthorizationProfileGetAuthorizationProfileByIDItemID(response2.AuthorizationProfile)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -832,7 +805,7 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetAuthorizationProfileByID response",
 				err))
@@ -845,7 +818,7 @@ func resourceAuthorizationProfileRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAuthorizationProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AuthorizationProfile update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AuthorizationProfile update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -857,9 +830,9 @@ func resourceAuthorizationProfileUpdate(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -882,15 +855,15 @@ func resourceAuthorizationProfileUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestAuthorizationProfileUpdateAuthorizationProfileByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.AuthorizationProfile.UpdateAuthorizationProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateAuthorizationProfileByID", err, restyResp1.String(),
 					"Failure at UpdateAuthorizationProfileByID, unexpected response", ""))
@@ -908,7 +881,7 @@ func resourceAuthorizationProfileUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAuthorizationProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AuthorizationProfile delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning AuthorizationProfile delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -920,9 +893,9 @@ func resourceAuthorizationProfileDelete(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -950,7 +923,7 @@ func resourceAuthorizationProfileDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.AuthorizationProfile.DeleteAuthorizationProfileByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAuthorizationProfileByID", err, restyResp1.String(),
 				"Failure at DeleteAuthorizationProfileByID, unexpected response", ""))
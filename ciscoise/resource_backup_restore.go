@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,8 +21,12 @@ to get the status of the backup job
 		CreateContext: resourceBackupRestoreCreate,
 		ReadContext:   resourceBackupRestoreRead,
 		DeleteContext: resourceBackupRestoreDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(BACKUP_RESTORE_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": waitForTaskSchema(),
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -108,8 +111,8 @@ to get the status of the backup job
 }
 
 func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RestoreConfigBackup create")
-	log.Printf("[DEBUG] Missing RestoreConfigBackup create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning RestoreConfigBackup create")
+	logDebugf(ctx, "Missing RestoreConfigBackup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -117,12 +120,23 @@ func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 
 	request1 := expandRequestBackupRestoreRestoreConfigBackup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *isegosdk.ResponseBackupAndRestoreRestoreConfigBackup
+	var restyResp1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, restyResp1, err = client.BackupAndRestore.RestoreConfigBackup(request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for RestoreConfigBackup", errWait))
+		return diags
 	}
-	response1, restyResp1, err := client.BackupAndRestore.RestoreConfigBackup(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RestoreConfigBackup", err, restyResp1.String(),
 				"Failure at RestoreConfigBackup, unexpected response", ""))
@@ -134,7 +148,7 @@ func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenBackupAndRestoreRestoreConfigBackupItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -143,6 +157,13 @@ func resourceBackupRestoreCreate(ctx context.Context, d *schema.ResourceData, m
 			err))
 		return diags
 	}
+	if d.Get("wait_for_completion").(bool) && response1.Response != nil {
+		taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if diags := waitForTask(taskCtx, client, response1.Response.ID, "RestoreConfigBackup"); diags.HasError() {
+			return diags
+		}
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
@@ -155,9 +176,9 @@ func resourceBackupRestoreRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceBackupRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BackupRestore delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning BackupRestore delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing BackupRestore delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing BackupRestore delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestBackupRestoreRestoreConfigBackup(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestBackupAndRestoreRestoreConfigBackup {
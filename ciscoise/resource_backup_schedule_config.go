@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -143,8 +141,8 @@ creating the schedule for the first time.
 }
 
 func resourceBackupScheduleConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CreateScheduledConfigBackup create")
-	log.Printf("[DEBUG] Missing CreateScheduledConfigBackup create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning CreateScheduledConfigBackup create")
+	logDebugf(ctx, "Missing CreateScheduledConfigBackup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -152,12 +150,12 @@ func resourceBackupScheduleConfigCreate(ctx context.Context, d *schema.ResourceD
 
 	request1 := expandRequestBackupScheduleConfigCreateScheduledConfigBackup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.BackupAndRestore.CreateScheduledConfigBackup(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing CreateScheduledConfigBackup", err, restyResp1.String(),
 				"Failure at CreateScheduledConfigBackup, unexpected response", ""))
@@ -169,7 +167,7 @@ func resourceBackupScheduleConfigCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenBackupAndRestoreCreateScheduledConfigBackupItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -190,9 +188,9 @@ func resourceBackupScheduleConfigRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceBackupScheduleConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BackupScheduleConfig delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning BackupScheduleConfig delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing BackupScheduleConfig delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing BackupScheduleConfig delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -143,8 +141,8 @@ only helps in editing the schedule.
 }
 
 func resourceBackupScheduleConfigUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning UpdateScheduledConfigBackup create")
-	log.Printf("[DEBUG] Missing UpdateScheduledConfigBackup create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning UpdateScheduledConfigBackup create")
+	logDebugf(ctx, "Missing UpdateScheduledConfigBackup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -152,12 +150,12 @@ func resourceBackupScheduleConfigUpdateCreate(ctx context.Context, d *schema.Res
 
 	request1 := expandRequestBackupScheduleConfigUpdateUpdateScheduledConfigBackup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.BackupAndRestore.UpdateScheduledConfigBackup(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateScheduledConfigBackup", err, restyResp1.String(),
 				"Failure at UpdateScheduledConfigBackup, unexpected response", ""))
@@ -169,7 +167,7 @@ func resourceBackupScheduleConfigUpdateCreate(ctx context.Context, d *schema.Res
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenBackupAndRestoreUpdateScheduledConfigBackupItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -185,8 +183,8 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 }
 
 func resourceBindSignedCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BindCsr create")
-	log.Printf("[DEBUG] Missing BindCsr create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BindCsr create")
+	logDebugf(ctx, "Missing BindCsr create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -194,12 +192,12 @@ func resourceBindSignedCertificateCreate(ctx context.Context, d *schema.Resource
 
 	request1 := expandRequestBindSignedCertificateBindCsr(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.BindCsr(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing BindCsr", err, restyResp1.String(),
 				"Failure at BindCsr, unexpected response", ""))
@@ -211,7 +209,7 @@ func resourceBindSignedCertificateCreate(ctx context.Context, d *schema.Resource
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesBindCsrItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -232,9 +230,9 @@ func resourceBindSignedCertificateRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceBindSignedCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BindSignedCertificate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning BindSignedCertificate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing BindSignedCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing BindSignedCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
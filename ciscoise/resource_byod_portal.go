@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceByodPortal() *schema.Resource {
 		ReadContext:   resourceByodPortalRead,
 		UpdateContext: resourceByodPortalUpdate,
 		DeleteContext: resourceByodPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceByodPortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1096,50 +1092,22 @@ Allowed values:
 }
 
 func resourceByodPortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ByodPortal create")
+	logDebugf(ctx, "Beginning ByodPortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestByodPortalCreateByodPortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vName, _ := resourceItem["name"]
 	vvID := interfaceToString(vID)
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.ByodPortal.GetByodPortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceByodPortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetByodPortalQueryParams{}
-
-			response2, _, err := client.ByodPortal.GetByodPortal(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsByodPortalGetByodPortal(m, response2, &queryParams2)
-				item2, err := searchByodPortalGetByodPortal(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceByodPortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.ByodPortal.CreateByodPortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1163,7 +1131,7 @@ func resourceByodPortalCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ByodPortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ByodPortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1175,28 +1143,28 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
 
-		log.Printf("[DEBUG] Selected method: GetByodPortal")
+		logDebugf(ctx, "Selected method: GetByodPortal")
 		queryParams1 := isegosdk.GetByodPortalQueryParams{}
 		response1, restyResp1, err := client.ByodPortal.GetByodPortal(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsByodPortalGetByodPortal(m, response1, &queryParams1)
 		item1, err := searchByodPortalGetByodPortal(m, items1, vvName, vvID)
@@ -1211,13 +1179,13 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetByodPortal search response",
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetByodPortal search response",
 				err))
@@ -1226,20 +1194,20 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetByodPortalByID")
+		logDebugf(ctx, "Selected method: GetByodPortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.ByodPortal.GetByodPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenByodPortalGetByodPortalByIDItem(response2.ByodPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1248,7 +1216,7 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetByodPortalByID response",
 				err))
@@ -1261,7 +1229,7 @@ func resourceByodPortalRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ByodPortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ByodPortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1273,9 +1241,9 @@ func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1284,7 +1252,7 @@ func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m int
 		vvName := vName
 		vvID := vID
 
-		log.Printf("[DEBUG] Selected method: GetByodPortal")
+		logDebugf(ctx, "Selected method: GetByodPortal")
 		queryParams1 := isegosdk.GetByodPortalQueryParams{}
 		response1, _, err := client.ByodPortal.GetByodPortal(&queryParams1)
 
@@ -1300,15 +1268,15 @@ func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m int
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestByodPortalUpdateByodPortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.ByodPortal.UpdateByodPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateByodPortalByID", err, restyResp1.String(),
 					"Failure at UpdateByodPortalByID, unexpected response", ""))
@@ -1326,7 +1294,7 @@ func resourceByodPortalUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceByodPortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ByodPortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ByodPortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1338,9 +1306,9 @@ func resourceByodPortalDelete(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1376,7 +1344,7 @@ func resourceByodPortalDelete(ctx context.Context, d *schema.ResourceData, m int
 	restyResp1, err := client.ByodPortal.DeleteByodPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteByodPortalByID", err, restyResp1.String(),
 				"Failure at DeleteByodPortalByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,9 +23,7 @@ func resourceCertificateProfile() *schema.Resource {
 		ReadContext:   resourceCertificateProfileRead,
 		UpdateContext: resourceCertificateProfileUpdate,
 		DeleteContext: resourceCertificateProfileDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceCertificateProfileRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -203,45 +199,22 @@ func resourceCertificateProfile() *schema.Resource {
 }
 
 func resourceCertificateProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CertificateProfile create")
+	logDebugf(ctx, "Beginning CertificateProfile create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestCertificateProfileCreateCertificateProfile(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.CertificateProfile.GetCertificateProfileByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceCertificateProfileRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.CertificateProfile.GetCertificateProfileByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.CertificateProfile.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceCertificateProfileRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.CertificateProfile.CreateCertificateProfile(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -265,7 +238,7 @@ func resourceCertificateProfileCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CertificateProfile read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning CertificateProfile read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -277,26 +250,26 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetCertificateProfileByName")
+		logDebugf(ctx, "Selected method: GetCertificateProfileByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.CertificateProfile.GetCertificateProfileByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenCertificateProfileGetCertificateProfileByNameItemName(response1.CertificateProfile)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -305,7 +278,7 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetCertificateProfileByName response",
 				err))
@@ -315,20 +288,20 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetCertificateProfileByID")
+		logDebugf(ctx, "Selected method: GetCertificateProfileByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.CertificateProfile.GetCertificateProfileByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenCertificateProfileGetCertificateProfileByIDItemID(response2.CertificateProfile)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -337,7 +310,7 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetCertificateProfileByID response",
 				err))
@@ -350,7 +323,7 @@ func resourceCertificateProfileRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceCertificateProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CertificateProfile update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning CertificateProfile update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -362,9 +335,9 @@ func resourceCertificateProfileUpdate(ctx context.Context, d *schema.ResourceDat
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -387,15 +360,15 @@ func resourceCertificateProfileUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestCertificateProfileUpdateCertificateProfileByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.CertificateProfile.UpdateCertificateProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateCertificateProfileByID", err, restyResp1.String(),
 					"Failure at UpdateCertificateProfileByID, unexpected response", ""))
@@ -413,9 +386,9 @@ func resourceCertificateProfileUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceCertificateProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CertificateProfile delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning CertificateProfile delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing CertificateProfile delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing CertificateProfile delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestCertificateProfileCreateCertificateProfile(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestCertificateProfileCreateCertificateProfile {
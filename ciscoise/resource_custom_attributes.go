@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,9 +23,7 @@ func resourceCustomAttributes() *schema.Resource {
 		ReadContext:   resourceCustomAttributesRead,
 		UpdateContext: resourceCustomAttributesUpdate,
 		DeleteContext: resourceCustomAttributesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceCustomAttributesRead, "", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -86,14 +82,13 @@ func resourceCustomAttributes() *schema.Resource {
 func resourceCustomAttributesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestCustomAttributesCreateCustomAttribute(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vName, okName := resourceItem["name"]
@@ -102,17 +97,6 @@ func resourceCustomAttributesCreate(ctx context.Context, d *schema.ResourceData,
 		vName = resourceItem["attribute_name"]
 		vvName = interfaceToString(vName)
 	}
-	if isEnableAutoImport {
-		if okName && vvName != "" {
-			getResponse2, _, err := client.CustomAttributes.Get(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceCustomAttributesRead(ctx, d, m)
-			}
-		}
-	}
 
 	resourceItem = *getResourceItem(d.Get("parameters"))
 	request1 = expandRequestCustomAttributesCreateCustomAttribute(ctx, "parameters.0", d)
@@ -143,13 +127,13 @@ func resourceCustomAttributesRead(ctx context.Context, d *schema.ResourceData, m
 
 	if err != nil || response2 == nil {
 		if restyResp2 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 	vItem2 := flattenCustomAttributesGetItem(response2)
 	if err := d.Set("item", vItem2); err != nil {
@@ -158,7 +142,7 @@ func resourceCustomAttributesRead(ctx context.Context, d *schema.ResourceData, m
 			err))
 		return diags
 	}
-	if err := d.Set("parameters", vItem2); err != nil {
+	if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting Get response",
 			err))
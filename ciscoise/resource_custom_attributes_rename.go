@@ -2,7 +2,6 @@ package ciscoise
 
 import (
 	"context"
-	"log"
 
 	"reflect"
 
@@ -70,7 +69,7 @@ func resourceCustomAttributesRenameCreate(ctx context.Context, d *schema.Resourc
 	response1, err := client.CustomAttributes.Rename(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,8 +60,8 @@ func resourceDeviceAdministrationAuthenticationResetHitcount() *schema.Resource
 }
 
 func resourceDeviceAdministrationAuthenticationResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsDeviceAdminAuthenticationRules create")
-	log.Printf("[DEBUG] Missing ResetHitCountsDeviceAdminAuthenticationRules create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsDeviceAdminAuthenticationRules create")
+	logDebugf(ctx, "Missing ResetHitCountsDeviceAdminAuthenticationRules create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -74,14 +72,14 @@ func resourceDeviceAdministrationAuthenticationResetHitcountCreate(ctx context.C
 	response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.ResetHitCountsDeviceAdminAuthenticationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminAuthenticationRules", err,
 			"Failure at ResetHitCountsDeviceAdminAuthenticationRules, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenDeviceAdministrationAuthenticationRulesResetHitCountsDeviceAdminAuthenticationRulesItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -101,9 +99,9 @@ func resourceDeviceAdministrationAuthenticationResetHitcountRead(ctx context.Con
 }
 
 func resourceDeviceAdministrationAuthenticationResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthenticationResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthenticationResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing DeviceAdministrationAuthenticationResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing DeviceAdministrationAuthenticationResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -6,9 +6,8 @@ import (
 	"reflect"
 	"strings"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -42,9 +41,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceDeviceAdministrationAuthenticationRulesRead,
 		UpdateContext: resourceDeviceAdministrationAuthenticationRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationAuthenticationRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceDeviceAdministrationAuthenticationRulesRead, deviceAdministrationPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -727,6 +724,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": conditionExpressionSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -781,20 +779,22 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceDeviceAdministrationAuthenticationRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthenticationRules create")
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthenticationRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationAuthenticationRulesCreateDeviceAdminAuthenticationRule(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvID string
@@ -814,34 +814,8 @@ func resourceDeviceAdministrationAuthenticationRulesCreate(ctx context.Context,
 			vvName = interfaceToString(v)
 		}
 	}
-	if isEnableAutoImport {
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationAuthenticationRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, response2, vvPolicyID)
-				item2, err := searchDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationAuthenticationRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.CreateDeviceAdminAuthenticationRule(vvPolicyID, request1)
+	resp1 := &isegosdk.ResponseDeviceAdministrationAuthenticationRulesCreateDeviceAdminAuthenticationRule{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, deviceAdministrationConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authentication", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -866,7 +840,7 @@ func resourceDeviceAdministrationAuthenticationRulesCreate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthenticationRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthenticationRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -896,25 +870,25 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthenticationRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthenticationRules")
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRules(m, items1, vvName, vvID, vvPolicyID)
@@ -929,27 +903,35 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthenticationRules search response",
 				err))
 			return diags
 		}
+		if item1 != nil && item1.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0.rule.0", restyResp1, item1.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthenticationRuleByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthenticationRuleByID")
 		response2, restyResp2, err := client.DeviceAdministrationAuthenticationRules.GetDeviceAdminAuthenticationRuleByID(vvPolicyID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthenticationRulesGetDeviceAdminAuthenticationRuleByIDItem(response2.Response, vvPolicyID)
 		if err := d.Set("item", vItem2); err != nil {
@@ -958,12 +940,20 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthenticationRuleByID response",
 				err))
 			return diags
 		}
+		if response2.Response != nil && response2.Response.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0.rule.0", restyResp2, response2.Response.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 		return diags
 
 	}
@@ -971,7 +961,7 @@ func resourceDeviceAdministrationAuthenticationRulesRead(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthenticationRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthenticationRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -999,9 +989,9 @@ func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context,
 	vvName := vName
 	vvPolicyID := vPolicyID
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 
@@ -1024,15 +1014,19 @@ func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationAuthenticationRulesUpdateDeviceAdminAuthenticationRuleByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthenticationRules.UpdateDeviceAdminAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		response1 := &isegosdk.ResponseDeviceAdministrationAuthenticationRulesUpdateDeviceAdminAuthenticationRuleByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, deviceAdministrationConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authentication/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminAuthenticationRuleByID, unexpected response", ""))
@@ -1050,7 +1044,7 @@ func resourceDeviceAdministrationAuthenticationRulesUpdate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationAuthenticationRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthenticationRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthenticationRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1078,9 +1072,9 @@ func resourceDeviceAdministrationAuthenticationRulesDelete(ctx context.Context,
 	vvPolicyID := vPolicyID
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 
@@ -1117,10 +1111,9 @@ func resourceDeviceAdministrationAuthenticationRulesDelete(ctx context.Context,
 		if restyResp1 != nil {
 			if strings.Contains(restyResp1.String(), "400") &&
 				strings.Contains(restyResp1.String(), "Failed to handle API - Network Access Authentication Rule : Attempted to delete default Rule") {
-				log.Printf("\033[33m")
-				log.Printf("\n[WARNING] Failure when executing DeleteDeviceAdminAuthenticationRuleByID\n%v\n%v\n%s", err, restyResp1.String(), "\033[0m")
+				logWarnf(ctx, "Failure when executing DeleteDeviceAdminAuthenticationRuleByID\n%v\n%v", err, restyResp1.String())
 			} else {
-				log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing DeleteDeviceAdminAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at DeleteDeviceAdminAuthenticationRuleByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -61,8 +59,8 @@ func resourceDeviceAdministrationAuthorizationResetHitcount() *schema.Resource {
 }
 
 func resourceDeviceAdministrationAuthorizationResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsDeviceAdminAuthorizationRules create")
-	log.Printf("[DEBUG] Missing ResetHitCountsDeviceAdminAuthorizationRules create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsDeviceAdminAuthorizationRules create")
+	logDebugf(ctx, "Missing ResetHitCountsDeviceAdminAuthorizationRules create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -73,14 +71,14 @@ func resourceDeviceAdministrationAuthorizationResetHitcountCreate(ctx context.Co
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.ResetHitCountsDeviceAdminAuthorizationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminAuthorizationRules", err,
 			"Failure at ResetHitCountsDeviceAdminAuthorizationRules, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenDeviceAdministrationAuthorizationRulesResetHitCountsDeviceAdminAuthorizationRulesItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -100,9 +98,9 @@ func resourceDeviceAdministrationAuthorizationResetHitcountRead(ctx context.Cont
 }
 
 func resourceDeviceAdministrationAuthorizationResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthorizationResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthorizationResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing DeviceAdministrationAuthorizationResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing DeviceAdministrationAuthorizationResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceDeviceAdministrationAuthorizationRulesRead,
 		UpdateContext: resourceDeviceAdministrationAuthorizationRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationAuthorizationRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceDeviceAdministrationAuthorizationRulesRead, deviceAdministrationPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -704,6 +701,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": conditionExpressionSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -758,20 +756,22 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceDeviceAdministrationAuthorizationRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthorizationRules create")
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthorizationRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationAuthorizationRulesCreateDeviceAdminAuthorizationRule(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvID string
@@ -792,34 +792,8 @@ func resourceDeviceAdministrationAuthorizationRulesCreate(ctx context.Context, d
 		}
 	}
 
-	if isEnableAutoImport {
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationAuthorizationRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, response2, vvPolicyID)
-				item2, err := searchDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationAuthorizationRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.CreateDeviceAdminAuthorizationRule(vvPolicyID, request1)
+	resp1 := &isegosdk.ResponseDeviceAdministrationAuthorizationRulesCreateDeviceAdminAuthorizationRule{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, deviceAdministrationConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authorization", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -844,7 +818,7 @@ func resourceDeviceAdministrationAuthorizationRulesCreate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthorizationRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthorizationRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -874,25 +848,25 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthorizationRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthorizationRules")
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.GetDeviceAdminAuthorizationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRules(m, items1, vvName, vvID, vvPolicyID)
@@ -907,16 +881,24 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthorizationRules search response",
 				err))
 			return diags
 		}
+		if item1 != nil && item1.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0.rule.0", restyResp1, item1.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminAuthorizationRuleByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminAuthorizationRuleByID")
 		vvPolicyID := vPolicyID
 		vvID := vID
 
@@ -924,13 +906,13 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthorizationRulesGetDeviceAdminAuthorizationRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -939,12 +921,20 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminAuthorizationRuleByID response",
 				err))
 			return diags
 		}
+		if response2.Response != nil && response2.Response.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0.rule.0", restyResp2, response2.Response.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 		return diags
 
 	}
@@ -952,7 +942,7 @@ func resourceDeviceAdministrationAuthorizationRulesRead(ctx context.Context, d *
 }
 
 func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthorizationRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthorizationRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -982,9 +972,9 @@ func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -1006,15 +996,19 @@ func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationAuthorizationRulesUpdateDeviceAdminAuthorizationRuleByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.UpdateDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		response1 := &isegosdk.ResponseDeviceAdministrationAuthorizationRulesUpdateDeviceAdminAuthorizationRuleByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, deviceAdministrationConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authorization/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminAuthorizationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminAuthorizationRuleByID, unexpected response", ""))
@@ -1032,7 +1026,7 @@ func resourceDeviceAdministrationAuthorizationRulesUpdate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationAuthorizationRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationAuthorizationRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationAuthorizationRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1062,9 +1056,9 @@ func resourceDeviceAdministrationAuthorizationRulesDelete(ctx context.Context, d
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -1098,7 +1092,7 @@ func resourceDeviceAdministrationAuthorizationRulesDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.DeleteDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminAuthorizationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminAuthorizationRuleByID, unexpected response", ""))
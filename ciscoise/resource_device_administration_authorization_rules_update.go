@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -795,12 +793,12 @@ func resourceDeviceAdministrationAuthorizationRulesUpdateUpdateCreate(ctx contex
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationRules.UpdateDeviceAdminAuthorizationRuleByID(vvPolicyID, vvID, request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			err = fmt.Errorf(restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
@@ -809,7 +807,7 @@ func resourceDeviceAdministrationAuthorizationRulesUpdateUpdateCreate(ctx contex
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenDeviceAdministrationAuthorizationRulesUpdateDeviceAdminAuthorizationRuleByIDItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
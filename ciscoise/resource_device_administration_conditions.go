@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,9 +71,7 @@ TimeAndDate
 		ReadContext:   resourceDeviceAdministrationConditionsRead,
 		UpdateContext: resourceDeviceAdministrationConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationConditionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDeviceAdministrationConditionsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -393,6 +390,7 @@ TimeAndDate
 								},
 							},
 						},
+						"condition_expression": conditionExpressionSchema(),
 						"condition_type": &schema.Schema{
 							Description:      `<ul><li>Inidicates whether the record is the condition itself(data) or a logical(or,and) aggregation</li> <li>Data type enum(reference,single) indicates than "conditonId" OR "ConditionAttrs" fields should contain condition data but not both</li> <li>Logical aggreation(and,or) enum indicates that additional conditions are present under the children field</li></ul>`,
 							Type:             schema.TypeString,
@@ -449,45 +447,26 @@ TimeAndDate
 }
 
 func resourceDeviceAdministrationConditionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationConditions create")
+	logDebugf(ctx, "Beginning DeviceAdministrationConditions create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationConditionsCreateDeviceAdminCondition(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0", request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationConditionsRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.Response.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationConditionsRead(ctx, d, m)
-			}
-		}
-	}
-	resp1, restyResp1, err := client.DeviceAdministrationConditions.CreateDeviceAdminCondition(request1)
+	resp1 := &isegosdk.ResponseDeviceAdministrationConditionsCreateDeviceAdminCondition{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, deviceAdministrationConditionLibrary.apiPath+"/condition", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -512,7 +491,7 @@ func resourceDeviceAdministrationConditionsCreate(ctx context.Context, d *schema
 }
 
 func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationConditions read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationConditions read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -524,26 +503,26 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminConditionByName")
+		logDebugf(ctx, "Selected method: GetDeviceAdminConditionByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenDeviceAdministrationConditionsGetDeviceAdminConditionByNameItemName(response1.Response)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -552,30 +531,36 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminConditionByName response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0", restyResp1, ""); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminConditionByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.DeviceAdministrationConditions.GetDeviceAdminConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenDeviceAdministrationConditionsGetDeviceAdminConditionByIDItemID(response2.Response)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -584,12 +569,18 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminConditionByID response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0", restyResp2, ""); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
@@ -597,7 +588,7 @@ func resourceDeviceAdministrationConditionsRead(ctx context.Context, d *schema.R
 }
 
 func resourceDeviceAdministrationConditionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationConditions update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationConditions update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -609,9 +600,9 @@ func resourceDeviceAdministrationConditionsUpdate(ctx context.Context, d *schema
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -634,15 +625,19 @@ func resourceDeviceAdministrationConditionsUpdate(ctx context.Context, d *schema
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationConditionsUpdateDeviceAdminConditionByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0", request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.DeviceAdministrationConditions.UpdateDeviceAdminConditionByID(vvID, request1)
+		response1 := &isegosdk.ResponseDeviceAdministrationConditionsUpdateDeviceAdminConditionByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, deviceAdministrationConditionLibrary.apiPath+"/condition/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminConditionByID, unexpected response", ""))
@@ -660,7 +655,7 @@ func resourceDeviceAdministrationConditionsUpdate(ctx context.Context, d *schema
 }
 
 func resourceDeviceAdministrationConditionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationConditions delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationConditions delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -672,9 +667,9 @@ func resourceDeviceAdministrationConditionsDelete(ctx context.Context, d *schema
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -702,7 +697,7 @@ func resourceDeviceAdministrationConditionsDelete(ctx context.Context, d *schema
 	response1, restyResp1, err := client.DeviceAdministrationConditions.DeleteDeviceAdminConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminConditionByID, unexpected response", ""))
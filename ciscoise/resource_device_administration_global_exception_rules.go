@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceDeviceAdministrationGlobalExceptionRulesRead,
 		UpdateContext: resourceDeviceAdministrationGlobalExceptionRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationGlobalExceptionRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerGlobalRule(resourceDeviceAdministrationGlobalExceptionRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -600,17 +596,16 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationGlobalExceptionRules create")
+	logDebugf(ctx, "Beginning DeviceAdministrationGlobalExceptionRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationGlobalExceptionRulesCreateDeviceAdminPolicySetGlobalException(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vID, okID := resourceItem["id"]
@@ -631,31 +626,6 @@ func resourceDeviceAdministrationGlobalExceptionRulesCreate(ctx context.Context,
 			vvName = interfaceToString(v)
 		}
 	}
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.GetDeviceAdminPolicySetGlobalExceptionByRuleID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationGlobalExceptionRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.GetDeviceAdminPolicySetGlobalExceptionRules()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, response2)
-				item2, err := searchDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.Rule.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationGlobalExceptionRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.CreateDeviceAdminPolicySetGlobalException(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -681,7 +651,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesCreate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationGlobalExceptionRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationGlobalExceptionRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -709,25 +679,25 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySetGlobalExceptionRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySetGlobalExceptionRules")
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.GetDeviceAdminPolicySetGlobalExceptionRules()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, response1)
 		item1, err := searchDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionRules(m, items1, vvName, vvID)
@@ -742,7 +712,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetGlobalExceptionRules search response",
 				err))
@@ -751,18 +721,18 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySetGlobalExceptionByRuleID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySetGlobalExceptionByRuleID")
 		response2, restyResp2, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.GetDeviceAdminPolicySetGlobalExceptionByRuleID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthorizationGlobalExceptionRulesGetDeviceAdminPolicySetGlobalExceptionByRuleIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -771,7 +741,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetGlobalExceptionByRuleID response",
 				err))
@@ -784,7 +754,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesRead(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationGlobalExceptionRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationGlobalExceptionRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -812,9 +782,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context,
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -836,15 +806,15 @@ func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationGlobalExceptionRulesUpdateDeviceAdminPolicySetGlobalExceptionByRuleID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.UpdateDeviceAdminPolicySetGlobalExceptionByRuleID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminPolicySetGlobalExceptionByRuleID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminPolicySetGlobalExceptionByRuleID, unexpected response", ""))
@@ -862,7 +832,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesUpdate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationGlobalExceptionRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationGlobalExceptionRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -890,9 +860,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesDelete(ctx context.Context,
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -926,7 +896,7 @@ func resourceDeviceAdministrationGlobalExceptionRulesDelete(ctx context.Context,
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.DeleteDeviceAdminPolicySetGlobalExceptionByRuleID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminPolicySetGlobalExceptionByRuleID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminPolicySetGlobalExceptionByRuleID, unexpected response", ""))
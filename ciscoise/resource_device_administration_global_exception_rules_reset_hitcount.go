@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ func resourceDeviceAdministrationGlobalExceptionRulesResetHitcount() *schema.Res
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsDeviceAdminGlobalExceptions create")
-	log.Printf("[DEBUG] Missing ResetHitCountsDeviceAdminGlobalExceptions create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsDeviceAdminGlobalExceptions create")
+	logDebugf(ctx, "Missing ResetHitCountsDeviceAdminGlobalExceptions create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -64,14 +62,14 @@ func resourceDeviceAdministrationGlobalExceptionRulesResetHitcountCreate(ctx con
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationGlobalExceptionRules.ResetHitCountsDeviceAdminGlobalExceptions()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminGlobalExceptions", err,
 			"Failure at ResetHitCountsDeviceAdminGlobalExceptions, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenDeviceAdministrationAuthorizationGlobalExceptionRulesResetHitCountsDeviceAdminGlobalExceptionsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -91,9 +89,9 @@ func resourceDeviceAdministrationGlobalExceptionRulesResetHitcountRead(ctx conte
 }
 
 func resourceDeviceAdministrationGlobalExceptionRulesResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationGlobalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationGlobalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing DeviceAdministrationGlobalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing DeviceAdministrationGlobalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceDeviceAdministrationLocalExceptionRulesRead,
 		UpdateContext: resourceDeviceAdministrationLocalExceptionRulesUpdate,
 		DeleteContext: resourceDeviceAdministrationLocalExceptionRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceDeviceAdministrationLocalExceptionRulesRead, deviceAdministrationPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -356,6 +352,12 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 
+						"policy_id": &schema.Schema{
+							Description:      `policyId path parameter. Policy id`,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: diffSupressOptional(),
+						},
 						"commands": &schema.Schema{
 							Description:      `Command sets enforce the specified list of commands that can be executed by a device administrator`,
 							Type:             schema.TypeList,
@@ -605,66 +607,22 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationLocalExceptionRules create")
+	logDebugf(ctx, "Beginning DeviceAdministrationLocalExceptionRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationLocalExceptionRulesCreateDeviceAdminLocalExceptionRule(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
-	vID, okID := resourceItem["id"]
 	var vvName string
 	var vvID string
-	if isEnableAutoImport {
-		if !okID || vID == "" {
-			if _, ok := d.GetOkExists("parameters.0.rule"); ok {
-				if v, ok2 := d.GetOkExists("parameters.0.rule.0.id"); ok2 {
-					vID = interfaceToString(v)
-					okID = ok2
-				}
-			}
-		}
-		vvID = interfaceToString(vID)
-		if _, ok := d.GetOkExists("parameters.0.rule"); ok {
-			if v, ok2 := d.GetOkExists("parameters.0.rule.0.name"); ok2 {
-				vvName = interfaceToString(v)
-			}
-		}
-
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationAuthorizationExceptionRules.GetDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationLocalExceptionRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationAuthorizationExceptionRules.GetDeviceAdminLocalExceptionRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, response2, vvPolicyID)
-				item2, err := searchDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationLocalExceptionRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.CreateDeviceAdminLocalExceptionRule(vvPolicyID, request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -691,7 +649,7 @@ func resourceDeviceAdministrationLocalExceptionRulesCreate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationLocalExceptionRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationLocalExceptionRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -721,25 +679,25 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminLocalExceptionRules")
+		logDebugf(ctx, "Selected method: GetDeviceAdminLocalExceptionRules")
 
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.GetDeviceAdminLocalExceptionRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, response1, vvPolicyID)
 		item1, err := searchDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRules(m, items1, vvName, vvID, vvPolicyID)
@@ -754,7 +712,7 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminLocalExceptionRules search response",
 				err))
@@ -763,18 +721,18 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminLocalExceptionRuleByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminLocalExceptionRuleByID")
 		response2, restyResp2, err := client.DeviceAdministrationAuthorizationExceptionRules.GetDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationAuthorizationExceptionRulesGetDeviceAdminLocalExceptionRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -783,7 +741,7 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminLocalExceptionRuleByID response",
 				err))
@@ -796,7 +754,7 @@ func resourceDeviceAdministrationLocalExceptionRulesRead(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationLocalExceptionRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationLocalExceptionRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -826,9 +784,9 @@ func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context,
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -850,15 +808,15 @@ func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationLocalExceptionRulesUpdateDeviceAdminLocalExceptionRuleByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.UpdateDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminLocalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminLocalExceptionRuleByID, unexpected response", ""))
@@ -876,7 +834,7 @@ func resourceDeviceAdministrationLocalExceptionRulesUpdate(ctx context.Context,
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationLocalExceptionRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationLocalExceptionRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -906,9 +864,9 @@ func resourceDeviceAdministrationLocalExceptionRulesDelete(ctx context.Context,
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -942,7 +900,7 @@ func resourceDeviceAdministrationLocalExceptionRulesDelete(ctx context.Context,
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.DeleteDeviceAdminLocalExceptionRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminLocalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminLocalExceptionRuleByID, unexpected response", ""))
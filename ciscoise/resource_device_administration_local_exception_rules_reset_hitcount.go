@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -61,8 +59,8 @@ func resourceDeviceAdministrationLocalExceptionRulesResetHitcount() *schema.Reso
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsDeviceAdminLocalExceptions create")
-	log.Printf("[DEBUG] Missing ResetHitCountsDeviceAdminLocalExceptions create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsDeviceAdminLocalExceptions create")
+	logDebugf(ctx, "Missing ResetHitCountsDeviceAdminLocalExceptions create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -73,14 +71,14 @@ func resourceDeviceAdministrationLocalExceptionRulesResetHitcountCreate(ctx cont
 	response1, restyResp1, err := client.DeviceAdministrationAuthorizationExceptionRules.ResetHitCountsDeviceAdminLocalExceptions(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminLocalExceptions", err,
 			"Failure at ResetHitCountsDeviceAdminLocalExceptions, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenDeviceAdministrationAuthorizationExceptionRulesResetHitCountsDeviceAdminLocalExceptionsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -100,9 +98,9 @@ func resourceDeviceAdministrationLocalExceptionRulesResetHitcountRead(ctx contex
 }
 
 func resourceDeviceAdministrationLocalExceptionRulesResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationLocalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationLocalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing DeviceAdministrationLocalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing DeviceAdministrationLocalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceDeviceAdministrationNetworkConditions() *schema.Resource {
 		ReadContext:   resourceDeviceAdministrationNetworkConditionsRead,
 		UpdateContext: resourceDeviceAdministrationNetworkConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationNetworkConditionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDeviceAdministrationNetworkConditionsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -238,48 +234,22 @@ func resourceDeviceAdministrationNetworkConditions() *schema.Resource {
 }
 
 func resourceDeviceAdministrationNetworkConditionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationNetworkConditions create")
+	logDebugf(ctx, "Beginning DeviceAdministrationNetworkConditions create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationNetworkConditionsCreateDeviceAdminNetworkCondition(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditionByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationNetworkConditionsRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditions()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, response2)
-				item2, err := searchDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationNetworkConditionsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.DeviceAdministrationNetworkConditions.CreateDeviceAdminNetworkCondition(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -305,7 +275,7 @@ func resourceDeviceAdministrationNetworkConditionsCreate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationNetworkConditions read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationNetworkConditions read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -318,25 +288,25 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminNetworkConditions")
+		logDebugf(ctx, "Selected method: GetDeviceAdminNetworkConditions")
 
 		response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, response1)
 		item1, err := searchDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditions(m, items1, vvName, vvID)
@@ -351,7 +321,7 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminNetworkConditions search response",
 				err))
@@ -360,20 +330,20 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminNetworkConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminNetworkConditionByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.DeviceAdministrationNetworkConditions.GetDeviceAdminNetworkConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationNetworkConditionsGetDeviceAdminNetworkConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -382,7 +352,7 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminNetworkConditionByID response",
 				err))
@@ -395,7 +365,7 @@ func resourceDeviceAdministrationNetworkConditionsRead(ctx context.Context, d *s
 }
 
 func resourceDeviceAdministrationNetworkConditionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationNetworkConditions update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationNetworkConditions update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -408,9 +378,9 @@ func resourceDeviceAdministrationNetworkConditionsUpdate(ctx context.Context, d
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -432,15 +402,15 @@ func resourceDeviceAdministrationNetworkConditionsUpdate(ctx context.Context, d
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationNetworkConditionsUpdateDeviceAdminNetworkConditionByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.UpdateDeviceAdminNetworkConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminNetworkConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminNetworkConditionByID, unexpected response", ""))
@@ -458,7 +428,7 @@ func resourceDeviceAdministrationNetworkConditionsUpdate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationNetworkConditionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationNetworkConditions delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationNetworkConditions delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -471,9 +441,9 @@ func resourceDeviceAdministrationNetworkConditionsDelete(ctx context.Context, d
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -507,7 +477,7 @@ func resourceDeviceAdministrationNetworkConditionsDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationNetworkConditions.DeleteDeviceAdminNetworkConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminNetworkConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminNetworkConditionByID, unexpected response", ""))
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,9 +39,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceDeviceAdministrationPolicySetRead,
 		UpdateContext: resourceDeviceAdministrationPolicySetUpdate,
 		DeleteContext: resourceDeviceAdministrationPolicySetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDeviceAdministrationPolicySetRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -619,6 +616,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 								},
 							},
 						},
+						"condition_expression": conditionExpressionSchema(),
 						"default": &schema.Schema{
 							Description:      `Flag which indicates if this policy set is the default one`,
 							Type:             schema.TypeString,
@@ -713,48 +711,26 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceDeviceAdministrationPolicySetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationPolicySet create")
+	logDebugf(ctx, "Beginning DeviceAdministrationPolicySet create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationPolicySetCreateDeviceAdminPolicySet(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0", request1, "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySetByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationPolicySetRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySets()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, response2)
-				item2, err := searchDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationPolicySetRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.DeviceAdministrationPolicySet.CreateDeviceAdminPolicySet(request1)
+	resp1 := &isegosdk.ResponseDeviceAdministrationPolicySetCreateDeviceAdminPolicySet{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, deviceAdministrationConditionLibrary.apiPath+"/policy-set", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -779,7 +755,7 @@ func resourceDeviceAdministrationPolicySetCreate(ctx context.Context, d *schema.
 }
 
 func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationPolicySet read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationPolicySet read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -792,25 +768,25 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySets")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySets")
 
 		response1, restyResp1, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySets()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, response1)
 		item1, err := searchDeviceAdministrationPolicySetGetDeviceAdminPolicySets(m, items1, vvName, vvID)
@@ -825,28 +801,34 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySets search response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0", restyResp1, item1.ID, "condition"); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminPolicySetByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminPolicySetByID")
 
 		response2, restyResp2, err := client.DeviceAdministrationPolicySet.GetDeviceAdminPolicySetByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationPolicySetGetDeviceAdminPolicySetByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -855,12 +837,18 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminPolicySetByID response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, deviceAdministrationConditionLibrary, "parameters.0", restyResp2, vvID, "condition"); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
@@ -868,7 +856,7 @@ func resourceDeviceAdministrationPolicySetRead(ctx context.Context, d *schema.Re
 }
 
 func resourceDeviceAdministrationPolicySetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationPolicySet update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationPolicySet update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -881,9 +869,9 @@ func resourceDeviceAdministrationPolicySetUpdate(ctx context.Context, d *schema.
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -905,15 +893,19 @@ func resourceDeviceAdministrationPolicySetUpdate(ctx context.Context, d *schema.
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationPolicySetUpdateDeviceAdminPolicySetByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, deviceAdministrationConditionLibrary, "parameters.0", request1, "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.DeviceAdministrationPolicySet.UpdateDeviceAdminPolicySetByID(vvID, request1)
+		response1 := &isegosdk.ResponseDeviceAdministrationPolicySetUpdateDeviceAdminPolicySetByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, deviceAdministrationConditionLibrary.apiPath+"/policy-set/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminPolicySetByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminPolicySetByID, unexpected response", ""))
@@ -931,7 +923,7 @@ func resourceDeviceAdministrationPolicySetUpdate(ctx context.Context, d *schema.
 }
 
 func resourceDeviceAdministrationPolicySetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationPolicySet delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationPolicySet delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -944,9 +936,9 @@ func resourceDeviceAdministrationPolicySetDelete(ctx context.Context, d *schema.
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -979,7 +971,7 @@ func resourceDeviceAdministrationPolicySetDelete(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.DeviceAdministrationPolicySet.DeleteDeviceAdminPolicySetByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminPolicySetByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminPolicySetByID, unexpected response", ""))
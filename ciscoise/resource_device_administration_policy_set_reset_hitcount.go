@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,8 +51,8 @@ func resourceDeviceAdministrationPolicySetResetHitcount() *schema.Resource {
 }
 
 func resourceDeviceAdministrationPolicySetResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsDeviceAdminPolicySets create")
-	log.Printf("[DEBUG] Missing ResetHitCountsDeviceAdminPolicySets create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsDeviceAdminPolicySets create")
+	logDebugf(ctx, "Missing ResetHitCountsDeviceAdminPolicySets create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -63,7 +61,7 @@ func resourceDeviceAdministrationPolicySetResetHitcountCreate(ctx context.Contex
 	response1, restyResp1, err := client.DeviceAdministrationPolicySet.ResetHitCountsDeviceAdminPolicySets()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsDeviceAdminPolicySets", err,
@@ -71,7 +69,7 @@ func resourceDeviceAdministrationPolicySetResetHitcountCreate(ctx context.Contex
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenDeviceAdministrationPolicySetResetHitCountsDeviceAdminPolicySetsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -80,7 +78,7 @@ func resourceDeviceAdministrationPolicySetResetHitcountCreate(ctx context.Contex
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceDeviceAdministrationPolicySetResetHitcountRead(ctx, d, m)
 }
@@ -91,9 +89,9 @@ func resourceDeviceAdministrationPolicySetResetHitcountRead(ctx context.Context,
 }
 
 func resourceDeviceAdministrationPolicySetResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationPolicySetResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationPolicySetResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing DeviceAdministrationPolicySetResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing DeviceAdministrationPolicySetResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
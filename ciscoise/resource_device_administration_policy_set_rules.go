@@ -0,0 +1,72 @@
+package ciscoise
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var deviceAdministrationPolicySetRules = policySetRulesDomain{
+	name:       "DeviceAdministrationPolicySetRules",
+	library:    deviceAdministrationConditionLibrary,
+	policySets: deviceAdministrationPolicySetIDs,
+	kinds: []policySetRuleKind{
+		{
+			key:        "authentication_rule",
+			attributes: policySetAuthenticationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/authentication"
+			},
+		},
+		{
+			key:        "local_exception_rule",
+			attributes: deviceAdministrationAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/exception"
+			},
+		},
+		{
+			key:        "global_exception_rule",
+			attributes: deviceAdministrationAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/global-exception"
+			},
+		},
+		{
+			key:        "authorization_rule",
+			attributes: deviceAdministrationAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/authorization"
+			},
+		},
+	},
+}
+
+func resourceDeviceAdministrationPolicySetRules() *schema.Resource {
+	return resourcePolicySetRules(deviceAdministrationPolicySetRules, `It manages the rules of a Device Administration policy set together.
+
+- The order of each list of rules is their rank. Apply computes the fewest creates, updates, moves and deletes.
+
+- Rules are matched by name, a renamed rule is deleted and created again.
+
+- The default rules are only updated, and must be last when declared.
+
+- The global exception rules apply to every policy set, declare them in a single resource.
+`)
+}
+
+func deviceAdministrationAuthorizationRuleAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"commands": &schema.Schema{
+			Description: `Command sets enforce the specified list of commands that can be executed by a device administrator`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"profile": &schema.Schema{
+			Description: `Device admin profiles control the initial login session of the device administrator`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
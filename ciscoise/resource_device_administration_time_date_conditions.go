@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceDeviceAdministrationTimeDateConditions() *schema.Resource {
 		ReadContext:   resourceDeviceAdministrationTimeDateConditionsRead,
 		UpdateContext: resourceDeviceAdministrationTimeDateConditionsUpdate,
 		DeleteContext: resourceDeviceAdministrationTimeDateConditionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDeviceAdministrationTimeDateConditionsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -529,48 +525,22 @@ func resourceDeviceAdministrationTimeDateConditions() *schema.Resource {
 }
 
 func resourceDeviceAdministrationTimeDateConditionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationTimeDateConditions create")
+	logDebugf(ctx, "Beginning DeviceAdministrationTimeDateConditions create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDeviceAdministrationTimeDateConditionsCreateDeviceAdminTimeCondition(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditionByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDeviceAdministrationTimeDateConditionsRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditions()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, response2)
-				item2, err := searchDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDeviceAdministrationTimeDateConditionsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.CreateDeviceAdminTimeCondition(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -596,7 +566,7 @@ func resourceDeviceAdministrationTimeDateConditionsCreate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationTimeDateConditions read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationTimeDateConditions read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -609,25 +579,25 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminTimeConditions")
+		logDebugf(ctx, "Selected method: GetDeviceAdminTimeConditions")
 
 		response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, response1)
 		item1, err := searchDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditions(m, items1, vvName, vvID)
@@ -642,7 +612,7 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminTimeConditions search response",
 				err))
@@ -651,19 +621,19 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeviceAdminTimeConditionByID")
+		logDebugf(ctx, "Selected method: GetDeviceAdminTimeConditionByID")
 
 		response2, restyResp2, err := client.DeviceAdministrationTimeDateConditions.GetDeviceAdminTimeConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDeviceAdministrationTimeDateConditionsGetDeviceAdminTimeConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -672,7 +642,7 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeviceAdminTimeConditionByID response",
 				err))
@@ -685,7 +655,7 @@ func resourceDeviceAdministrationTimeDateConditionsRead(ctx context.Context, d *
 }
 
 func resourceDeviceAdministrationTimeDateConditionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationTimeDateConditions update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationTimeDateConditions update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -698,9 +668,9 @@ func resourceDeviceAdministrationTimeDateConditionsUpdate(ctx context.Context, d
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -722,15 +692,15 @@ func resourceDeviceAdministrationTimeDateConditionsUpdate(ctx context.Context, d
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDeviceAdministrationTimeDateConditionsUpdateDeviceAdminTimeConditionByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.UpdateDeviceAdminTimeConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeviceAdminTimeConditionByID", err, restyResp1.String(),
 					"Failure at UpdateDeviceAdminTimeConditionByID, unexpected response", ""))
@@ -748,7 +718,7 @@ func resourceDeviceAdministrationTimeDateConditionsUpdate(ctx context.Context, d
 }
 
 func resourceDeviceAdministrationTimeDateConditionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeviceAdministrationTimeDateConditions delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DeviceAdministrationTimeDateConditions delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -761,9 +731,9 @@ func resourceDeviceAdministrationTimeDateConditionsDelete(ctx context.Context, d
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -796,7 +766,7 @@ func resourceDeviceAdministrationTimeDateConditionsDelete(ctx context.Context, d
 	response1, restyResp1, err := client.DeviceAdministrationTimeDateConditions.DeleteDeviceAdminTimeConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeviceAdminTimeConditionByID", err, restyResp1.String(),
 				"Failure at DeleteDeviceAdminTimeConditionByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceDownloadableACL() *schema.Resource {
 		ReadContext:   resourceDownloadableACLRead,
 		UpdateContext: resourceDownloadableACLUpdate,
 		DeleteContext: resourceDownloadableACLDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDownloadableACLRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -169,52 +165,23 @@ func resourceDownloadableACL() *schema.Resource {
 }
 
 func resourceDownloadableACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DownloadableACL create")
+	logDebugf(ctx, "Beginning DownloadableACL create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDownloadableACLCreateDownloadableACL(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
 
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.DownloadableACL.GetDownloadableACLByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDownloadableACLRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetDownloadableACLQueryParams{}
-
-			response2, _, err := client.DownloadableACL.GetDownloadableACL(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDownloadableACLGetDownloadableACL(m, response2, &queryParams2)
-				item2, err := searchDownloadableACLGetDownloadableACL(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDownloadableACLRead(ctx, d, m)
-				}
-			}
-		}
-	}
-
 	restyResp1, err := client.DownloadableACL.CreateDownloadableACL(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -238,7 +205,7 @@ func resourceDownloadableACLCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DownloadableACL read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DownloadableACL read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -252,26 +219,26 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 	vvName := vName
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetDownloadableACL")
+		logDebugf(ctx, "Selected method: GetDownloadableACL")
 		queryParams1 := isegosdk.GetDownloadableACLQueryParams{}
 
 		response1, restyResp1, err := client.DownloadableACL.GetDownloadableACL(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDownloadableACLGetDownloadableACL(m, response1, &queryParams1)
 		item1, err := searchDownloadableACLGetDownloadableACL(m, items1, vvName, vvID)
@@ -286,7 +253,7 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDownloadableACL search response",
 				err))
@@ -295,20 +262,20 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDownloadableACLByID")
+		logDebugf(ctx, "Selected method: GetDownloadableACLByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.DownloadableACL.GetDownloadableACLByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDownloadableACLGetDownloadableACLByIDItem(response2.DownloadableACL)
 		if err := d.Set("item", vItem2); err != nil {
@@ -317,7 +284,7 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDownloadableACLByID response",
 				err))
@@ -330,7 +297,7 @@ func resourceDownloadableACLRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceDownloadableACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DownloadableACL update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DownloadableACL update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -342,9 +309,9 @@ func resourceDownloadableACLUpdate(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -369,15 +336,15 @@ func resourceDownloadableACLUpdate(ctx context.Context, d *schema.ResourceData,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestDownloadableACLUpdateDownloadableACLByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.DownloadableACL.UpdateDownloadableACLByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDownloadableACLByID", err, restyResp1.String(),
 					"Failure at UpdateDownloadableACLByID, unexpected response", ""))
@@ -395,7 +362,7 @@ func resourceDownloadableACLUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceDownloadableACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DownloadableACL delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning DownloadableACL delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -407,9 +374,9 @@ func resourceDownloadableACLDelete(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -445,7 +412,7 @@ func resourceDownloadableACLDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.DownloadableACL.DeleteDownloadableACLByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDownloadableACLByID", err, restyResp1.String(),
 				"Failure at DeleteDownloadableACLByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceDuoIDentitySync() *schema.Resource {
 		ReadContext:   resourceDuoIDentitySyncRead,
 		UpdateContext: resourceDuoIDentitySyncUpdate,
 		DeleteContext: resourceDuoIDentitySyncDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDuoIDentitySyncRead, "", "sync_name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -221,41 +217,17 @@ func resourceDuoIDentitySync() *schema.Resource {
 func resourceDuoIDentitySyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDuoIDentitySyncCreateIDentitysync(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vSyncName, okSyncName := resourceItem["sync_name"]
+	vSyncName := resourceItem["sync_name"]
 	vvSyncName := interfaceToString(vSyncName)
-	if isEnableAutoImport {
-		if okSyncName && vvSyncName != "" {
-			getResponse2, _, err := client.DuoIDentitySync.GetIDentitysyncBySyncName(vvSyncName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["sync_name"] = vvSyncName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDuoIDentitySyncRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DuoIDentitySync.GetIDentitysync()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDuoIDentitySyncGetIDentitysync(m, response2)
-				item2, err := searchDuoIDentitySyncGetIDentitysync(m, items2, vvSyncName)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["sync_name"] = vvSyncName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDuoIDentitySyncRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, err := client.DuoIDentitySync.CreateIDentitysync(request1)
 	if err != nil || resp1 == nil {
 		diags = append(diags, diagError(
@@ -280,25 +252,25 @@ func resourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData, m
 	vvSyncName, okSyncName := resourceMap["sync_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSyncName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentitysync")
+		logDebugf(ctx, "Selected method: GetIDentitysync")
 
 		response1, restyResp1, err := client.DuoIDentitySync.GetIDentitysync()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDuoIDentitySyncGetIDentitysync(m, response1)
 		item1, err := searchDuoIDentitySyncGetIDentitysync(m, items1, vvSyncName)
@@ -314,7 +286,7 @@ func resourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentitysync search response",
 				err))
@@ -323,18 +295,18 @@ func resourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentitysyncBySyncName")
+		logDebugf(ctx, "Selected method: GetIDentitysyncBySyncName")
 		response2, restyResp2, err := client.DuoIDentitySync.GetIDentitysyncBySyncName(vvSyncName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDuoIDentitySyncGetIDentitysyncBySyncNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -343,7 +315,7 @@ func resourceDuoIDentitySyncRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentitysyncBySyncName response",
 				err))
@@ -368,11 +340,11 @@ func resourceDuoIDentitySyncUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] Name used for update operation %s", vvSyncName)
+		logDebugf(ctx, "Name used for update operation %s", vvSyncName)
 
 		request1 := expandRequestDuoIDentitySyncUpdateIDentitysyncBySyncName(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, err := client.DuoIDentitySync.UpdateIDentitysyncBySyncName(vvSyncName, request1)
 
@@ -403,9 +375,9 @@ func resourceDuoIDentitySyncDelete(ctx context.Context, d *schema.ResourceData,
 	vvSyncName, _ := resourceMap["sync_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSyncName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -2,7 +2,6 @@ package ciscoise
 
 import (
 	"context"
-	"log"
 
 	"fmt"
 	"reflect"
@@ -83,7 +82,7 @@ func resourceDuoIDentitySyncStatusCreate(ctx context.Context, d *schema.Resource
 	response1, err := client.DuoIDentitySync.UpdateStatus(vvSyncName, request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceDuoMfa() *schema.Resource {
 		ReadContext:   resourceDuoMfaRead,
 		UpdateContext: resourceDuoMfaUpdate,
 		DeleteContext: resourceDuoMfaDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceDuoMfaRead, "", "connection_name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -161,41 +157,17 @@ func resourceDuoMfa() *schema.Resource {
 func resourceDuoMfaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestDuoMfaCreateMfa(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vConnectionName, okConnectionName := resourceItem["connection_name"]
+	vConnectionName := resourceItem["connection_name"]
 	vvConnectionName := interfaceToString(vConnectionName)
-	if isEnableAutoImport {
-		if okConnectionName && vvConnectionName != "" {
-			getResponse2, _, err := client.DuoMfa.GetMfaByconnectionName(vvConnectionName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["connection_name"] = vvConnectionName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceDuoMfaRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.DuoMfa.GetMfa()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsDuoMfaGetMfa(m, response2)
-				item2, err := searchDuoMfaGetMfa(m, items2, vvConnectionName)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["connection_name"] = vvConnectionName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceDuoMfaRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, err := client.DuoMfa.CreateMfa(request1)
 	if err != nil || resp1 == nil {
 		diags = append(diags, diagError(
@@ -220,25 +192,25 @@ func resourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interface
 	vConnectionName, okConnectionName := resourceMap["connection_name"]
 	vvConnectionName := vConnectionName
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectionName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetMfa")
+		logDebugf(ctx, "Selected method: GetMfa")
 
 		response1, restyResp1, err := client.DuoMfa.GetMfa()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsDuoMfaGetMfa(m, response1)
 		item1, err := searchDuoMfaGetMfa(m, items1, vvConnectionName)
@@ -254,7 +226,7 @@ func resourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interface
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetMfa search response",
 				err))
@@ -263,19 +235,19 @@ func resourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interface
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetMfaByconnectionName")
+		logDebugf(ctx, "Selected method: GetMfaByconnectionName")
 
 		response2, restyResp2, err := client.DuoMfa.GetMfaByconnectionName(vvConnectionName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenDuoMfaGetMfaByconnectionNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -284,7 +256,7 @@ func resourceDuoMfaRead(ctx context.Context, d *schema.ResourceData, m interface
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetMfaByconnectionName response",
 				err))
@@ -310,11 +282,11 @@ func resourceDuoMfaUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] Name used for update operation %s", vvConnectionName)
+		logDebugf(ctx, "Name used for update operation %s", vvConnectionName)
 
 		request1 := expandRequestDuoMfaUpdateMFaByConnectionName(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, err := client.DuoMfa.UpdateMFaByConnectionName(vvConnectionName, request1)
 
@@ -347,9 +319,9 @@ func resourceDuoMfaDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	vvConnectionName := vConnectionName
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectionName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
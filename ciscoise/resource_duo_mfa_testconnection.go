@@ -2,7 +2,6 @@ package ciscoise
 
 import (
 	"context"
-	"log"
 
 	"reflect"
 
@@ -103,7 +102,7 @@ func resourceDuoMfaTestconnectionCreate(ctx context.Context, d *schema.ResourceD
 
 	response1, err := client.DuoMfa.TestConnection(vvConnectionName, request1)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceEgressMatrixCell() *schema.Resource {
 		ReadContext:   resourceEgressMatrixCellRead,
 		UpdateContext: resourceEgressMatrixCellUpdate,
 		DeleteContext: resourceEgressMatrixCellDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceEgressMatrixCellRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -207,52 +203,23 @@ func resourceEgressMatrixCell() *schema.Resource {
 }
 
 func resourceEgressMatrixCellCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCell create")
+	logDebugf(ctx, "Beginning EgressMatrixCell create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestEgressMatrixCellCreateEgressMatrixCell(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
 
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.EgressMatrixCell.GetEgressMatrixCellByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEgressMatrixCellRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetEgressMatrixCellQueryParams{}
-
-			response2, _, err := client.EgressMatrixCell.GetEgressMatrixCell(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsEgressMatrixCellGetEgressMatrixCell(m, response2, &queryParams2)
-				item2, err := searchEgressMatrixCellGetEgressMatrixCell(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceEgressMatrixCellRead(ctx, d, m)
-				}
-			}
-		}
-	}
-
 	restyResp1, err := client.EgressMatrixCell.CreateEgressMatrixCell(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -276,7 +243,7 @@ func resourceEgressMatrixCellCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCell read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCell read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -291,26 +258,26 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 	vvName := vName
 	vvID := vID
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetEgressMatrixCell")
+		logDebugf(ctx, "Selected method: GetEgressMatrixCell")
 		queryParams1 := isegosdk.GetEgressMatrixCellQueryParams{}
 
 		response1, restyResp1, err := client.EgressMatrixCell.GetEgressMatrixCell(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsEgressMatrixCellGetEgressMatrixCell(m, response1, &queryParams1)
 		item1, err := searchEgressMatrixCellGetEgressMatrixCell(m, items1, vvName, vvID)
@@ -325,7 +292,7 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEgressMatrixCell search response",
 				err))
@@ -334,19 +301,19 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEgressMatrixCellByID")
+		logDebugf(ctx, "Selected method: GetEgressMatrixCellByID")
 
 		response2, restyResp2, err := client.EgressMatrixCell.GetEgressMatrixCellByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenEgressMatrixCellGetEgressMatrixCellByIDItem(response2.EgressMatrixCell)
 		if err := d.Set("item", vItem2); err != nil {
@@ -355,7 +322,7 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEgressMatrixCellByID response",
 				err))
@@ -368,7 +335,7 @@ func resourceEgressMatrixCellRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceEgressMatrixCellUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCell update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCell update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -381,9 +348,9 @@ func resourceEgressMatrixCellUpdate(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -408,15 +375,15 @@ func resourceEgressMatrixCellUpdate(ctx context.Context, d *schema.ResourceData,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestEgressMatrixCellUpdateEgressMatrixCellByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.EgressMatrixCell.UpdateEgressMatrixCellByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEgressMatrixCellByID", err, restyResp1.String(),
 					"Failure at UpdateEgressMatrixCellByID, unexpected response", ""))
@@ -434,7 +401,7 @@ func resourceEgressMatrixCellUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceEgressMatrixCellDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCell delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCell delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -447,9 +414,9 @@ func resourceEgressMatrixCellDelete(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -485,7 +452,7 @@ func resourceEgressMatrixCellDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.EgressMatrixCell.DeleteEgressMatrixCellByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEgressMatrixCellByID", err, restyResp1.String(),
 				"Failure at DeleteEgressMatrixCellByID, unexpected response", ""))
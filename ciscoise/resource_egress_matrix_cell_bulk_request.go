@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceEgressMatrixCellBulkRequest() *schema.Resource {
 		CreateContext: resourceEgressMatrixCellBulkRequestCreate,
 		ReadContext:   resourceEgressMatrixCellBulkRequestRead,
 		DeleteContext: resourceEgressMatrixCellBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceEgressMatrixCellBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceEgressMatrixCellBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForEgressMatrixCell create")
-	log.Printf("[DEBUG] Missing BulkRequestForEgressMatrixCell create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForEgressMatrixCell create")
+	logDebugf(ctx, "Missing BulkRequestForEgressMatrixCell create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -73,17 +75,23 @@ func resourceEgressMatrixCellBulkRequestCreate(ctx context.Context, d *schema.Re
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting BulkRequestForEgressMatrixCell response",
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForEgressMatrixCell", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.EgressMatrixCell.MonitorBulkStatusEgressMatrixCell(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceEgressMatrixCellBulkRequestRead(ctx, d, m)
+	return append(diags, resourceEgressMatrixCellBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceEgressMatrixCellBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -92,9 +100,9 @@ func resourceEgressMatrixCellBulkRequestRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceEgressMatrixCellBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCellBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCellBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EgressMatrixCellBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EgressMatrixCellBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestEgressMatrixCellBulkRequestBulkRequestForEgressMatrixCell(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestEgressMatrixCellBulkRequestForEgressMatrixCell {
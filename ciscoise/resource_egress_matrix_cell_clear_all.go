@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,8 +42,8 @@ func resourceEgressMatrixCellClearAll() *schema.Resource {
 }
 
 func resourceEgressMatrixCellClearAllCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ClearAllMatrixCells create")
-	log.Printf("[DEBUG] Missing ClearAllMatrixCells create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ClearAllMatrixCells create")
+	logDebugf(ctx, "Missing ClearAllMatrixCells create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -60,7 +58,7 @@ func resourceEgressMatrixCellClearAllCreate(ctx context.Context, d *schema.Resou
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -80,8 +78,8 @@ func resourceEgressMatrixCellClearAllRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceEgressMatrixCellClearAllDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCellClearAll delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCellClearAll delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EgressMatrixCellClearAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EgressMatrixCellClearAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
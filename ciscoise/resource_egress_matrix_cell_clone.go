@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -87,8 +85,8 @@ func resourceEgressMatrixCellClone() *schema.Resource {
 }
 
 func resourceEgressMatrixCellCloneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CloneMatrixCell create")
-	log.Printf("[DEBUG] Missing CloneMatrixCell create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning CloneMatrixCell create")
+	logDebugf(ctx, "Missing CloneMatrixCell create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -105,7 +103,7 @@ func resourceEgressMatrixCellCloneCreate(ctx context.Context, d *schema.Resource
 	response1, restyResp1, err := client.EgressMatrixCell.CloneMatrixCell(vvID, vvSrcSgtID, vvDstSgtID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing CloneMatrixCell", err, restyResp1.String(),
 				"Failure at CloneMatrixCell, unexpected response", ""))
@@ -117,7 +115,7 @@ func resourceEgressMatrixCellCloneCreate(ctx context.Context, d *schema.Resource
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenEgressMatrixCellCloneMatrixCellItem(response1.OperationResult)
 	if err := d.Set("item", vItem1); err != nil {
@@ -138,9 +136,9 @@ func resourceEgressMatrixCellCloneRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceEgressMatrixCellCloneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCellClone delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCellClone delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EgressMatrixCellClone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EgressMatrixCellClone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
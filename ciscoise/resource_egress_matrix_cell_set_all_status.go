@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,8 +49,8 @@ func resourceEgressMatrixCellSetAllStatus() *schema.Resource {
 }
 
 func resourceEgressMatrixCellSetAllStatusCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SetAllCellsStatus create")
-	log.Printf("[DEBUG] Missing SetAllCellsStatus create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning SetAllCellsStatus create")
+	logDebugf(ctx, "Missing SetAllCellsStatus create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -67,7 +65,7 @@ func resourceEgressMatrixCellSetAllStatusCreate(ctx context.Context, d *schema.R
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -87,8 +85,8 @@ func resourceEgressMatrixCellSetAllStatusRead(ctx context.Context, d *schema.Res
 }
 
 func resourceEgressMatrixCellSetAllStatusDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EgressMatrixCellSetAllStatus delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EgressMatrixCellSetAllStatus delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EgressMatrixCellSetAllStatus delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EgressMatrixCellSetAllStatus delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
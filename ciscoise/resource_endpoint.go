@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceEndpoint() *schema.Resource {
 		ReadContext:   resourceEndpointRead,
 		UpdateContext: resourceEndpointUpdate,
 		DeleteContext: resourceEndpointDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceEndpointRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -406,47 +402,23 @@ func resourceEndpoint() *schema.Resource {
 }
 
 func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Endpoint create")
+	logDebugf(ctx, "Beginning Endpoint create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestEndpointCreateEndpoint(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
 
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.Endpoint.GetEndpointByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEndpointRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.Endpoint.GetEndpointByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.ERSEndPoint.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEndpointRead(ctx, d, m)
-			}
-		}
-	}
-
 	restyResp1, err := client.Endpoint.CreateEndpoint(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -470,7 +442,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Endpoint read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Endpoint read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -482,26 +454,26 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetEndpointByName")
+		logDebugf(ctx, "Selected method: GetEndpointByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.Endpoint.GetEndpointByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenEndpointGetEndpointByNameItemName(response1.ERSEndPoint)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -510,7 +482,7 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEndpointByName response",
 				err))
@@ -520,20 +492,20 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEndpointByID")
+		logDebugf(ctx, "Selected method: GetEndpointByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.Endpoint.GetEndpointByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenEndpointGetEndpointByIDItemID(response2.ERSEndPoint)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -542,7 +514,7 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEndpointByID response",
 				err))
@@ -555,7 +527,7 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Endpoint update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Endpoint update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -567,9 +539,9 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -592,15 +564,15 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestEndpointUpdateEndpointByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Endpoint.UpdateEndpointByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEndpointByID", err, restyResp1.String(),
 					"Failure at UpdateEndpointByID, unexpected response", ""))
@@ -618,7 +590,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Endpoint delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Endpoint delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -630,9 +602,9 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, m inter
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -660,7 +632,7 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, m inter
 	restyResp1, err := client.Endpoint.DeleteEndpointByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEndpointByID", err, restyResp1.String(),
 				"Failure at DeleteEndpointByID, unexpected response", ""))
@@ -792,7 +764,7 @@ func expandRequestEndpointCreateEndpointERSEndPointCustomAttributes(ctx context.
 func expandRequestEndpointCreateEndpointERSEndPointCustomAttributesCustomAttributes(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestEndpointCreateEndpointERSEndPointCustomAttributesCustomAttributes {
 	var request isegosdk.RequestEndpointCreateEndpointERSEndPointCustomAttributesCustomAttributes
 	v := d.Get(fixKeyAccess(key))
-	log.Printf("[DEBUG] customAttributes => %s => %v", key, v)
+	logDebugf(ctx, "customAttributes => %s => %v", key, v)
 	request = v.(map[string]interface{})
 	return &request
 }
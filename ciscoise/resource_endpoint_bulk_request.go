@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceEndpointBulkRequest() *schema.Resource {
 		CreateContext: resourceEndpointBulkRequestCreate,
 		ReadContext:   resourceEndpointBulkRequestRead,
 		DeleteContext: resourceEndpointBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceEndpointBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceEndpointBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForEndpoint create")
-	log.Printf("[DEBUG] Missing BulkRequestForEndpoint create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForEndpoint create")
+	logDebugf(ctx, "Missing BulkRequestForEndpoint create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -74,7 +76,7 @@ func resourceEndpointBulkRequestCreate(ctx context.Context, d *schema.ResourceDa
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -82,10 +84,16 @@ func resourceEndpointBulkRequestCreate(ctx context.Context, d *schema.ResourceDa
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForEndpoint", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.Endpoint.MonitorBulkStatusEndpoint(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceEndpointBulkRequestRead(ctx, d, m)
+	return append(diags, resourceEndpointBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceEndpointBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -94,9 +102,9 @@ func resourceEndpointBulkRequestRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceEndpointBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EndpointBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EndpointBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -103,13 +101,13 @@ func resourceEndpointCertificate() *schema.Resource {
 }
 
 func resourceEndpointCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CreateEndpointCertificate create")
-	log.Printf("[DEBUG] Missing CreateEndpointCertificate create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning CreateEndpointCertificate create")
+	logDebugf(ctx, "Missing CreateEndpointCertificate create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Selected method: CreateEndpointCertificate")
+	logDebugf(ctx, "Selected method: CreateEndpointCertificate")
 	request1 := expandRequestEndpointCertificateCreateEndpointCertificate(ctx, "parameters.0", d)
 
 	response1, _, err := client.EndpointCertificate.CreateEndpointCertificate(request1)
@@ -120,7 +118,7 @@ func resourceEndpointCertificateCreate(ctx context.Context, d *schema.ResourceDa
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response")
+	logDebugf(ctx, "Retrieved response")
 
 	vvDirpath := d.Get("dirpath").(string)
 	err = response1.SaveDownload(vvDirpath)
@@ -129,7 +127,7 @@ func resourceEndpointCertificateCreate(ctx context.Context, d *schema.ResourceDa
 			"Failure when downloading file", err))
 		return diags
 	}
-	log.Printf("[DEBUG] Downloaded file %s", vvDirpath)
+	logDebugf(ctx, "Downloaded file %s", vvDirpath)
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
@@ -142,9 +140,9 @@ func resourceEndpointCertificateRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceEndpointCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointCertificate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointCertificate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EndpointCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EndpointCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,8 +49,8 @@ func resourceEndpointDeregister() *schema.Resource {
 }
 
 func resourceEndpointDeregisterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeregisterEndpoint create")
-	log.Printf("[DEBUG] Missing DeregisterEndpoint create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeregisterEndpoint create")
+	logDebugf(ctx, "Missing DeregisterEndpoint create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,7 +66,7 @@ func resourceEndpointDeregisterCreate(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -88,8 +86,8 @@ func resourceEndpointDeregisterRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceEndpointDeregisterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointDeregister delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointDeregister delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EndpointDeregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EndpointDeregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
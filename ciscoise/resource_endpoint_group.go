@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceEndpointGroup() *schema.Resource {
 		ReadContext:   resourceEndpointGroupRead,
 		UpdateContext: resourceEndpointGroupUpdate,
 		DeleteContext: resourceEndpointGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceEndpointGroupRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -155,45 +151,22 @@ func resourceEndpointGroup() *schema.Resource {
 }
 
 func resourceEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointGroup create")
+	logDebugf(ctx, "Beginning EndpointGroup create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestEndpointGroupCreateEndpointGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.EndpointIDentityGroup.GetEndpointGroupByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEndpointGroupRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.EndpointIDentityGroup.GetEndpointGroupByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.EndPointGroup.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEndpointGroupRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.EndpointIDentityGroup.CreateEndpointGroup(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -217,7 +190,7 @@ func resourceEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointGroup read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointGroup read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -229,26 +202,26 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetEndpointGroupByName")
+		logDebugf(ctx, "Selected method: GetEndpointGroupByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.EndpointIDentityGroup.GetEndpointGroupByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenEndpointIDentityGroupGetEndpointGroupByNameItemName(response1.EndPointGroup)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -257,7 +230,7 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEndpointGroupByName response",
 				err))
@@ -267,20 +240,20 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetEndpointGroupByID")
+		logDebugf(ctx, "Selected method: GetEndpointGroupByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.EndpointIDentityGroup.GetEndpointGroupByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenEndpointIDentityGroupGetEndpointGroupByIDItemID(response2.EndPointGroup)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -289,7 +262,7 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetEndpointGroupByID response",
 				err))
@@ -302,7 +275,7 @@ func resourceEndpointGroupRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointGroup update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointGroup update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -314,9 +287,9 @@ func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -339,15 +312,15 @@ func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestEndpointGroupUpdateEndpointGroupByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.EndpointIDentityGroup.UpdateEndpointGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateEndpointGroupByID", err, restyResp1.String(),
 					"Failure at UpdateEndpointGroupByID, unexpected response", ""))
@@ -365,7 +338,7 @@ func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointGroup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointGroup delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -377,9 +350,9 @@ func resourceEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -407,7 +380,7 @@ func resourceEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.EndpointIDentityGroup.DeleteEndpointGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteEndpointGroupByID", err, restyResp1.String(),
 				"Failure at DeleteEndpointGroupByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -202,8 +200,8 @@ func resourceEndpointRegister() *schema.Resource {
 }
 
 func resourceEndpointRegisterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RegisterEndpoint create")
-	log.Printf("[DEBUG] Missing RegisterEndpoint create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning RegisterEndpoint create")
+	logDebugf(ctx, "Missing RegisterEndpoint create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -213,7 +211,7 @@ func resourceEndpointRegisterCreate(ctx context.Context, d *schema.ResourceData,
 	response1, err := client.Endpoint.RegisterEndpoint(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
@@ -223,7 +221,7 @@ func resourceEndpointRegisterCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -243,9 +241,9 @@ func resourceEndpointRegisterRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceEndpointRegisterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning EndpointRegister delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning EndpointRegister delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing EndpointRegister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing EndpointRegister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceEndpoints() *schema.Resource {
 		ReadContext:   resourceEndpointsRead,
 		UpdateContext: resourceEndpointsUpdate,
 		DeleteContext: resourceEndpointsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceEndpointsRead, "", "value"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -245,43 +241,17 @@ func resourceEndpoints() *schema.Resource {
 func resourceEndpointsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestEndpointsCreateEndPoint(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vValue, okValue := resourceItem["value"]
+	vValue := resourceItem["value"]
 	vvValue := interfaceToString(vValue)
-	if isEnableAutoImport {
-		if okValue && vvValue != "" {
-			getResponse2, _, err := client.Endpoints.Get1(vvValue)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["value"] = vvValue
-				d.SetId(joinResourceID(resourceMap))
-				return resourceEndpointsRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.List1QueryParams{}
-
-			response2, _, err := client.Endpoints.List1(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsEndpointsList1(m, response2, &queryParams2)
-				item2, err := searchEndpointsList1(m, items2, vvValue)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["value"] = vvValue
-					d.SetId(joinResourceID(resourceMap))
-					return resourceEndpointsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, err := client.Endpoints.CreateEndPoint(request1)
 	if err != nil || resp1 == nil {
 		diags = append(diags, diagError(
@@ -307,26 +277,26 @@ func resourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interf
 	vvValue := vValue
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okValue}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: List1")
+		logDebugf(ctx, "Selected method: List1")
 		queryParams1 := isegosdk.List1QueryParams{}
 
 		response1, restyResp1, err := client.Endpoints.List1(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsEndpointsList1(m, response1, nil)
 		item1, err := searchEndpointsList1(m, items1, vvValue)
@@ -342,7 +312,7 @@ func resourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting List1 search response",
 				err))
@@ -351,19 +321,19 @@ func resourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: Get1")
+		logDebugf(ctx, "Selected method: Get1")
 
 		response2, restyResp2, err := client.Endpoints.Get1(vvValue)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenEndpointsGet1Item(response2)
 		if err := d.Set("item", vItem2); err != nil {
@@ -372,7 +342,7 @@ func resourceEndpointsRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting Get1 response",
 				err))
@@ -398,11 +368,11 @@ func resourceEndpointsUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] ID used for update operation %s", vvValue)
+		logDebugf(ctx, "ID used for update operation %s", vvValue)
 
 		request1 := expandRequestEndpointsUpdateEndpoint(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, restyResp1, err := client.Endpoints.UpdateEndpoint(vvValue, request1)
 
@@ -410,7 +380,7 @@ func resourceEndpointsUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 			if restyResp1 != nil {
 
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 
 				diags = append(diags, diagErrorWithAltAndResponse(
 
@@ -450,9 +420,9 @@ func resourceEndpointsDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vvValue := vValue
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okValue}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -2,10 +2,10 @@ package ciscoise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
-
-	"log"
+	"strings"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
@@ -13,6 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Number of endpoints listed per page.
+const ENDPOINTS_PAGE_SIZE = 100
+
+var endpointsBulkSource = sourceSpec{
+	itemsKey: "parameters",
+	columns: []string{"mac", "name", "description", "device_type", "group_id", "profile_id", "ip_address",
+		"static_group_assignment", "static_profile_assignment", "portal_user", "identity_store", "identity_store_id",
+		"product_id", "serial_number", "hardware_revision", "software_revision", "protocol", "vendor"},
+	item: endpointsBulkSourceItem,
+}
+
 func resourceEndpointsBulk() *schema.Resource {
 	return &schema.Resource{
 		Description: `It manages create, read, update and delete operations on endpoints.
@@ -28,21 +39,38 @@ func resourceEndpointsBulk() *schema.Resource {
 - Update Endpoint by id or mac
 
 - Delete endpoint by id or mac
+
+- Endpoints can be loaded from a CSV or JSON source instead of parameters, each row is planned as an endpoint.
+
+- Endpoints are refreshed from the endpoint listing, matched by MAC address. Only the endpoints confirmed created by the resource are refreshed, updated and deleted by it. The endpoints which already existed keep their declared value while they exist.
 `,
 
 		CreateContext: resourceEndpointsBulkCreate,
 		ReadContext:   resourceEndpointsBulkRead,
 		UpdateContext: resourceEndpointsBulkUpdate,
 		DeleteContext: resourceEndpointsBulkDelete,
+		CustomizeDiff: resourceEndpointsBulkCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+			Update: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: sourceSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"managed_macs": &schema.Schema{
+				Description: `MAC addresses of the endpoints created by the resource, deleted on destroy.`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"item": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -207,104 +235,435 @@ func resourceEndpointsBulk() *schema.Resource {
 					},
 				},
 			},
-		},
+		}, endpointsBulkSource),
 	}
 }
 
 func resourceEndpointsBulkCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning EndpointsBulk create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	var diags diag.Diagnostics
-	// resourceItem := *getResourceItem(d.Get("parameters"))
-	request1 := expandRequestEndpointsBulkCreateBulkEndPoints(ctx, "parameters", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
-	}
+	taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
-	resp1, restyResp1, err := client.Endpoints.CreateBulkEndPoints(request1)
-	if err != nil || resp1 == nil {
-		if restyResp1 != nil {
-			diags = append(diags, diagErrorWithResponse(
-				"Failure when executing CreateBulkEndPoints", err, restyResp1.String()))
+	var indexes []int
+	for item_no := range d.Get("parameters").([]interface{}) {
+		indexes = append(indexes, item_no)
+	}
+	resp1, created, diags := createEndpointsBulk(ctx, taskCtx, d, client, indexes)
+	if resp1 == nil && diags.HasError() {
+		return diags
+	}
+	if resp1 != nil {
+		vItem1 := flattenEndpointsBulkItem(resp1)
+		if err := d.Set("item", vItem1); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting CreateBulkEndPoints response",
+				err))
 			return diags
 		}
+	}
+
+	d.SetId(getUnixTimeString())
+	_ = d.Set("managed_macs", created)
+	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceEndpointsBulkRead(ctx, d, m)...)
+}
+
+func resourceEndpointsBulkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning EndpointsBulk read for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+
+	managedMacs := interfaceToSliceString(d.Get("managed_macs"))
+	managed := make(map[string]bool)
+	for _, mac := range managedMacs {
+		managed[normalizeMacAddress(mac)] = true
+	}
+	existing, err := getEndpoints(ctx, client, append(endpointsBulkMacs(d.Get("parameters")), managedMacs...))
+	if err != nil {
 		diags = append(diags, diagError(
-			"Failure when executing CreateBulkEndPoints", err))
+			"Failure when executing List1", err))
 		return diags
 	}
 
-	vItem1 := flattenEndpointsBulkItem(resp1)
-	if err := d.Set("item", vItem1); err != nil {
+	var items []interface{}
+	for _, item := range d.Get("parameters").([]interface{}) {
+		endpoint, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mac := normalizeMacAddress(endpointsBulkItemMac(endpoint))
+		found, ok := existing[mac]
+		if !ok {
+			logDebugf(ctx, "Endpoint %s not found, removing it from the state", endpointsBulkItemMac(endpoint))
+			continue
+		}
+		if !managed[mac] {
+			// An endpoint which already existed is not updated, so it is not
+			// refreshed either
+			items = append(items, endpoint)
+			continue
+		}
+		items = append(items, flattenEndpointsBulkParametersItem(endpoint, found))
+	}
+	if err := d.Set("parameters", items); err != nil {
 		diags = append(diags, diagError(
-			"Failure when setting GetAciSettings response",
+			"Failure when setting List1 response",
 			err))
 		return diags
 	}
-	d.SetId(getUnixTimeString())
+
+	// The endpoints created by the resource stay managed while they exist
+	stillManaged := []string{}
+	for _, mac := range managedMacs {
+		if _, ok := existing[normalizeMacAddress(mac)]; ok {
+			stillManaged = append(stillManaged, mac)
+		}
+	}
+	_ = d.Set("managed_macs", stillManaged)
 	return diags
 }
 
-func resourceEndpointsBulkRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	//client := m.(*isegosdk.Client)
+func resourceEndpointsBulkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning EndpointsBulk update for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
 	var diags diag.Diagnostics
-	return diags
+	if !d.HasChange("parameters") {
+		return resourceEndpointsBulkRead(ctx, d, m)
+	}
+
+	taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	managed := make(map[string]bool)
+	for _, mac := range interfaceToSliceString(d.Get("managed_macs")) {
+		managed[normalizeMacAddress(mac)] = true
+	}
+	managedMacs := []string{}
+	oldItems, newItems := d.GetChange("parameters")
+	prior := make(map[string]interface{})
+	for _, item := range oldItems.([]interface{}) {
+		if endpoint, ok := item.(map[string]interface{}); ok {
+			prior[normalizeMacAddress(endpointsBulkItemMac(endpoint))] = endpoint
+		}
+	}
+
+	planned := make(map[string]bool)
+	var createIndexes []int
+	updateRequest := isegosdk.RequestEndpointsUpdateBulkEndPoints{}
+	for item_no, item := range newItems.([]interface{}) {
+		endpoint, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mac := normalizeMacAddress(endpointsBulkItemMac(endpoint))
+		planned[mac] = true
+		if _, ok := prior[mac]; ok && !managed[mac] {
+			// It already existed on the last read, so it is left as it is
+			continue
+		}
+		if !managed[mac] {
+			createIndexes = append(createIndexes, item_no)
+			continue
+		}
+		request := expandRequestEndpointsBulkCreateBulkEndPointsItem(ctx, fmt.Sprintf("parameters.%d", item_no), d)
+		if request != nil && !reflect.DeepEqual(prior[mac], item) {
+			updateRequest = append(updateRequest, endpointsBulkUpdateItem(request))
+		}
+	}
+
+	for mac, item := range prior {
+		if planned[mac] && managed[mac] {
+			managedMacs = append(managedMacs, endpointsBulkItemMac(item.(map[string]interface{})))
+		}
+		if planned[mac] || !managed[mac] {
+			continue
+		}
+		value := endpointsBulkItemMac(item.(map[string]interface{}))
+		restyResp1, err := client.Endpoints.DeleteEndpoint(value)
+		if err != nil && (restyResp1 == nil || restyResp1.StatusCode() != 404) {
+			// The endpoint stays managed, so that the next apply or the
+			// destroy deletes it
+			managedMacs = append(managedMacs, value)
+			if restyResp1 != nil {
+				diags = append(diags, diagErrorWithResponse(
+					"Failure when executing DeleteEndpoint", err, restyResp1.String()))
+			} else {
+				diags = append(diags, diagError(
+					"Failure when executing DeleteEndpoint", err))
+			}
+		}
+	}
+
+	if len(updateRequest) > 0 {
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(updateRequest))
+		resp1, restyResp1, err := client.Endpoints.UpdateBulkEndPoints(&updateRequest)
+		if err != nil || resp1 == nil {
+			if restyResp1 != nil {
+				diags = append(diags, diagErrorWithResponse(
+					"Failure when executing UpdateBulkEndPoints", err, restyResp1.String()))
+			} else {
+				diags = append(diags, diagError(
+					"Failure when executing UpdateBulkEndPoints", err))
+			}
+		} else {
+			diags = append(diags, waitForTask(taskCtx, client, resp1.ID, "UpdateBulkEndPoints")...)
+		}
+	}
+
+	_, created, createDiags := createEndpointsBulk(ctx, taskCtx, d, client, createIndexes)
+	diags = append(diags, createDiags...)
+	managedMacs = append(managedMacs, created...)
+
+	_ = d.Set("managed_macs", managedMacs)
+	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceEndpointsBulkRead(ctx, d, m)...)
 }
 
-func resourceEndpointsBulkUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return resourceEndpointsBulkRead(ctx, d, m)
+func resourceEndpointsBulkCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	return customizeSourceDiff(diff, endpointsBulkSource)
 }
 
 func resourceEndpointsBulkDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	//client := m.(*isegosdk.Client)
+	logDebugf(ctx, "Beginning EndpointsBulk delete for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	for _, mac := range interfaceToSliceString(d.Get("managed_macs")) {
+		restyResp1, err := client.Endpoints.DeleteEndpoint(mac)
+		if err != nil && (restyResp1 == nil || restyResp1.StatusCode() != 404) {
+			if restyResp1 != nil {
+				diags = append(diags, diagErrorWithResponse(
+					"Failure when executing DeleteEndpoint", err, restyResp1.String()))
+			} else {
+				diags = append(diags, diagError(
+					"Failure when executing DeleteEndpoint", err))
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
 	return diags
 }
 
-func flattenEndpointsBulkItem(item *isegosdk.ResponseEndpointsCreateBulkEndPoints) []map[string]interface{} {
-	if item == nil {
-		return nil
-	}
-	respItem := make(map[string]interface{})
-	respItem["id"] = item.ID
-	return []map[string]interface{}{
-		respItem,
+// getEndpoints returns the endpoints of macs which exist, keyed by their
+// normalized MAC, following the pages of the endpoint listing.
+func getEndpoints(ctx context.Context, client *isegosdk.Client, macs []string) (map[string]*isegosdk.ResponseEndpointsGet1, error) {
+	wanted := make(map[string]bool, len(macs))
+	for _, mac := range macs {
+		wanted[normalizeMacAddress(mac)] = true
+	}
+	found := make(map[string]*isegosdk.ResponseEndpointsGet1)
+	if len(wanted) == 0 {
+		return found, nil
+	}
+	queryParams := isegosdk.List1QueryParams{
+		Page: 1,
+		Size: ENDPOINTS_PAGE_SIZE,
+	}
+	for {
+		response, restyResp, err := client.Endpoints.List1(&queryParams)
+		if err != nil || response == nil {
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			return nil, err
+		}
+		for _, item := range *response {
+			mac := normalizeMacAddress(item.Mac)
+			if !wanted[mac] {
+				continue
+			}
+			endpoint := &isegosdk.ResponseEndpointsGet1{}
+			if err := responseToJSONTypes(item, endpoint); err != nil {
+				return nil, err
+			}
+			found[mac] = endpoint
+		}
+		if len(*response) < ENDPOINTS_PAGE_SIZE {
+			return found, nil
+		}
+		queryParams.Page++
 	}
 }
 
-func expandRequestEndpointsBulkCreateBulkEndPoints(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestEndpointsCreateBulkEndPoints {
-	request := isegosdk.RequestEndpointsCreateBulkEndPoints{}
-	if v := expandRequestEndpointsBulkCreateBulkEndPointsItemArray(ctx, key+".payload", d); v != nil {
-		request = *v
+// createEndpointsBulk creates the endpoints at indexes of parameters in a
+// bulk and waits for its task. The task only reports counts, so the
+// endpoints which existed before are left out of the bulk, and the ones
+// found afterwards are returned as created by the resource.
+func createEndpointsBulk(ctx context.Context, taskCtx context.Context, d *schema.ResourceData, client *isegosdk.Client, indexes []int) (*isegosdk.ResponseEndpointsCreateBulkEndPoints, []string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(indexes) == 0 {
+		return nil, nil, diags
 	}
-	if isEmptyValue(reflect.ValueOf(request)) {
-		return nil
+	items := d.Get("parameters").([]interface{})
+	var macs []string
+	for _, item_no := range indexes {
+		macs = append(macs, endpointsBulkItemMac(items[item_no].(map[string]interface{})))
 	}
-	return &request
+	existing, err := getEndpoints(ctx, client, macs)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing List1", err))
+		return nil, nil, diags
+	}
+
+	request1 := isegosdk.RequestEndpointsCreateBulkEndPoints{}
+	var requested []string
+	for i, item_no := range indexes {
+		if _, ok := existing[normalizeMacAddress(macs[i])]; ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Endpoint %s already exists", macs[i]),
+				Detail:   "It is not created nor deleted by the resource.",
+			})
+			continue
+		}
+		request := expandRequestEndpointsBulkCreateBulkEndPointsItem(ctx, fmt.Sprintf("parameters.%d", item_no), d)
+		if request == nil {
+			continue
+		}
+		request1 = append(request1, *request)
+		requested = append(requested, macs[i])
+	}
+	if len(request1) == 0 {
+		return nil, nil, diags
+	}
+
+	logDebugf(ctx, "request sent => %v", responseInterfaceToString(request1))
+	resp1, restyResp1, err := client.Endpoints.CreateBulkEndPoints(&request1)
+	if err != nil || resp1 == nil {
+		if restyResp1 != nil {
+			diags = append(diags, diagErrorWithResponse(
+				"Failure when executing CreateBulkEndPoints", err, restyResp1.String()))
+		} else {
+			diags = append(diags, diagError(
+				"Failure when executing CreateBulkEndPoints", err))
+		}
+		return nil, nil, diags
+	}
+	diags = append(diags, waitForTask(taskCtx, client, resp1.ID, "CreateBulkEndPoints")...)
+
+	found, err := getEndpoints(ctx, client, requested)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing List1", err))
+		return resp1, nil, diags
+	}
+	created := []string{}
+	for _, mac := range requested {
+		if _, ok := found[normalizeMacAddress(mac)]; ok {
+			created = append(created, mac)
+		}
+	}
+	return resp1, created, diags
 }
 
-func expandRequestEndpointsBulkCreateBulkEndPointsItemArray(ctx context.Context, key string, d *schema.ResourceData) *[]isegosdk.RequestItemEndpointsCreateBulkEndPoints {
-	request := []isegosdk.RequestItemEndpointsCreateBulkEndPoints{}
-	key = fixKeyAccess(key)
-	o := d.Get(key)
-	if o == nil {
-		return nil
+// endpointsBulkItemMac returns the MAC of an item of parameters, value when
+// mac is not set.
+func endpointsBulkItemMac(item map[string]interface{}) string {
+	if mac := interfaceToString(item["mac"]); mac != "" && mac != "<nil>" {
+		return mac
 	}
-	objs := o.([]interface{})
-	if len(objs) == 0 {
-		return nil
+	return interfaceToString(item["value"])
+}
+
+func endpointsBulkMacs(items interface{}) []string {
+	macs := []string{}
+	list, _ := items.([]interface{})
+	for _, item := range list {
+		if endpoint, ok := item.(map[string]interface{}); ok {
+			macs = append(macs, endpointsBulkItemMac(endpoint))
+		}
 	}
-	for item_no := range objs {
-		i := expandRequestEndpointsBulkCreateBulkEndPointsItem(ctx, fmt.Sprintf("%s.%d", key, item_no), d)
-		if i != nil {
-			request = append(request, *i)
+	return macs
+}
+
+// endpointsBulkSourceItem reads an endpoint from a source record, the
+// endpoint is addressed by its MAC.
+func endpointsBulkSourceItem(record map[string]string) (map[string]interface{}, error) {
+	if record["mac"] == "" {
+		return nil, fmt.Errorf("mac is empty")
+	}
+	for _, key := range []string{"static_group_assignment", "static_profile_assignment"} {
+		record[key] = strings.ToLower(record[key])
+		if record[key] != "" && record[key] != "true" && record[key] != "false" {
+			return nil, fmt.Errorf("%s: expected true or false, got %s", key, record[key])
 		}
 	}
-	if isEmptyValue(reflect.ValueOf(request)) {
+	item := map[string]interface{}{"value": record["mac"]}
+	for key, value := range record {
+		item[key] = value
+	}
+	return item, nil
+}
+
+func endpointsBulkUpdateItem(item *isegosdk.RequestItemEndpointsCreateBulkEndPoints) isegosdk.RequestItemEndpointsUpdateBulkEndPoints {
+	updateItem := isegosdk.RequestItemEndpointsUpdateBulkEndPoints{}
+	data, err := json.Marshal(item)
+	if err == nil {
+		_ = json.Unmarshal(data, &updateItem)
+	}
+	return updateItem
+}
+
+// flattenEndpointsBulkParametersItem refreshes an item of parameters with
+// the endpoint read from ISE. value, mac and the links and MDM attributes are
+// kept from the configuration.
+func flattenEndpointsBulkParametersItem(item map[string]interface{}, found *isegosdk.ResponseEndpointsGet1) map[string]interface{} {
+	respItem := make(map[string]interface{})
+	for k, v := range item {
+		respItem[k] = v
+	}
+	respItem["id"] = found.ID
+	respItem["name"] = found.Name
+	respItem["description"] = found.Description
+	respItem["device_type"] = found.DeviceType
+	respItem["group_id"] = found.GroupID
+	respItem["hardware_revision"] = found.HardwareRevision
+	respItem["identity_store"] = found.IDentityStore
+	respItem["identity_store_id"] = found.IDentityStoreID
+	respItem["ip_address"] = found.IPAddress
+	respItem["portal_user"] = found.PortalUser
+	respItem["product_id"] = found.ProductID
+	respItem["profile_id"] = found.ProfileID
+	respItem["protocol"] = found.Protocol
+	respItem["serial_number"] = found.SerialNumber
+	respItem["software_revision"] = found.SoftwareRevision
+	respItem["static_group_assignment"] = boolPtrToString(found.StaticGroupAssignment)
+	respItem["static_profile_assignment"] = boolPtrToString(found.StaticProfileAssignment)
+	respItem["vendor"] = found.Vendor
+	if found.CustomAttributes != nil {
+		respItem["custom_attributes"] = mapInterfaceToMapString(*found.CustomAttributes)
+	}
+	return respItem
+}
+
+func flattenEndpointsBulkItem(item *isegosdk.ResponseEndpointsCreateBulkEndPoints) []map[string]interface{} {
+	if item == nil {
 		return nil
 	}
-	return &request
+	respItem := make(map[string]interface{})
+	respItem["id"] = item.ID
+	return []map[string]interface{}{
+		respItem,
+	}
 }
 
 func expandRequestEndpointsBulkCreateBulkEndPointsItem(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestItemEndpointsCreateBulkEndPoints {
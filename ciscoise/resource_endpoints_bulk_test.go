@@ -0,0 +1,156 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCreateEndpointsBulk(t *testing.T) {
+	var mu sync.Mutex
+	endpoints := map[string]bool{"11:11:11:11:11:11": true}
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/endpoint/bulk":
+			var request []map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			for _, item := range request {
+				mac := item["mac"].(string)
+				requested = append(requested, mac)
+				// The bulk task fails for this endpoint
+				if mac != "33:33:33:33:33:33" {
+					endpoints[mac] = true
+				}
+			}
+			w.Write([]byte(`{"id": "task1"}`))
+		case r.URL.Path == "/api/v1/task/task1":
+			w.Write([]byte(`{"id": "task1", "executionStatus": "COMPLETED", "failCount": 1}`))
+		case r.URL.Path == "/api/v1/endpoint":
+			writeEndpointsPage(w, r, endpoints)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	d := schema.TestResourceDataRaw(t, resourceEndpointsBulk().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{"mac": "11:11:11:11:11:11"},
+			map[string]interface{}{"mac": "22:22:22:22:22:22"},
+			map[string]interface{}{"mac": "33:33:33:33:33:33"},
+		},
+	})
+	resp, created, diags := createEndpointsBulk(ctx, ctx, d, clientConfig.Client, []int{0, 1, 2})
+	if resp == nil || resp.ID != "task1" {
+		t.Fatalf("bad: expect the bulk task, got %v with %v", resp, diags)
+	}
+	if !reflect.DeepEqual(requested, []string{"22:22:22:22:22:22", "33:33:33:33:33:33"}) {
+		t.Errorf("bad: expect the existing endpoint not to be sent, got %v", requested)
+	}
+	if !reflect.DeepEqual(created, []string{"22:22:22:22:22:22"}) {
+		t.Errorf("bad: expect only the endpoint confirmed created, got %v", created)
+	}
+	if !diags.HasError() || len(diags) != 2 {
+		t.Errorf("bad: expect a warning for the existing endpoint and an error for the task, got %v", diags)
+	}
+}
+
+// writeEndpointsPage writes the page of the endpoint listing requested,
+// ENDPOINTS_PAGE_SIZE endpoints per page.
+func writeEndpointsPage(w http.ResponseWriter, r *http.Request, endpoints map[string]bool) {
+	var macs []string
+	for mac := range endpoints {
+		macs = append(macs, mac)
+	}
+	sort.Strings(macs)
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	items := []map[string]interface{}{}
+	for i := (page - 1) * ENDPOINTS_PAGE_SIZE; i >= 0 && i < len(macs) && i < page*ENDPOINTS_PAGE_SIZE; i++ {
+		items = append(items, map[string]interface{}{"id": "id-" + macs[i], "mac": macs[i], "description": "read"})
+	}
+	json.NewEncoder(w).Encode(items)
+}
+
+func TestEndpointsBulkReadUpdate(t *testing.T) {
+	endpoints := map[string]bool{"11:11:11:11:11:11": true, "22:22:22:22:22:22": true}
+	// Other endpoints fill the first page
+	for i := 0; i < ENDPOINTS_PAGE_SIZE; i++ {
+		endpoints[fmt.Sprintf("00:00:00:00:%02x:%02x", i/256, i%256)] = true
+	}
+	var pages, sent int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/endpoint":
+			pages++
+			writeEndpointsPage(w, r, endpoints)
+		default:
+			sent++
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	// 11:11:11:11:11:11 was created by the resource, 22:22:22:22:22:22
+	// existed before
+	d := schema.TestResourceDataRaw(t, resourceEndpointsBulk().Schema, map[string]interface{}{
+		"parameters": []interface{}{
+			map[string]interface{}{"mac": "11:11:11:11:11:11", "description": "declared"},
+			map[string]interface{}{"mac": "22:22:22:22:22:22", "description": "declared"},
+		},
+	})
+	d.SetId("1")
+	_ = d.Set("managed_macs", []interface{}{"11:11:11:11:11:11"})
+
+	if diags := resourceEndpointsBulkRead(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if pages != 2 {
+		t.Errorf("bad: expect the listing to be paged through, got %d pages", pages)
+	}
+	if d.Get("parameters.0.description") != "read" || d.Get("parameters.1.description") != "declared" {
+		t.Errorf("bad: expect only the managed endpoint to be refreshed, got %v", d.Get("parameters"))
+	}
+
+	// The endpoint which existed is neither created again nor updated
+	state := d.State()
+	d, _ = schema.InternalMap(resourceEndpointsBulk().Schema).Data(state, &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"parameters.1.name": {Old: "", New: "printer"},
+		},
+	})
+	if !d.HasChange("parameters") {
+		t.Fatalf("bad: expect a change of parameters")
+	}
+	if diags := resourceEndpointsBulkUpdate(ctx, d, clientConfig); diags.HasError() || len(diags) != 0 {
+		t.Errorf("bad: expect no diagnostics, got %v", diags)
+	}
+	if sent != 0 {
+		t.Errorf("bad: expect no request besides the listing, got %d", sent)
+	}
+}
@@ -5,8 +5,6 @@ import (
 
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,7 +22,11 @@ func resourceEndpointsTask() *schema.Resource {
 		CreateContext: resourceEndpointsTaskCreate,
 		ReadContext:   resourceEndpointsTaskRead,
 		DeleteContext: resourceEndpointsTaskDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": waitForTaskSchema(),
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -178,18 +180,18 @@ func resourceEndpointsTaskCreate(ctx context.Context, d *schema.ResourceData, m
 	response1, restyResp1, err := client.Endpoints.CreateEndPointTask(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenEndpointsCreateEndPointTaskItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
@@ -199,6 +201,13 @@ func resourceEndpointsTaskCreate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
+	if d.Get("wait_for_completion").(bool) {
+		taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if diags := waitForTask(taskCtx, client, response1.ID, "CreateEndPointTask"); diags.HasError() {
+			return diags
+		}
+	}
 	d.SetId(getUnixTimeString())
 	return diags
 
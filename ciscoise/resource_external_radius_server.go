@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceExternalRadiusServer() *schema.Resource {
 		ReadContext:   resourceExternalRadiusServerRead,
 		UpdateContext: resourceExternalRadiusServerUpdate,
 		DeleteContext: resourceExternalRadiusServerDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceExternalRadiusServerRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -281,45 +277,22 @@ Allowed Values:
 }
 
 func resourceExternalRadiusServerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ExternalRadiusServer create")
+	logDebugf(ctx, "Beginning ExternalRadiusServer create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestExternalRadiusServerCreateExternalRadiusServer(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.ExternalRadiusServer.GetExternalRadiusServerByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceExternalRadiusServerRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.ExternalRadiusServer.GetExternalRadiusServerByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.ExternalRadiusServer.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceExternalRadiusServerRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.ExternalRadiusServer.CreateExternalRadiusServer(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -343,7 +316,7 @@ func resourceExternalRadiusServerCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ExternalRadiusServer read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ExternalRadiusServer read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -355,26 +328,26 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetExternalRadiusServerByName")
+		logDebugf(ctx, "Selected method: GetExternalRadiusServerByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.ExternalRadiusServer.GetExternalRadiusServerByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenExternalRadiusServerGetExternalRadiusServerByNameItemName(response1.ExternalRadiusServer)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -383,7 +356,7 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetExternalRadiusServerByName response",
 				err))
@@ -393,20 +366,20 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetExternalRadiusServerByID")
+		logDebugf(ctx, "Selected method: GetExternalRadiusServerByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.ExternalRadiusServer.GetExternalRadiusServerByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenExternalRadiusServerGetExternalRadiusServerByIDItemID(response2.ExternalRadiusServer)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -415,7 +388,7 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetExternalRadiusServerByID response",
 				err))
@@ -428,7 +401,7 @@ func resourceExternalRadiusServerRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceExternalRadiusServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ExternalRadiusServer update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ExternalRadiusServer update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -440,9 +413,9 @@ func resourceExternalRadiusServerUpdate(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -465,15 +438,15 @@ func resourceExternalRadiusServerUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestExternalRadiusServerUpdateExternalRadiusServerByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.ExternalRadiusServer.UpdateExternalRadiusServerByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateExternalRadiusServerByID", err, restyResp1.String(),
 					"Failure at UpdateExternalRadiusServerByID, unexpected response", ""))
@@ -491,7 +464,7 @@ func resourceExternalRadiusServerUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceExternalRadiusServerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ExternalRadiusServer delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ExternalRadiusServer delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -503,9 +476,9 @@ func resourceExternalRadiusServerDelete(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -533,7 +506,7 @@ func resourceExternalRadiusServerDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.ExternalRadiusServer.DeleteExternalRadiusServerByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteExternalRadiusServerByID", err, restyResp1.String(),
 				"Failure at DeleteExternalRadiusServerByID, unexpected response", ""))
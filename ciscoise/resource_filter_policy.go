@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceFilterPolicy() *schema.Resource {
 		ReadContext:   resourceFilterPolicyRead,
 		UpdateContext: resourceFilterPolicyUpdate,
 		DeleteContext: resourceFilterPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceFilterPolicyRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -121,20 +117,19 @@ At least one of subnet or sgt or vn should be defined`,
 }
 
 func resourceFilterPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning FilterPolicy create")
+	logDebugf(ctx, "Beginning FilterPolicy create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := clientConfig.EnableAutoImport
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestFilterPolicyCreateFilterPolicy(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vSgt, _ := resourceItem["sgt"]
 	vSubnet, _ := resourceItem["subnet"]
 	vVn, _ := resourceItem["vn"]
@@ -142,40 +137,6 @@ func resourceFilterPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 	vvSgt := interfaceToString(vSgt)
 	vvSubnet := interfaceToString(vSubnet)
 	vvVn := interfaceToString(vVn)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.FilterPolicy.GetFilterPolicyByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["sgt"] = vvSgt
-				resourceMap["subnet"] = vvSubnet
-				resourceMap["vn"] = vvVn
-				d.SetId(joinResourceID(resourceMap))
-				return diags
-			}
-		} else {
-			queryParams2 := isegosdk.GetFilterPolicyQueryParams{}
-
-			response2, _, err := client.FilterPolicy.GetFilterPolicy(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsFilterPolicyGetFilterPolicy(m, response2, &queryParams2)
-				item2, nID, err := searchFilterPolicyGetFilterPolicy(m, items2, vvSgt, vvSubnet, vvVn, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.Sgt
-					if nID != "" && nID != vvID {
-						resourceMap["id"] = nID
-					}
-					resourceMap["sgt"] = vvSgt
-					resourceMap["subnet"] = vvSubnet
-					resourceMap["vn"] = vvVn
-					d.SetId(joinResourceID(resourceMap))
-					return diags
-				}
-			}
-		}
-	}
 	restyResp1, err := client.FilterPolicy.CreateFilterPolicy(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -201,7 +162,7 @@ func resourceFilterPolicyCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning FilterPolicy read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning FilterPolicy read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -218,26 +179,26 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 	vvVn := vVn
 	vvID := vID
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSgt, okSubnet, okVn}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetFilterPolicy")
+		logDebugf(ctx, "Selected method: GetFilterPolicy")
 		queryParams1 := isegosdk.GetFilterPolicyQueryParams{}
 
 		response1, restyResp1, err := client.FilterPolicy.GetFilterPolicy(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsFilterPolicyGetFilterPolicy(m, response1, &queryParams1)
 		item1, _, err := searchFilterPolicyGetFilterPolicy(m, items1, vvSgt, vvSubnet, vvVn, vvID)
@@ -252,7 +213,7 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetFilterPolicy search response",
 				err))
@@ -261,19 +222,19 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetFilterPolicyByID")
+		logDebugf(ctx, "Selected method: GetFilterPolicyByID")
 
 		response2, restyResp2, err := client.FilterPolicy.GetFilterPolicyByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenFilterPolicyGetFilterPolicyByIDItem(response2.ERSFilterPolicy)
 		if err := d.Set("item", vItem2); err != nil {
@@ -282,7 +243,7 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetFilterPolicyByID response",
 				err))
@@ -295,7 +256,7 @@ func resourceFilterPolicyRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceFilterPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning FilterPolicy update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning FilterPolicy update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -312,9 +273,9 @@ func resourceFilterPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 	vvVn := vVn
 	vvID := vID
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSgt, okSubnet, okVn}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -334,15 +295,15 @@ func resourceFilterPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestFilterPolicyUpdateFilterPolicyByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.FilterPolicy.UpdateFilterPolicyByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateFilterPolicyByID", err, restyResp1.String(),
 					"Failure at UpdateFilterPolicyByID, unexpected response", ""))
@@ -360,7 +321,7 @@ func resourceFilterPolicyUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceFilterPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning FilterPolicy delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning FilterPolicy delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -377,9 +338,9 @@ func resourceFilterPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	vvVn := vVn
 	vvID := vID
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okSgt, okSubnet, okVn}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -408,7 +369,7 @@ func resourceFilterPolicyDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.FilterPolicy.DeleteFilterPolicyByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteFilterPolicyByID", err, restyResp1.String(),
 				"Failure at DeleteFilterPolicyByID, unexpected response", ""))
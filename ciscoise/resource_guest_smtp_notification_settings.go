@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,9 +24,7 @@ func resourceGuestSmtpNotificationSettings() *schema.Resource {
 		ReadContext:   resourceGuestSmtpNotificationSettingsRead,
 		UpdateContext: resourceGuestSmtpNotificationSettingsUpdate,
 		DeleteContext: resourceGuestSmtpNotificationSettingsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceGuestSmtpNotificationSettingsRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -239,46 +235,20 @@ func resourceGuestSmtpNotificationSettings() *schema.Resource {
 }
 
 func resourceGuestSmtpNotificationSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSmtpNotificationSettings create")
+	logDebugf(ctx, "Beginning GuestSmtpNotificationSettings create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestGuestSmtpNotificationSettingsCreateGuestSmtpNotificationSettings(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.GuestSmtpNotificationConfiguration.GetGuestSmtpNotificationSettingsByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				d.SetId(joinResourceID(resourceMap))
-				return resourceGuestSmtpNotificationSettingsRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetGuestSmtpNotificationSettingsQueryParams{}
-
-			response2, _, err := client.GuestSmtpNotificationConfiguration.GetGuestSmtpNotificationSettings(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, response2, &queryParams2)
-				item2, err := searchGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, items2, "", vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					d.SetId(joinResourceID(resourceMap))
-					return resourceGuestSmtpNotificationSettingsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.GuestSmtpNotificationConfiguration.CreateGuestSmtpNotificationSettings(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -301,7 +271,7 @@ func resourceGuestSmtpNotificationSettingsCreate(ctx context.Context, d *schema.
 }
 
 func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSmtpNotificationSettings read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSmtpNotificationSettings read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -312,27 +282,27 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetGuestSmtpNotificationSettings")
+		logDebugf(ctx, "Selected method: GetGuestSmtpNotificationSettings")
 		queryParams1 := isegosdk.GetGuestSmtpNotificationSettingsQueryParams{}
 
 		response1, restyResp1, err := client.GuestSmtpNotificationConfiguration.GetGuestSmtpNotificationSettings(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, response1, &queryParams1)
 		item1, err := searchGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettings(m, items1, "", vvID)
@@ -347,7 +317,7 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestSmtpNotificationSettings search response",
 				err))
@@ -356,20 +326,20 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetGuestSmtpNotificationSettingsByID")
+		logDebugf(ctx, "Selected method: GetGuestSmtpNotificationSettingsByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.GuestSmtpNotificationConfiguration.GetGuestSmtpNotificationSettingsByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenGuestSmtpNotificationConfigurationGetGuestSmtpNotificationSettingsByIDItem(response2.ERSGuestSmtpNotificationSettings)
 		if err := d.Set("item", vItem2); err != nil {
@@ -378,7 +348,7 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestSmtpNotificationSettingsByID response",
 				err))
@@ -391,7 +361,7 @@ func resourceGuestSmtpNotificationSettingsRead(ctx context.Context, d *schema.Re
 }
 
 func resourceGuestSmtpNotificationSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSmtpNotificationSettings update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSmtpNotificationSettings update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -402,9 +372,9 @@ func resourceGuestSmtpNotificationSettingsUpdate(ctx context.Context, d *schema.
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -413,15 +383,15 @@ func resourceGuestSmtpNotificationSettingsUpdate(ctx context.Context, d *schema.
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestGuestSmtpNotificationSettingsUpdateGuestSmtpNotificationSettingsByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.GuestSmtpNotificationConfiguration.UpdateGuestSmtpNotificationSettingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestSmtpNotificationSettingsByID", err, restyResp1.String(),
 					"Failure at UpdateGuestSmtpNotificationSettingsByID, unexpected response", ""))
@@ -439,9 +409,9 @@ func resourceGuestSmtpNotificationSettingsUpdate(ctx context.Context, d *schema.
 }
 
 func resourceGuestSmtpNotificationSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSmtpNotificationSettings delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSmtpNotificationSettings delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestSmtpNotificationSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestSmtpNotificationSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestGuestSmtpNotificationSettingsCreateGuestSmtpNotificationSettings(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestGuestSmtpNotificationConfigurationCreateGuestSmtpNotificationSettings {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceGuestSSID() *schema.Resource {
 		ReadContext:   resourceGuestSSIDRead,
 		UpdateContext: resourceGuestSSIDUpdate,
 		DeleteContext: resourceGuestSSIDDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceGuestSSIDRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -127,50 +123,22 @@ func resourceGuestSSID() *schema.Resource {
 }
 
 func resourceGuestSSIDCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSSID create")
+	logDebugf(ctx, "Beginning GuestSSID create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestGuestSSIDCreateGuestSSID(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.GuestSSID.GetGuestSSIDByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceGuestSSIDRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetGuestSSIDQueryParams{}
-
-			response2, _, err := client.GuestSSID.GetGuestSSID(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsGuestSSIDGetGuestSSID(m, response2, &queryParams2)
-				item2, err := searchGuestSSIDGetGuestSSID(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceGuestSSIDRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.GuestSSID.CreateGuestSSID(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -194,7 +162,7 @@ func resourceGuestSSIDCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSSID read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSSID read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -207,28 +175,28 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetGuestSSID")
+		logDebugf(ctx, "Selected method: GetGuestSSID")
 		queryParams1 := isegosdk.GetGuestSSIDQueryParams{}
 
 		response1, restyResp1, err := client.GuestSSID.GetGuestSSID(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsGuestSSIDGetGuestSSID(m, response1, &queryParams1)
 		item1, err := searchGuestSSIDGetGuestSSID(m, items1, vvName, vvID)
@@ -243,7 +211,7 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestSSID search response",
 				err))
@@ -252,20 +220,20 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetGuestSSIDByID")
+		logDebugf(ctx, "Selected method: GetGuestSSIDByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.GuestSSID.GetGuestSSIDByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenGuestSSIDGetGuestSSIDByIDItem(response2.GuestSSID)
 		if err := d.Set("item", vItem2); err != nil {
@@ -274,7 +242,7 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestSSIDByID response",
 				err))
@@ -287,7 +255,7 @@ func resourceGuestSSIDRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceGuestSSIDUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSSID update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSSID update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -300,9 +268,9 @@ func resourceGuestSSIDUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -327,15 +295,15 @@ func resourceGuestSSIDUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestGuestSSIDUpdateGuestSSIDByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.GuestSSID.UpdateGuestSSIDByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestSSIDByID", err, restyResp1.String(),
 					"Failure at UpdateGuestSSIDByID, unexpected response", ""))
@@ -353,7 +321,7 @@ func resourceGuestSSIDUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestSSIDDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestSSID delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestSSID delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -365,9 +333,9 @@ func resourceGuestSSIDDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -403,7 +371,7 @@ func resourceGuestSSIDDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestSSID.DeleteGuestSSIDByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestSSIDByID", err, restyResp1.String(),
 				"Failure at DeleteGuestSSIDByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceGuestType() *schema.Resource {
 		ReadContext:   resourceGuestTypeRead,
 		UpdateContext: resourceGuestTypeUpdate,
 		DeleteContext: resourceGuestTypeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceGuestTypeRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -535,50 +531,22 @@ Allowed values are:
 }
 
 func resourceGuestTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestType create")
+	logDebugf(ctx, "Beginning GuestType create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestGuestTypeCreateGuestType(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.GuestType.GetGuestTypeByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceGuestTypeRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetGuestTypeQueryParams{}
-
-			response2, _, err := client.GuestType.GetGuestType(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsGuestTypeGetGuestType(m, response2, &queryParams2)
-				item2, err := searchGuestTypeGetGuestType(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceGuestTypeRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.GuestType.CreateGuestType(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -602,7 +570,7 @@ func resourceGuestTypeCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestType read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestType read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -614,28 +582,28 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetGuestType")
+		logDebugf(ctx, "Selected method: GetGuestType")
 		queryParams1 := isegosdk.GetGuestTypeQueryParams{}
 
 		response1, restyResp1, err := client.GuestType.GetGuestType(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsGuestTypeGetGuestType(m, response1, &queryParams1)
 		item1, err := searchGuestTypeGetGuestType(m, items1, vvName, vvID)
@@ -650,7 +618,7 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestType search response",
 				err))
@@ -659,20 +627,20 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetGuestTypeByID")
+		logDebugf(ctx, "Selected method: GetGuestTypeByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.GuestType.GetGuestTypeByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenGuestTypeGetGuestTypeByIDItem(response2.GuestType)
 		if err := d.Set("item", vItem2); err != nil {
@@ -681,7 +649,7 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestTypeByID response",
 				err))
@@ -694,7 +662,7 @@ func resourceGuestTypeRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceGuestTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestType update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestType update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -706,9 +674,9 @@ func resourceGuestTypeUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -732,15 +700,15 @@ func resourceGuestTypeUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestGuestTypeUpdateGuestTypeByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.GuestType.UpdateGuestTypeByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestTypeByID", err, restyResp1.String(),
 					"Failure at UpdateGuestTypeByID, unexpected response", ""))
@@ -758,7 +726,7 @@ func resourceGuestTypeUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestType delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestType delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -770,9 +738,9 @@ func resourceGuestTypeDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -808,7 +776,7 @@ func resourceGuestTypeDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestType.DeleteGuestTypeByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestTypeByID", err, restyResp1.String(),
 				"Failure at DeleteGuestTypeByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,9 +31,7 @@ func resourceGuestUser() *schema.Resource {
 		ReadContext:   resourceGuestUserRead,
 		UpdateContext: resourceGuestUserUpdate,
 		DeleteContext: resourceGuestUserDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceGuestUserRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -443,45 +439,22 @@ func resourceGuestUser() *schema.Resource {
 }
 
 func resourceGuestUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUser create")
+	logDebugf(ctx, "Beginning GuestUser create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestGuestUserCreateGuestUser(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.GuestUser.GetGuestUserByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceGuestUserRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.GuestUser.GetGuestUserByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.GuestUser.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceGuestUserRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.GuestUser.CreateGuestUser(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -505,7 +478,7 @@ func resourceGuestUserCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUser read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUser read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -517,26 +490,26 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetGuestUserByName")
+		logDebugf(ctx, "Selected method: GetGuestUserByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.GuestUser.GetGuestUserByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenGuestUserGetGuestUserByNameItemName(response1.GuestUser)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -545,7 +518,7 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestUserByName response",
 				err))
@@ -555,20 +528,20 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetGuestUserByID")
+		logDebugf(ctx, "Selected method: GetGuestUserByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.GuestUser.GetGuestUserByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenGuestUserGetGuestUserByIDItemID(response2.GuestUser)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -577,7 +550,7 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetGuestUserByID response",
 				err))
@@ -590,7 +563,7 @@ func resourceGuestUserRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUser update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUser update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -602,9 +575,9 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -627,15 +600,15 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestGuestUserUpdateGuestUserByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.GuestUser.UpdateGuestUserByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateGuestUserByID", err, restyResp1.String(),
 					"Failure at UpdateGuestUserByID, unexpected response", ""))
@@ -666,7 +639,7 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 									request3.OperationAdditionalData = &operational_data
 									response3, err := client.GuestUser.UpdateGuestUserEmail(vvID, vvPortalID, request3)
 									if err != nil || response3 == nil {
-										log.Printf("[ERROR] response for UpdateGuestUserEmail operation => %v", response3.String())
+										logErrorf(ctx, "response for UpdateGuestUserEmail operation => %v", response3.String())
 									}
 								}
 							}
@@ -682,7 +655,7 @@ func resourceGuestUserUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceGuestUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUser delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUser delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -694,9 +667,9 @@ func resourceGuestUserDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -724,7 +697,7 @@ func resourceGuestUserDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.GuestUser.DeleteGuestUserByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteGuestUserByID", err, restyResp1.String(),
 				"Failure at DeleteGuestUserByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,8 +49,8 @@ func resourceGuestUserApprove() *schema.Resource {
 }
 
 func resourceGuestUserApproveCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ApproveGuestUserByID create")
-	log.Printf("[DEBUG] Missing ApproveGuestUserByID create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ApproveGuestUserByID create")
+	logDebugf(ctx, "Missing ApproveGuestUserByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,7 +66,7 @@ func resourceGuestUserApproveCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -88,8 +86,8 @@ func resourceGuestUserApproveRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGuestUserApproveDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserApprove delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserApprove delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserApprove delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserApprove delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
@@ -2,68 +2,184 @@ package ciscoise
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-
-	"log"
+	"strconv"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// Number of guest users listed per page, the ERS maximum.
+const GUEST_USERS_PAGE_SIZE = 100
+
+var guestUserBulkType = ersBulkType{
+	name:      "GuestUser",
+	path:      "guestuser",
+	mediaType: "vnd.com.cisco.ise.identity.guestuser.2.0",
+	monitor: func(client *isegosdk.Client, bulkID string) (interface{}, *resty.Response, error) {
+		return client.GuestUser.MonitorBulkStatusGuestUser(bulkID)
+	},
+}
+
+var guestUsersSource = sourceSpec{
+	itemsKey: "guest",
+	columns: []string{"name", "description", "guest_type", "portal_id", "sponsor_user_name", "first_name", "last_name",
+		"company", "email_address", "phone_number", "password", "location", "valid_days", "from_date", "to_date"},
+	item: guestUsersSourceItem,
+}
+
+type guestUserBulkResource struct {
+	ID string `json:"id,omitempty"`
+	*isegosdk.RequestGuestUserCreateGuestUserGuestUser
+}
+
 func resourceGuestUserBulkRequest() *schema.Resource {
+	s := sourceSchema(bulkRequestSchema(map[string]*schema.Schema{
+		"last_updated": &schema.Schema{
+			Description: `Unix timestamp records the last time that the resource was updated.`,
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"item": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"guest": &schema.Schema{
+			Description: `Guest users sent in the bulk request, updates and deletes find them by name.`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Description: `User name of the guest`,
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"description": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"guest_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"portal_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"sponsor_user_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"first_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"last_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"company": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"email_address": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"phone_number": &schema.Schema{
+						Description: `Phone number should be E.164 format`,
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"password": &schema.Schema{
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"location": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"valid_days": &schema.Schema{
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"from_date": &schema.Schema{
+						Description: `Start of the access, MM/dd/yyyy HH:mm`,
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+					"to_date": &schema.Schema{
+						Description: `End of the access, MM/dd/yyyy HH:mm`,
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"parameters": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			MinItems: 1,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"operation_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+					"resource_media_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+	}), guestUsersSource)
+	// The resource has no update, a change of the source sends a new bulk
+	// request
+	for _, key := range []string{"source_file", "source_content", "source_format", "column_mappings"} {
+		s[key].ForceNew = true
+	}
 	return &schema.Resource{
 		Description: `It performs update operation on GuestUser.
 - This resource allows the client to submit the bulk request.
+
+- The guest users of the request can be declared in guest blocks or loaded from a CSV or JSON source, each row is planned as a guest user. They are sent in a single bulk request of at most 500 guest users.
 `,
 
 		CreateContext: resourceGuestUserBulkRequestCreate,
 		ReadContext:   resourceGuestUserBulkRequestRead,
 		DeleteContext: resourceGuestUserBulkRequestDelete,
-
-		Schema: map[string]*schema.Schema{
-			"last_updated": &schema.Schema{
-				Description: `Unix timestamp records the last time that the resource was updated.`,
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"item": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"parameters": &schema.Schema{
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
-				MinItems: 1,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"operation_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-						"resource_media_type": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
-						},
-					},
-				},
-			},
+		CustomizeDiff: resourceGuestUserBulkRequestCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
 		},
+
+		Schema: s,
 	}
 }
 
 func resourceGuestUserBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForGuestUser create")
-	log.Printf("[DEBUG] Missing BulkRequestForGuestUser create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForGuestUser create")
+	logDebugf(ctx, "Missing BulkRequestForGuestUser create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
+	if guests := d.Get("guest").([]interface{}); len(guests) > 0 {
+		return createGuestUserBulkRequestGuests(ctx, d, m, guests)
+	}
 	request1 := expandRequestGuestUserBulkRequestBulkRequestForGuestUser(ctx, "parameters.0", d)
 
 	response1, err := client.GuestUser.BulkRequestForGuestUser(request1)
@@ -74,7 +190,7 @@ func resourceGuestUserBulkRequestCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -82,10 +198,105 @@ func resourceGuestUserBulkRequestCreate(ctx context.Context, d *schema.ResourceD
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForGuestUser", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.GuestUser.MonitorBulkStatusGuestUser(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceGuestUserBulkRequestRead(ctx, d, m)
+	return append(diags, resourceGuestUserBulkRequestRead(ctx, d, m)...)
+}
+
+// createGuestUserBulkRequestGuests sends the guest users in a bulk request of
+// operation_type. Updates and deletes find the ID of each guest user by name
+// in the guest user listing.
+func createGuestUserBulkRequestGuests(ctx context.Context, d *schema.ResourceData, m interface{}, guests []interface{}) diag.Diagnostics {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+	request := ersBulkRequestBody{
+		OperationType:     d.Get("parameters.0.operation_type").(string),
+		ResourceMediaType: d.Get("parameters.0.resource_media_type").(string),
+	}
+	if request.ResourceMediaType == "" {
+		request.ResourceMediaType = guestUserBulkType.mediaType
+	}
+	guestIDs := make(map[string]string)
+	if request.OperationType != "create" {
+		existing, err := listGuestUsers(ctx, client)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when executing GetGuestUsers", err))
+			return diags
+		}
+		for _, item := range existing {
+			guestIDs[item.Name] = item.ID
+		}
+	}
+	for _, item := range guests {
+		guest, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := interfaceToString(guest["name"])
+		if request.OperationType != "create" && guestIDs[name] == "" {
+			diags = append(diags, diagError(
+				"Failure when executing BulkRequestForGuestUser", fmt.Errorf("guest user %s not found", name)))
+			return diags
+		}
+		if request.OperationType == "delete" {
+			request.IDList = append(request.IDList, guestIDs[name])
+			continue
+		}
+		request.ResourcesList = append(request.ResourcesList, map[string]interface{}{
+			guestUserBulkType.name: guestUserBulkResource{
+				ID:                                       guestIDs[name],
+				RequestGuestUserCreateGuestUserGuestUser: expandGuestUsersGuest(guest),
+			},
+		})
+	}
+
+	submitURL := clientConfig.Config.APIURL(ERS_API_PORT, fmt.Sprintf("/ers/config/%s/bulk/submit", guestUserBulkType.path))
+	response1, err := putERSBulk(ctx, client, submitURL, guestUserBulkType, request)
+	if err != nil {
+		if response1 != nil {
+			diags = append(diags, diagErrorWithResponse(
+				"Failure when executing BulkRequestForGuestUser", err, response1.String()))
+		} else {
+			diags = append(diags, diagError(
+				"Failure when executing BulkRequestForGuestUser", err))
+		}
+		return diags
+	}
+	if err := d.Set("item", response1.String()); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting BulkRequestForGuestUser response",
+			err))
+		return diags
+	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForGuestUser", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.GuestUser.MonitorBulkStatusGuestUser(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+	_ = d.Set("last_updated", getUnixTimeString())
+	d.SetId(getUnixTimeString())
+	return append(diags, resourceGuestUserBulkRequestRead(ctx, d, m)...)
+}
+
+func resourceGuestUserBulkRequestCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := customizeSourceDiff(diff, guestUsersSource); err != nil {
+		return err
+	}
+	if guests := diff.Get("guest").([]interface{}); len(guests) > ERS_BULK_SIZE {
+		return fmt.Errorf("%d guest users, a bulk request holds at most %d", len(guests), ERS_BULK_SIZE)
+	}
+	return nil
 }
 
 func resourceGuestUserBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -94,9 +305,9 @@ func resourceGuestUserBulkRequestRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGuestUserBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -116,3 +327,88 @@ func expandRequestGuestUserBulkRequestBulkRequestForGuestUserGuestUserBulkReques
 	}
 	return &request
 }
+
+// listGuestUsers returns every guest user, following the pages of the search
+// result.
+func listGuestUsers(ctx context.Context, client *isegosdk.Client) ([]isegosdk.ResponseGuestUserGetGuestUsersSearchResultResources, error) {
+	queryParams := isegosdk.GetGuestUsersQueryParams{
+		Page: 1,
+		Size: GUEST_USERS_PAGE_SIZE,
+	}
+	var items []isegosdk.ResponseGuestUserGetGuestUsersSearchResultResources
+	for {
+		response, restyResp, err := client.GuestUser.GetGuestUsers(&queryParams)
+		if err != nil || response == nil {
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			return nil, err
+		}
+		if response.SearchResult == nil || response.SearchResult.Resources == nil || len(*response.SearchResult.Resources) == 0 {
+			return items, nil
+		}
+		items = append(items, *response.SearchResult.Resources...)
+		if response.SearchResult.NextPage == nil || response.SearchResult.NextPage.Rel != "next" {
+			return items, nil
+		}
+		queryParams.Page, queryParams.Size, err = getNextPageAndSizeParams(response.SearchResult.NextPage.Href)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func expandGuestUsersGuest(guest map[string]interface{}) *isegosdk.RequestGuestUserCreateGuestUserGuestUser {
+	request := isegosdk.RequestGuestUserCreateGuestUserGuestUser{
+		Name:            interfaceToString(guest["name"]),
+		Description:     interfaceToString(guest["description"]),
+		GuestType:       interfaceToString(guest["guest_type"]),
+		PortalID:        interfaceToString(guest["portal_id"]),
+		SponsorUserName: interfaceToString(guest["sponsor_user_name"]),
+		GuestInfo: &isegosdk.RequestGuestUserCreateGuestUserGuestUserGuestInfo{
+			UserName:     interfaceToString(guest["name"]),
+			FirstName:    interfaceToString(guest["first_name"]),
+			LastName:     interfaceToString(guest["last_name"]),
+			Company:      interfaceToString(guest["company"]),
+			EmailAddress: interfaceToString(guest["email_address"]),
+			PhoneNumber:  interfaceToString(guest["phone_number"]),
+			Password:     interfaceToString(guest["password"]),
+		},
+		GuestAccessInfo: &isegosdk.RequestGuestUserCreateGuestUserGuestUserGuestAccessInfo{
+			Location: interfaceToString(guest["location"]),
+			FromDate: interfaceToString(guest["from_date"]),
+			ToDate:   interfaceToString(guest["to_date"]),
+		},
+	}
+	if validDays, ok := guest["valid_days"].(int); ok && validDays > 0 {
+		request.GuestAccessInfo.ValidDays = &validDays
+	}
+	return &request
+}
+
+// guestUsersSourceItem reads a guest user from a source record.
+func guestUsersSourceItem(record map[string]string) (map[string]interface{}, error) {
+	for _, key := range []string{"name", "guest_type", "portal_id", "location"} {
+		if record[key] == "" {
+			return nil, fmt.Errorf("%s is empty", key)
+		}
+	}
+	validDays := 0
+	if record["valid_days"] != "" {
+		days, err := strconv.Atoi(record["valid_days"])
+		if err != nil {
+			return nil, fmt.Errorf("valid_days: %v", err)
+		}
+		validDays = days
+	}
+	item := map[string]interface{}{"valid_days": validDays}
+	for key, value := range record {
+		if key != "valid_days" {
+			item[key] = value
+		}
+	}
+	return item, nil
+}
@@ -0,0 +1,85 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCreateGuestUserBulkRequestGuests(t *testing.T) {
+	var sent map[string]ersBulkRequestBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ers/config/guestuser":
+			w.Write([]byte(`{"SearchResult": {"total": 2, "resources": [{"id": "id-bob", "name": "bob"}, {"id": "id-carol", "name": "carol"}]}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/ers/config/guestuser/bulk/submit":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("err: %s", err)
+			}
+			w.Header().Set("Location", "https://ise/ers/config/guestuser/bulk/bulk1")
+			w.WriteHeader(http.StatusAccepted)
+		case r.URL.Path == "/ers/config/guestuser/bulk/bulk1":
+			w.Write([]byte(`{"BulkStatus": {"bulkId": "bulk1", "executionStatus": "COMPLETED", "resourcesStatus": [{"id": "id-bob", "name": "bob", "resourceExecutionStatus": "SUCCESS"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	clientConfig.Config.UseAPIGateway = "true"
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	d := schema.TestResourceDataRaw(t, resourceGuestUserBulkRequest().Schema, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"operation_type": "update"}},
+		"guest": []interface{}{
+			map[string]interface{}{"name": "bob", "guest_type": "Daily", "portal_id": "1", "location": "San Jose", "valid_days": 2},
+		},
+	})
+	if diags := resourceGuestUserBulkRequestCreate(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	request := sent["GuestUserBulkRequest"]
+	if request.OperationType != "update" || request.ResourceMediaType != guestUserBulkType.mediaType || len(request.ResourcesList) != 1 {
+		t.Fatalf("bad: unexpected request %+v", request)
+	}
+	guest := request.ResourcesList[0].(map[string]interface{})["GuestUser"].(map[string]interface{})
+	if guest["id"] != "id-bob" || guest["name"] != "bob" {
+		t.Errorf("bad: expect the guest user found by name, got %v", guest)
+	}
+	if d.Get("bulk_id") != "bulk1" || d.Get("execution_status") != "COMPLETED" || d.Id() == "" {
+		t.Errorf("bad: expect the bulk results, got %s %s", d.Get("bulk_id"), d.Get("execution_status"))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceGuestUserBulkRequest().Schema, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"operation_type": "delete"}},
+		"guest": []interface{}{
+			map[string]interface{}{"name": "carol", "guest_type": "Daily", "portal_id": "1", "location": "San Jose"},
+		},
+	})
+	if diags := resourceGuestUserBulkRequestCreate(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if request := sent["GuestUserBulkRequest"]; !reflect.DeepEqual(request.IDList, []string{"id-carol"}) || len(request.ResourcesList) != 0 {
+		t.Errorf("bad: expect a delete by ID, got %+v", request)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceGuestUserBulkRequest().Schema, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"operation_type": "update"}},
+		"guest": []interface{}{
+			map[string]interface{}{"name": "dave", "guest_type": "Daily", "portal_id": "1", "location": "San Jose"},
+		},
+	})
+	if diags := resourceGuestUserBulkRequestCreate(ctx, d, clientConfig); !diags.HasError() {
+		t.Errorf("bad: expect an error for a guest user not found")
+	}
+}
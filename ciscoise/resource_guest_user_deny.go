@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,8 +49,8 @@ func resourceGuestUserDeny() *schema.Resource {
 }
 
 func resourceGuestUserDenyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DenyGuestUserByID create")
-	log.Printf("[DEBUG] Missing DenyGuestUserByID create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DenyGuestUserByID create")
+	logDebugf(ctx, "Missing DenyGuestUserByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,7 +66,7 @@ func resourceGuestUserDenyCreate(ctx context.Context, d *schema.ResourceData, m
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -88,8 +86,8 @@ func resourceGuestUserDenyRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceGuestUserDenyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserDeny delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserDeny delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserDeny delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserDeny delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
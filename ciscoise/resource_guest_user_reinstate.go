@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -58,8 +56,8 @@ func resourceGuestUserReinstate() *schema.Resource {
 }
 
 func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ReinstateGuestUserByName create")
-	log.Printf("[DEBUG] Missing ReinstateGuestUserByName create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ReinstateGuestUserByName create")
+	logDebugf(ctx, "Missing ReinstateGuestUserByName create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,20 +66,20 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 	vID, okID := d.GetOk("parameters.0.id")
 
 	method1 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: ReinstateGuestUserByName")
+		logDebugf(ctx, "Selected method: ReinstateGuestUserByName")
 		vvName := vName.(string)
 
 		response1, err := client.GuestUser.ReinstateGuestUserByName(vvName)
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing ReinstateGuestUserByName", err, response1.String(),
 					"Failure at ReinstateGuestUserByName, unexpected response", ""))
@@ -93,7 +91,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -107,7 +105,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: ReinstateGuestUserByID")
+		logDebugf(ctx, "Selected method: ReinstateGuestUserByID")
 		vvID := vID.(string)
 
 		response2, err := client.GuestUser.ReinstateGuestUserByID(vvID)
@@ -119,7 +117,7 @@ func resourceGuestUserReinstateCreate(ctx context.Context, d *schema.ResourceDat
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response2.String())
+		logDebugf(ctx, "Retrieved response %s", response2.String())
 
 		if err := d.Set("item", response2.String()); err != nil {
 			diags = append(diags, diagError(
@@ -144,8 +142,8 @@ func resourceGuestUserReinstateRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGuestUserReinstateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserReinstate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserReinstate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserReinstate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserReinstate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -74,8 +72,8 @@ func resourceGuestUserResetPassword() *schema.Resource {
 }
 
 func resourceGuestUserResetPasswordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetGuestUserPasswordByID create")
-	log.Printf("[DEBUG] Missing ResetGuestUserPasswordByID create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetGuestUserPasswordByID create")
+	logDebugf(ctx, "Missing ResetGuestUserPasswordByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -86,14 +84,14 @@ func resourceGuestUserResetPasswordCreate(ctx context.Context, d *schema.Resourc
 	response1, restyResp1, err := client.GuestUser.ResetGuestUserPasswordByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetGuestUserPasswordByID", err,
 			"Failure at ResetGuestUserPasswordByID, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenGuestUserResetGuestUserPasswordByIDItem(response1.OperationResult)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -113,9 +111,9 @@ func resourceGuestUserResetPasswordRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGuestUserResetPasswordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserResetPassword delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserResetPassword delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserResetPassword delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserResetPassword delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -82,8 +80,8 @@ func resourceGuestUserSuspend() *schema.Resource {
 }
 
 func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SuspendGuestUserByName create")
-	log.Printf("[DEBUG] Missing SuspendGuestUserByName create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning SuspendGuestUserByName create")
+	logDebugf(ctx, "Missing SuspendGuestUserByName create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -92,20 +90,20 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 	vID, okID := d.GetOk("parameters.0.id")
 
 	method1 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: SuspendGuestUserByName")
+		logDebugf(ctx, "Selected method: SuspendGuestUserByName")
 		vvName := vName.(string)
 
 		response1, err := client.GuestUser.SuspendGuestUserByName(vvName)
 
 		if err != nil || response1 == nil {
 			if response1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+				logDebugf(ctx, "Retrieved error response %s", response1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SuspendGuestUserByName", err, response1.String(),
 					"Failure at SuspendGuestUserByName, unexpected response", ""))
@@ -117,7 +115,7 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response1.String())
+		logDebugf(ctx, "Retrieved response %s", response1.String())
 
 		if err := d.Set("item", response1.String()); err != nil {
 			diags = append(diags, diagError(
@@ -130,14 +128,14 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: SuspendGuestUserByID")
+		logDebugf(ctx, "Selected method: SuspendGuestUserByID")
 		vvID := vID.(string)
 		request2 := expandRequestGuestUserSuspendSuspendGuestUserByID(ctx, "parameters.0", d)
 
 		response2, err := client.GuestUser.SuspendGuestUserByID(vvID, request2)
 
 		if request2 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request2))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request2))
 		}
 
 		if err != nil || response2 == nil {
@@ -147,7 +145,7 @@ func resourceGuestUserSuspendCreate(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %s", response2.String())
+		logDebugf(ctx, "Retrieved response %s", response2.String())
 
 		if err := d.Set("item", response2.String()); err != nil {
 			diags = append(diags, diagError(
@@ -168,9 +166,9 @@ func resourceGuestUserSuspendRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGuestUserSuspendDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GuestUserSuspend delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning GuestUserSuspend delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing GuestUserSuspend delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing GuestUserSuspend delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
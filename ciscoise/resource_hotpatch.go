@@ -2,10 +2,8 @@ package ciscoise
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-	"time"
-
-	"log"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
@@ -30,12 +28,10 @@ unavailable for a certain period of time.
 		ReadContext:   resourceHotpatchRead,
 		UpdateContext: resourceHotpatchUpdate,
 		DeleteContext: resourceHotpatchDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceHotpatchRead, "", "hotpatch_name"),
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(HOTPATCH_INSTALL_TIMEOUT_SLEEP),
-			Delete: schema.DefaultTimeout(HOTPATCH_ROLLBACK_TIMEOUT_SLEEP),
+			Create: schema.DefaultTimeout(HOTPATCH_INSTALL_TIMEOUT),
+			Delete: schema.DefaultTimeout(HOTPATCH_ROLLBACK_TIMEOUT),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,7 +81,7 @@ unavailable for a certain period of time.
 }
 
 func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Hotpatch create")
+	logDebugf(ctx, "Beginning Hotpatch create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -118,13 +114,13 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 	request1 := expandRequestHotpatchInstallInstallHotpatch(ctx, "parameters.0", d)
 	response1, restyResp1, err := client.Patching.InstallHotpatch(request1)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing InstallPatch", err,
@@ -137,31 +133,26 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", restyResp1.String())
+	logDebugf(ctx, "Retrieved response %s", restyResp1.String())
 
+	taskID := ""
 	if response1.Response != nil {
-		taskID := response1.Response.ID
-		if taskID != "" {
-			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
-			if err != nil || taskResponse == nil {
-				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-				}
-				diags = append(diags, diagErrorWithAlt(
-					"Failure when executing GetTaskStatusByID", err,
-					"Failure at GetTaskStatusByID, unexpected response", ""))
-				return diags
-			}
-			for err != nil || taskResponse == nil || taskResponse.ExecutionStatus == "IN_PROGRESS" {
-				time.Sleep(HOTPATCH_INSTALL_TIMEOUT_SLEEP)
-				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
-				if err != nil || taskResponse == nil {
-					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-					}
-				}
-			}
+		taskID = response1.Response.ID
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	errWait := patchWaiter.wait(waitCtx, fmt.Sprintf("hotpatch %s installation", vvHotpatchName), patchCheck(ctx, client, taskID, true, func() (bool, error) {
+		getResponse, _, err := client.Patching.ListInstalledHotpatches()
+		if err != nil {
+			return false, err
 		}
+		item, err := searchHotPatch(m, getResponse, vvHotpatchName)
+		return item != nil, err
+	}))
+	if errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for InstallHotpatch", errWait))
+		return diags
 	}
 
 	resourceMap := make(map[string]string)
@@ -172,7 +163,7 @@ func resourceHotpatchCreate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Hotpatch read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Hotpatch read for id=[%s]", d.Id())
 
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
@@ -186,12 +177,12 @@ func resourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m interfa
 	response1, restyResp1, err := client.Patching.ListInstalledHotpatches()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	item1, err := searchHotPatch(m, response1, vvHotpatchName)
 	if err != nil || item1 == nil {
 		d.SetId("")
@@ -215,14 +206,14 @@ func resourceHotpatchRead(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func resourceHotpatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Hotpatch update for id=[%s]", d.Id())
-	log.Printf("[DEBUG] Missing Hotpatch update on Cisco ISE. It will only be update it on Terraform")
+	logDebugf(ctx, "Beginning Hotpatch update for id=[%s]", d.Id())
+	logDebugf(ctx, "Missing Hotpatch update on Cisco ISE. It will only be update it on Terraform")
 	// _ = d.Set("last_updated", getUnixTimeString())
 	return resourceHotpatchRead(ctx, d, m)
 }
 
 func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Hotpatch delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Hotpatch delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -235,13 +226,13 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 	getResp1, restyResp1, err := client.Patching.ListInstalledHotpatches()
 	if err != nil || getResp1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*getResp1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*getResp1))
 	item1, err := searchHotPatch(m, getResp1, vvHotpatchName)
 	if err != nil || item1 == nil {
 		d.SetId("")
@@ -253,12 +244,12 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 	response1, restyResp1, err := client.Patching.RollbackHotpatch(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing RollbackPatch", err,
@@ -266,31 +257,26 @@ func resourceHotpatchDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
+	taskID := ""
 	if response1.Response != nil {
-		taskID := response1.Response.ID
-		if taskID != "" {
-			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
-			if err != nil || taskResponse == nil {
-				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-				}
-				diags = append(diags, diagErrorWithAlt(
-					"Failure when executing GetTaskStatusByID", err,
-					"Failure at GetTaskStatusByID, unexpected response", ""))
-				return diags
-			}
-			for err != nil || taskResponse == nil || taskResponse.ExecutionStatus == "IN_PROGRESS" {
-				time.Sleep(HOTPATCH_ROLLBACK_TIMEOUT_SLEEP)
-				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
-				if err != nil || taskResponse == nil {
-					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-					}
-				}
-			}
+		taskID = response1.Response.ID
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	errWait := patchWaiter.wait(waitCtx, fmt.Sprintf("hotpatch %s rollback", vvHotpatchName), patchCheck(ctx, client, taskID, false, func() (bool, error) {
+		getResponse, _, err := client.Patching.ListInstalledHotpatches()
+		if err != nil {
+			return false, err
 		}
+		item, err := searchHotPatch(m, getResponse, vvHotpatchName)
+		return item != nil, err
+	}))
+	if errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for RollbackHotpatch", errWait))
+		return diags
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
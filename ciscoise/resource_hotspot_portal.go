@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceHotspotPortal() *schema.Resource {
 		ReadContext:   resourceHotspotPortalRead,
 		UpdateContext: resourceHotspotPortalUpdate,
 		DeleteContext: resourceHotspotPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceHotspotPortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1078,50 +1074,22 @@ Allowed values:
 }
 
 func resourceHotspotPortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning HotspotPortal create")
+	logDebugf(ctx, "Beginning HotspotPortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestHotspotPortalCreateHotspotPortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.HotspotPortal.GetHotspotPortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceHotspotPortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetHotspotPortalQueryParams{}
-
-			response2, _, err := client.HotspotPortal.GetHotspotPortal(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsHotspotPortalGetHotspotPortal(m, response2, &queryParams2)
-				item2, err := searchHotspotPortalGetHotspotPortal(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceHotspotPortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.HotspotPortal.CreateHotspotPortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1145,7 +1113,7 @@ func resourceHotspotPortalCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning HotspotPortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning HotspotPortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1158,28 +1126,28 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetHotspotPortal")
+		logDebugf(ctx, "Selected method: GetHotspotPortal")
 		queryParams1 := isegosdk.GetHotspotPortalQueryParams{}
 
 		response1, restyResp1, err := client.HotspotPortal.GetHotspotPortal(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsHotspotPortalGetHotspotPortal(m, response1, &queryParams1)
 		item1, err := searchHotspotPortalGetHotspotPortal(m, items1, vvName, vvID)
@@ -1194,7 +1162,7 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetHotspotPortal search response",
 				err))
@@ -1203,20 +1171,20 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetHotspotPortalByID")
+		logDebugf(ctx, "Selected method: GetHotspotPortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.HotspotPortal.GetHotspotPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenHotspotPortalGetHotspotPortalByIDItem(response2.HotspotPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1225,7 +1193,7 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetHotspotPortalByID response",
 				err))
@@ -1238,7 +1206,7 @@ func resourceHotspotPortalRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceHotspotPortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning HotspotPortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning HotspotPortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1250,9 +1218,9 @@ func resourceHotspotPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1276,15 +1244,15 @@ func resourceHotspotPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestHotspotPortalUpdateHotspotPortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.HotspotPortal.UpdateHotspotPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateHotspotPortalByID", err, restyResp1.String(),
 					"Failure at UpdateHotspotPortalByID, unexpected response", ""))
@@ -1302,7 +1270,7 @@ func resourceHotspotPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceHotspotPortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning HotspotPortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning HotspotPortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1314,9 +1282,9 @@ func resourceHotspotPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1352,7 +1320,7 @@ func resourceHotspotPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.HotspotPortal.DeleteHotspotPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteHotspotPortalByID", err, restyResp1.String(),
 				"Failure at DeleteHotspotPortalByID, unexpected response", ""))
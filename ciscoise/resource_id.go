@@ -0,0 +1,40 @@
+package ciscoise
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Schema version of the resources whose state holds a versioned resource ID.
+const RESOURCE_ID_SCHEMA_VERSION = 1
+
+// upgradeResourceIDs adds to every resource a state upgrader rewriting the
+// legacy key:=value IDs as versioned IDs. The schema is unchanged, so the
+// prior state has the type of the current schema.
+func upgradeResourceIDs(p *schema.Provider) {
+	for _, r := range p.ResourcesMap {
+		if r.SchemaVersion != 0 || len(r.StateUpgraders) > 0 {
+			continue
+		}
+		r.SchemaVersion = RESOURCE_ID_SCHEMA_VERSION
+		r.StateUpgraders = []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    r.CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeResourceIDV0,
+			},
+		}
+	}
+}
+
+func upgradeResourceIDV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	if id, ok := rawState["id"].(string); ok && isLegacyResourceID(id) {
+		rawState["id"] = joinResourceID(separateResourceID(id))
+		logDebugf(ctx, "Upgraded resource ID %s to %s", id, rawState["id"])
+	}
+	return rawState, nil
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,9 +27,7 @@ Partial update is not supported
 		ReadContext:   resourceIDStoreSequenceRead,
 		UpdateContext: resourceIDStoreSequenceUpdate,
 		DeleteContext: resourceIDStoreSequenceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceIDStoreSequenceRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -207,45 +203,22 @@ Partial update is not supported
 }
 
 func resourceIDStoreSequenceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDStoreSequence create")
+	logDebugf(ctx, "Beginning IDStoreSequence create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestIDStoreSequenceCreateIDentitySequence(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.IDentitySequence.GetIDentitySequenceByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceIDStoreSequenceRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.IDentitySequence.GetIDentitySequenceByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.IDStoreSequence.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceIDStoreSequenceRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.IDentitySequence.CreateIDentitySequence(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -269,7 +242,7 @@ func resourceIDStoreSequenceCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDStoreSequence read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDStoreSequence read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -281,26 +254,26 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentitySequenceByName")
+		logDebugf(ctx, "Selected method: GetIDentitySequenceByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.IDentitySequence.GetIDentitySequenceByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenIDentitySequenceGetIDentitySequenceByNameItemName(response1.IDStoreSequence)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -309,7 +282,7 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentitySequenceByName response",
 				err))
@@ -319,20 +292,20 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentitySequenceByID")
+		logDebugf(ctx, "Selected method: GetIDentitySequenceByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.IDentitySequence.GetIDentitySequenceByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenIDentitySequenceGetIDentitySequenceByIDItemID(response2.IDStoreSequence)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -341,7 +314,7 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentitySequenceByID response",
 				err))
@@ -354,7 +327,7 @@ func resourceIDStoreSequenceRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceIDStoreSequenceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDStoreSequence update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDStoreSequence update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -366,9 +339,9 @@ func resourceIDStoreSequenceUpdate(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -391,15 +364,15 @@ func resourceIDStoreSequenceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestIDStoreSequenceUpdateIDentitySequenceByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.IDentitySequence.UpdateIDentitySequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIDentitySequenceByID", err, restyResp1.String(),
 					"Failure at UpdateIDentitySequenceByID, unexpected response", ""))
@@ -417,7 +390,7 @@ func resourceIDStoreSequenceUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIDStoreSequenceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDStoreSequence delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDStoreSequence delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -429,9 +402,9 @@ func resourceIDStoreSequenceDelete(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -459,7 +432,7 @@ func resourceIDStoreSequenceDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.IDentitySequence.DeleteIDentitySequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIDentitySequenceByID", err, restyResp1.String(),
 				"Failure at DeleteIDentitySequenceByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -25,9 +23,7 @@ func resourceIDentityGroup() *schema.Resource {
 		ReadContext:   resourceIDentityGroupRead,
 		UpdateContext: resourceIDentityGroupUpdate,
 		DeleteContext: resourceIDentityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceIDentityGroupRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -142,45 +138,22 @@ func resourceIDentityGroup() *schema.Resource {
 }
 
 func resourceIDentityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDentityGroup create")
+	logDebugf(ctx, "Beginning IDentityGroup create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestIDentityGroupCreateIDentityGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.IDentityGroups.GetIDentityGroupByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceIDentityGroupRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.IDentityGroups.GetIDentityGroupByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.IDentityGroup.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceIDentityGroupRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.IDentityGroups.CreateIDentityGroup(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -204,7 +177,7 @@ func resourceIDentityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDentityGroup read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDentityGroup read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -216,26 +189,26 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIDentityGroupByName")
+		logDebugf(ctx, "Selected method: GetIDentityGroupByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.IDentityGroups.GetIDentityGroupByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenIDentityGroupsGetIDentityGroupByNameItemName(response1.IDentityGroup)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -244,7 +217,7 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentityGroupByName response",
 				err))
@@ -254,20 +227,20 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIDentityGroupByID")
+		logDebugf(ctx, "Selected method: GetIDentityGroupByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.IDentityGroups.GetIDentityGroupByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenIDentityGroupsGetIDentityGroupByIDItemID(response2.IDentityGroup)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -276,7 +249,7 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIDentityGroupByID response",
 				err))
@@ -289,7 +262,7 @@ func resourceIDentityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceIDentityGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDentityGroup update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDentityGroup update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -301,9 +274,9 @@ func resourceIDentityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -326,15 +299,15 @@ func resourceIDentityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestIDentityGroupUpdateIDentityGroupByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.IDentityGroups.UpdateIDentityGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIDentityGroupByID", err, restyResp1.String(),
 					"Failure at UpdateIDentityGroupByID, unexpected response", ""))
@@ -352,9 +325,9 @@ func resourceIDentityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceIDentityGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IDentityGroup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IDentityGroup delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing IDentityGroup delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing IDentityGroup delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestIDentityGroupCreateIDentityGroup(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestIDentityGroupsCreateIDentityGroup {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -31,9 +29,7 @@ func resourceInternalUser() *schema.Resource {
 		ReadContext:   resourceInternalUserRead,
 		UpdateContext: resourceInternalUserUpdate,
 		DeleteContext: resourceInternalUserDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceInternalUserRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -279,45 +275,22 @@ The values are case sensitive. For example, '[ERSObjectURL]?filter=enabled.EQ.En
 }
 
 func resourceInternalUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning InternalUser create")
+	logDebugf(ctx, "Beginning InternalUser create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestInternalUserCreateInternalUser(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.InternalUser.GetInternalUserByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceInternalUserRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.InternalUser.GetInternalUserByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.InternalUser.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceInternalUserRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.InternalUser.CreateInternalUser(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -341,7 +314,7 @@ func resourceInternalUserCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning InternalUser read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning InternalUser read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -353,26 +326,26 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetInternalUserByName")
+		logDebugf(ctx, "Selected method: GetInternalUserByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.InternalUser.GetInternalUserByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenInternalUserGetInternalUserByNameItemName(response1.InternalUser)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -381,7 +354,7 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetInternalUserByName response",
 				err))
@@ -391,20 +364,20 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetInternalUserByID")
+		logDebugf(ctx, "Selected method: GetInternalUserByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.InternalUser.GetInternalUserByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenInternalUserGetInternalUserByIDItemID(response2.InternalUser)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -413,7 +386,7 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetInternalUserByID response",
 				err))
@@ -426,7 +399,7 @@ func resourceInternalUserRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceInternalUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning InternalUser update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning InternalUser update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -438,9 +411,9 @@ func resourceInternalUserUpdate(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -463,15 +436,15 @@ func resourceInternalUserUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestInternalUserUpdateInternalUserByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.InternalUser.UpdateInternalUserByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateInternalUserByID", err, restyResp1.String(),
 					"Failure at UpdateInternalUserByID, unexpected response", ""))
@@ -489,7 +462,7 @@ func resourceInternalUserUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceInternalUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning InternalUser delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning InternalUser delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -501,9 +474,9 @@ func resourceInternalUserDelete(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -531,7 +504,7 @@ func resourceInternalUserDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.InternalUser.DeleteInternalUserByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteInternalUserByID", err, restyResp1.String(),
 				"Failure at DeleteInternalUserByID, unexpected response", ""))
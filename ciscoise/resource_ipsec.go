@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -458,9 +456,7 @@ DH Groups 1, 2, and 5 cannot be chosen for Phase-one and Phase-two fields.
 		ReadContext:   resourceIPsecRead,
 		UpdateContext: resourceIPsecUpdate,
 		DeleteContext: resourceIPsec2Delete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByPath(resourceIPsecRead, []string{"host_name", "nad_ip"}, ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -768,47 +764,19 @@ DH Groups 1, 2, and 5 cannot be chosen for Phase-one and Phase-two fields.
 func resourceIPsecCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestIPsecCreateIPsecConnection(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vHostName, okHostName := resourceItem["host_name"]
+	vHostName := resourceItem["host_name"]
 	vvHostName := interfaceToString(vHostName)
-	vNadIP, okNadIP := resourceItem["nad_ip"]
+	vNadIP := resourceItem["nad_ip"]
 	vvNadIP := interfaceToString(vNadIP)
-	if isEnableAutoImport {
-		if okHostName && vvHostName != "" && okNadIP && vvNadIP != "" {
-			getResponse2, _, err := client.NativeIPsec.GetIPsecNode(vvHostName, vvNadIP)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["host_name"] = vvHostName
-				resourceMap["nad_ip"] = vvNadIP
-				d.SetId(joinResourceID(resourceMap))
-				return resourceIPsecRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetIPsecEnabledNodesQueryParams{}
-
-			response2, _, err := client.NativeIPsec.GetIPsecEnabledNodes(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNativeIPsecGetIPsecEnabledNodes(m, response2, &queryParams2)
-				item2, err := searchNativeIPsecGetIPsecEnabledNodes(m, items2, vvHostName, vvNadIP)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["host_name"] = vvHostName
-					resourceMap["nad_ip"] = vvNadIP
-					d.SetId(joinResourceID(resourceMap))
-					return resourceIPsecRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NativeIPsec.CreateIPsecConnection(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -847,26 +815,26 @@ func resourceIPsecRead(ctx context.Context, d *schema.ResourceData, m interface{
 	vvNadIP := vNadIP
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName, okNadIP}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIPsecEnabledNodes")
+		logDebugf(ctx, "Selected method: GetIPsecEnabledNodes")
 		queryParams1 := isegosdk.GetIPsecEnabledNodesQueryParams{}
 
 		response1, restyResp1, err := client.NativeIPsec.GetIPsecEnabledNodes(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNativeIPsecGetIPsecEnabledNodes(m, response1, &queryParams1)
 		item1, err := searchNativeIPsecGetIPsecEnabledNodes(m, items1, vvHostName, vvNadIP)
@@ -882,7 +850,7 @@ func resourceIPsecRead(ctx context.Context, d *schema.ResourceData, m interface{
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPsecEnabledNodes search response",
 				err))
@@ -891,19 +859,19 @@ func resourceIPsecRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetIPsecNode")
+		logDebugf(ctx, "Selected method: GetIPsecNode")
 
 		response2, restyResp2, err := client.NativeIPsec.GetIPsecNode(vvHostName, vvNadIP)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNativeIPsecGetIPsecNodeItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -912,7 +880,7 @@ func resourceIPsecRead(ctx context.Context, d *schema.ResourceData, m interface{
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPsecNode response",
 				err))
@@ -939,11 +907,11 @@ func resourceIPsecUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] Name used for update operation %s, %s", vvHostName, vvNadIP)
+		logDebugf(ctx, "Name used for update operation %s, %s", vvHostName, vvNadIP)
 
 		request1 := expandRequestIPsecUpdateIPsecConnectionConfig(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, restyResp1, err := client.NativeIPsec.UpdateIPsecConnectionConfig(request1)
 
@@ -951,7 +919,7 @@ func resourceIPsecUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 			if restyResp1 != nil {
 
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 
 				diags = append(diags, diagErrorWithAltAndResponse(
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,8 +21,12 @@ be used to track the status.
 		CreateContext: resourceIseRootCaRegenerateCreate,
 		ReadContext:   resourceIseRootCaRegenerateRead,
 		DeleteContext: resourceIseRootCaRegenerateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": waitForTaskSchema(),
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -92,8 +94,8 @@ be used to track the status.
 }
 
 func resourceIseRootCaRegenerateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RegenerateIseRootCa create")
-	log.Printf("[DEBUG] Missing RegenerateIseRootCa create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning RegenerateIseRootCa create")
+	logDebugf(ctx, "Missing RegenerateIseRootCa create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -101,12 +103,12 @@ func resourceIseRootCaRegenerateCreate(ctx context.Context, d *schema.ResourceDa
 
 	request1 := expandRequestIseRootCaRegenerateRegenerateIseRootCa(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.RegenerateIseRootCa(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RegenerateIseRootCa", err, restyResp1.String(),
 				"Failure at RegenerateIseRootCa, unexpected response", ""))
@@ -118,7 +120,7 @@ func resourceIseRootCaRegenerateCreate(ctx context.Context, d *schema.ResourceDa
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesRegenerateIseRootCaItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -127,6 +129,13 @@ func resourceIseRootCaRegenerateCreate(ctx context.Context, d *schema.ResourceDa
 			err))
 		return diags
 	}
+	if d.Get("wait_for_completion").(bool) && response1.Response != nil {
+		taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if diags := waitForTask(taskCtx, client, response1.Response.ID, "RegenerateIseRootCa"); diags.HasError() {
+			return diags
+		}
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
@@ -139,9 +148,9 @@ func resourceIseRootCaRegenerateRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceIseRootCaRegenerateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning IseRootCaRegenerate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning IseRootCaRegenerate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing IseRootCaRegenerate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing IseRootCaRegenerate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestIseRootCaRegenerateRegenerateIseRootCa(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestCertificatesRegenerateIseRootCa {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceLdap() *schema.Resource {
 		ReadContext:   resourceLdapRead,
 		UpdateContext: resourceLdapUpdate,
 		DeleteContext: resourceLdapDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceLdapRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1126,50 +1122,22 @@ func resourceLdap() *schema.Resource {
 }
 
 func resourceLdapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LDAP create")
+	logDebugf(ctx, "Beginning LDAP create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestLdapPostLdap(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.Ldap.GetLdapid(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceLdapRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetLdapQueryParams{}
-
-			response2, _, err := client.Ldap.GetLdap(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsLdapGetLdap(m, response2, &queryParams2)
-				item2, err := searchLdapGetLdap(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceLdapRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.Ldap.PostLdap(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -1203,26 +1171,26 @@ func resourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	vvName := vName
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetLdap")
+		logDebugf(ctx, "Selected method: GetLdap")
 		queryParams1 := isegosdk.GetLdapQueryParams{}
 
 		response1, restyResp1, err := client.Ldap.GetLdap(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsLdapGetLdap(m, response1, &queryParams1)
 		item1, err := searchLdapGetLdap(m, items1, vvName, vvID)
@@ -1238,7 +1206,7 @@ func resourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface{}
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetLdap search response",
 				err))
@@ -1247,19 +1215,19 @@ func resourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetLdapid")
+		logDebugf(ctx, "Selected method: GetLdapid")
 
 		response2, restyResp2, err := client.Ldap.GetLdapid(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenLdapGetLdapidItem(response2.ERSLdap)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1268,7 +1236,7 @@ func resourceLdapRead(ctx context.Context, d *schema.ResourceData, m interface{}
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetLdapid response",
 				err))
@@ -1294,14 +1262,14 @@ func resourceLdapUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	var vvID string
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Update selected method: GetLdap")
+		logDebugf(ctx, "Update selected method: GetLdap")
 		queryParams1 := isegosdk.GetLdapQueryParams{}
 		response1, _, err := client.Ldap.GetLdap(&queryParams1)
 		if err == nil && response1 != nil {
@@ -1320,13 +1288,13 @@ func resourceLdapUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestLdapPutLdapid(ctx, "parameters.0", d)
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		response1, restyResp1, err := client.Ldap.PutLdapid(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing PutLdapid", err, restyResp1.String(),
 					"Failure at PutLdapid, unexpected response", ""))
@@ -1342,7 +1310,7 @@ func resourceLdapUpdate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceLdapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LDAP delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning LDAP delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1356,14 +1324,14 @@ func resourceLdapDelete(ctx context.Context, d *schema.ResourceData, m interface
 	var vvID string
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Update selected method: GetLdap")
+		logDebugf(ctx, "Update selected method: GetLdap")
 		queryParams1 := isegosdk.GetLdapQueryParams{}
 		response1, _, err := client.Ldap.GetLdap(&queryParams1)
 		if err != nil || response1 == nil {
@@ -1394,7 +1362,7 @@ func resourceLdapDelete(ctx context.Context, d *schema.ResourceData, m interface
 	response1, restyResp1, err := client.Ldap.DeleteLdapid(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteLdapid", err, restyResp1.String(),
 				"Failure at DeleteLdapid, unexpected response", ""))
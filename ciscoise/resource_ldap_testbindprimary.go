@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -95,12 +93,12 @@ func resourceLdapTestbindprimaryCreate(ctx context.Context, d *schema.ResourceDa
 	response1, restyResp1, err := client.Ldap.PutLdapidTestbindprimary(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenLdapPutLdapidTestbindprimaryItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
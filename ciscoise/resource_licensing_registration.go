@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -115,7 +113,7 @@ func resourceLicensingRegistration() *schema.Resource {
 }
 
 func resourceLicensingRegistrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LicenseRegistration create")
+	logDebugf(ctx, "Beginning LicenseRegistration create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -124,7 +122,7 @@ func resourceLicensingRegistrationCreate(ctx context.Context, d *schema.Resource
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestLicensingRegistrationCreateCreateRegistrationInfo(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	restyResp1, err := client.Licensing.CreateRegistrationInfo(request1)
@@ -148,7 +146,7 @@ func resourceLicensingRegistrationCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceLicensingRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LicenseRegistration read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning LicenseRegistration read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -158,13 +156,13 @@ func resourceLicensingRegistrationRead(ctx context.Context, d *schema.ResourceDa
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenLicensingGetRegistrationInfoItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -173,7 +171,7 @@ func resourceLicensingRegistrationRead(ctx context.Context, d *schema.ResourceDa
 			err))
 		return diags
 	}
-	if err := d.Set("parameters", vItem1); err != nil {
+	if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting GetRegistrationInfo response",
 			err))
@@ -183,7 +181,7 @@ func resourceLicensingRegistrationRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceLicensingRegistrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LicenseRegistration update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning LicenseRegistration update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -192,7 +190,7 @@ func resourceLicensingRegistrationUpdate(ctx context.Context, d *schema.Resource
 	if d.HasChange("parameters") {
 		request1 := expandRequestLicensingRegistrationCreateCreateRegistrationInfo(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		restyResp1, err := client.Licensing.CreateRegistrationInfo(request1)
@@ -212,9 +210,9 @@ func resourceLicensingRegistrationUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceLicensingRegistrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LicenseRegistration delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning LicenseRegistration delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing LicenseRegistration delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing LicenseRegistration delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceLicensingTierState() *schema.Resource {
 		ReadContext:   resourceLicensingTierStateRead,
 		UpdateContext: resourceLicensingTierStateUpdate,
 		DeleteContext: resourceLicensingTierStateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceLicensingTierStateRead, "", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -124,7 +120,7 @@ func resourceLicensingTierState() *schema.Resource {
 }
 
 func resourceLicensingTierStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TierState create")
+	logDebugf(ctx, "Beginning TierState create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -133,7 +129,7 @@ func resourceLicensingTierStateCreate(ctx context.Context, d *schema.ResourceDat
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestLicensingTierStateCreateUpdateTierStateInfo(ctx, "", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	response1, restyResp1, err := client.Licensing.UpdateTierStateInfo(request1)
@@ -154,7 +150,7 @@ func resourceLicensingTierStateCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceLicensingTierStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TierState read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TierState read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -168,13 +164,13 @@ func resourceLicensingTierStateRead(ctx context.Context, d *schema.ResourceData,
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	response_nodes, err := searchLicensingGetTierStateInfo(m, response1, vName)
 	if err != nil || response_nodes == nil || len(*response_nodes) == 0 {
@@ -193,7 +189,7 @@ func resourceLicensingTierStateRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceLicensingTierStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TierState update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TierState update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -202,7 +198,7 @@ func resourceLicensingTierStateUpdate(ctx context.Context, d *schema.ResourceDat
 	if d.HasChange("parameters") {
 		request1 := expandRequestLicensingTierStateCreateUpdateTierStateInfo(ctx, "", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		response1, restyResp1, err := client.Licensing.UpdateTierStateInfo(request1)
@@ -222,9 +218,9 @@ func resourceLicensingTierStateUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceLicensingTierStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TierState delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TierState delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TierState delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TierState delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
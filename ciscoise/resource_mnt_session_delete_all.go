@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,8 +42,8 @@ func resourceMntSessionDeleteAll() *schema.Resource {
 }
 
 func resourceMntSessionDeleteAllCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeleteAllSessions create")
-	log.Printf("[DEBUG] Missing DeleteAllSessions create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeleteAllSessions create")
+	logDebugf(ctx, "Missing DeleteAllSessions create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -53,7 +51,7 @@ func resourceMntSessionDeleteAllCreate(ctx context.Context, d *schema.ResourceDa
 	response1, err := client.Misc.DeleteAllSessions()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteAllSessions", err, response1.String(),
 				"Failure at DeleteAllSessions, unexpected response", ""))
@@ -64,7 +62,7 @@ func resourceMntSessionDeleteAllCreate(ctx context.Context, d *schema.ResourceDa
 			"Failure at DeleteAllSessions, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting DeleteAllSessions response",
@@ -83,8 +81,8 @@ func resourceMntSessionDeleteAllRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceMntSessionDeleteAllDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MntSessionDeleteAll delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning MntSessionDeleteAll delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing MntSessionDeleteAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing MntSessionDeleteAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceMyDevicePortal() *schema.Resource {
 		ReadContext:   resourceMyDevicePortalRead,
 		UpdateContext: resourceMyDevicePortalUpdate,
 		DeleteContext: resourceMyDevicePortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceMyDevicePortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1159,50 +1155,22 @@ Only valid when emptyFieldDisplay = DISPLAYWITHDEFAULTVALUE`,
 }
 
 func resourceMyDevicePortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MyDevicePortal create")
+	logDebugf(ctx, "Beginning MyDevicePortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestMyDevicePortalCreateMyDevicePortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.MyDevicePortal.GetMyDevicePortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceMyDevicePortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetMyDevicePortalQueryParams{}
-
-			response2, _, err := client.MyDevicePortal.GetMyDevicePortal(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsMyDevicePortalGetMyDevicePortal(m, response2, &queryParams2)
-				item2, err := searchMyDevicePortalGetMyDevicePortal(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceMyDevicePortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.MyDevicePortal.CreateMyDevicePortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1226,7 +1194,7 @@ func resourceMyDevicePortalCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MyDevicePortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning MyDevicePortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1239,28 +1207,28 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetMyDevicePortal")
+		logDebugf(ctx, "Selected method: GetMyDevicePortal")
 		queryParams1 := isegosdk.GetMyDevicePortalQueryParams{}
 
 		response1, restyResp1, err := client.MyDevicePortal.GetMyDevicePortal(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsMyDevicePortalGetMyDevicePortal(m, response1, &queryParams1)
 		item1, err := searchMyDevicePortalGetMyDevicePortal(m, items1, vvName, vvID)
@@ -1275,7 +1243,7 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetMyDevicePortal search response",
 				err))
@@ -1284,20 +1252,20 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetMyDevicePortalByID")
+		logDebugf(ctx, "Selected method: GetMyDevicePortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.MyDevicePortal.GetMyDevicePortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenMyDevicePortalGetMyDevicePortalByIDItem(response2.MyDevicePortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1306,7 +1274,7 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetMyDevicePortalByID response",
 				err))
@@ -1319,7 +1287,7 @@ func resourceMyDevicePortalRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceMyDevicePortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MyDevicePortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning MyDevicePortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1332,9 +1300,9 @@ func resourceMyDevicePortalUpdate(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1359,15 +1327,15 @@ func resourceMyDevicePortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestMyDevicePortalUpdateMyDevicePortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.MyDevicePortal.UpdateMyDevicePortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateMyDevicePortalByID", err, restyResp1.String(),
 					"Failure at UpdateMyDevicePortalByID, unexpected response", ""))
@@ -1385,7 +1353,7 @@ func resourceMyDevicePortalUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceMyDevicePortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MyDevicePortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning MyDevicePortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1398,9 +1366,9 @@ func resourceMyDevicePortalDelete(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1436,7 +1404,7 @@ func resourceMyDevicePortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.MyDevicePortal.DeleteMyDevicePortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteMyDevicePortalByID", err, restyResp1.String(),
 				"Failure at DeleteMyDevicePortalByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,9 +24,7 @@ func resourceNativeSupplicantProfile() *schema.Resource {
 		ReadContext:   resourceNativeSupplicantProfileRead,
 		UpdateContext: resourceNativeSupplicantProfileUpdate,
 		DeleteContext: resourceNativeSupplicantProfileDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNativeSupplicantProfileRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -216,8 +212,8 @@ Allowed values:
 }
 
 func resourceNativeSupplicantProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NativeSupplicantProfile create")
-	log.Printf("[DEBUG] Missing NativeSupplicantProfile create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning NativeSupplicantProfile create")
+	logDebugf(ctx, "Missing NativeSupplicantProfile create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -226,15 +222,15 @@ func resourceNativeSupplicantProfileCreate(ctx context.Context, d *schema.Resour
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
 	vvID := interfaceToString(resourceItem["id"])
-	log.Printf("[DEBUG] ID used for update operation %s", vvID)
+	logDebugf(ctx, "ID used for update operation %s", vvID)
 	request1 := expandRequestNativeSupplicantProfileUpdateNativeSupplicantProfileByID(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.NativeSupplicantProfile.UpdateNativeSupplicantProfileByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateNativeSupplicantProfileByID", err, restyResp1.String(),
 				"Failure at UpdateNativeSupplicantProfileByID, unexpected response", ""))
@@ -252,7 +248,7 @@ func resourceNativeSupplicantProfileCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NativeSupplicantProfile read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NativeSupplicantProfile read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -264,28 +260,28 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetNativeSupplicantProfile")
+		logDebugf(ctx, "Selected method: GetNativeSupplicantProfile")
 		queryParams1 := isegosdk.GetNativeSupplicantProfileQueryParams{}
 
 		response1, restyResp1, err := client.NativeSupplicantProfile.GetNativeSupplicantProfile(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNativeSupplicantProfileGetNativeSupplicantProfile(m, response1, &queryParams1)
 		item1, err := searchNativeSupplicantProfileGetNativeSupplicantProfile(m, items1, vvName, vvID)
@@ -300,7 +296,7 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNativeSupplicantProfile search response",
 				err))
@@ -309,20 +305,20 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNativeSupplicantProfileByID")
+		logDebugf(ctx, "Selected method: GetNativeSupplicantProfileByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.NativeSupplicantProfile.GetNativeSupplicantProfileByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNativeSupplicantProfileGetNativeSupplicantProfileByIDItem(response2.ERSNSpProfile)
 		if err := d.Set("item", vItem2); err != nil {
@@ -331,7 +327,7 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNativeSupplicantProfileByID response",
 				err))
@@ -344,7 +340,7 @@ func resourceNativeSupplicantProfileRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNativeSupplicantProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NativeSupplicantProfile update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NativeSupplicantProfile update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -356,9 +352,9 @@ func resourceNativeSupplicantProfileUpdate(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -383,15 +379,15 @@ func resourceNativeSupplicantProfileUpdate(ctx context.Context, d *schema.Resour
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNativeSupplicantProfileUpdateNativeSupplicantProfileByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NativeSupplicantProfile.UpdateNativeSupplicantProfileByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNativeSupplicantProfileByID", err, restyResp1.String(),
 					"Failure at UpdateNativeSupplicantProfileByID, unexpected response", ""))
@@ -409,7 +405,7 @@ func resourceNativeSupplicantProfileUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNativeSupplicantProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NativeSupplicantProfile delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NativeSupplicantProfile delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -421,9 +417,9 @@ func resourceNativeSupplicantProfileDelete(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -459,7 +455,7 @@ func resourceNativeSupplicantProfileDelete(ctx context.Context, d *schema.Resour
 	restyResp1, err := client.NativeSupplicantProfile.DeleteNativeSupplicantProfileByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNativeSupplicantProfileByID", err, restyResp1.String(),
 				"Failure at DeleteNativeSupplicantProfileByID, unexpected response", ""))
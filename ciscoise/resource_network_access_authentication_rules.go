@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceNetworkAccessAuthenticationRulesRead,
 		UpdateContext: resourceNetworkAccessAuthenticationRulesUpdate,
 		DeleteContext: resourceNetworkAccessAuthenticationRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceNetworkAccessAuthenticationRulesRead, networkAccessPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -722,6 +719,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": conditionExpressionSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -776,20 +774,22 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceNetworkAccessAuthenticationRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthenticationRules create")
+	logDebugf(ctx, "Beginning NetworkAccessAuthenticationRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessAuthenticationRulesCreateNetworkAccessAuthenticationRule(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
@@ -807,34 +807,8 @@ func resourceNetworkAccessAuthenticationRulesCreate(ctx context.Context, d *sche
 			vvName = interfaceToString(v)
 		}
 	}
-	if isEnableAutoImport {
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessAuthenticationRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, response2, vvPolicyID)
-				item2, err := searchNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessAuthenticationRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.NetworkAccessAuthenticationRules.CreateNetworkAccessAuthenticationRule(vvPolicyID, request1)
+	resp1 := &isegosdk.ResponseNetworkAccessAuthenticationRulesCreateNetworkAccessAuthenticationRule{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, networkAccessConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authentication", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -860,7 +834,7 @@ func resourceNetworkAccessAuthenticationRulesCreate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthenticationRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthenticationRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -888,24 +862,24 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthenticationRules")
+		logDebugf(ctx, "Selected method: GetNetworkAccessAuthenticationRules")
 		response1, restyResp1, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRules(m, items1, vvName, vvID, vvPolicyID)
@@ -920,28 +894,36 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		log.Printf("[DEBUG] Parameters %+v", responseInterfaceToString(d.Get("parameters")))
-		if err := d.Set("parameters", vItem1); err != nil {
+		logDebugf(ctx, "Parameters %+v", responseInterfaceToString(d.Get("parameters")))
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthenticationRules search response",
 				err))
 			return diags
 		}
+		if item1 != nil && item1.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0.rule.0", restyResp1, item1.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthenticationRuleByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessAuthenticationRuleByID")
 		response2, restyResp2, err := client.NetworkAccessAuthenticationRules.GetNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessAuthenticationRulesGetNetworkAccessAuthenticationRuleByIDItem(response2.Response, vID, vPolicyID)
 		if err := d.Set("item", vItem2); err != nil {
@@ -950,12 +932,20 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthenticationRuleByID response",
 				err))
 			return diags
 		}
+		if response2.Response != nil && response2.Response.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0.rule.0", restyResp2, response2.Response.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 		return diags
 
 	}
@@ -963,7 +953,7 @@ func resourceNetworkAccessAuthenticationRulesRead(ctx context.Context, d *schema
 }
 
 func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthenticationRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthenticationRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -991,9 +981,9 @@ func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *sche
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -1015,15 +1005,19 @@ func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *sche
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessAuthenticationRulesUpdateNetworkAccessAuthenticationRuleByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthenticationRules.UpdateNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID, request1)
+		response1 := &isegosdk.ResponseNetworkAccessAuthenticationRulesUpdateNetworkAccessAuthenticationRuleByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, networkAccessConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authentication/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessAuthenticationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessAuthenticationRuleByID, unexpected response", ""))
@@ -1041,7 +1035,7 @@ func resourceNetworkAccessAuthenticationRulesUpdate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessAuthenticationRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthenticationRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthenticationRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1069,9 +1063,9 @@ func resourceNetworkAccessAuthenticationRulesDelete(ctx context.Context, d *sche
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -1105,7 +1099,7 @@ func resourceNetworkAccessAuthenticationRulesDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessAuthenticationRules.DeleteNetworkAccessAuthenticationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessAuthenticationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessAuthenticationRuleByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,8 +60,8 @@ func resourceNetworkAccessAuthenticationRulesResetHitcount() *schema.Resource {
 }
 
 func resourceNetworkAccessAuthenticationRulesResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsNetworkAccessAuthenticationRules create")
-	log.Printf("[DEBUG] Missing ResetHitCountsNetworkAccessAuthenticationRules create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsNetworkAccessAuthenticationRules create")
+	logDebugf(ctx, "Missing ResetHitCountsNetworkAccessAuthenticationRules create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -75,7 +73,7 @@ func resourceNetworkAccessAuthenticationRulesResetHitcountCreate(ctx context.Con
 	response1, restyResp1, err := client.NetworkAccessAuthenticationRules.ResetHitCountsNetworkAccessAuthenticationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsNetworkAccessAuthenticationRules", err,
@@ -83,7 +81,7 @@ func resourceNetworkAccessAuthenticationRulesResetHitcountCreate(ctx context.Con
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNetworkAccessAuthenticationRulesResetHitCountsNetworkAccessAuthenticationRulesItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -92,7 +90,7 @@ func resourceNetworkAccessAuthenticationRulesResetHitcountCreate(ctx context.Con
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceNetworkAccessAuthenticationRulesResetHitcountRead(ctx, d, m)
 }
@@ -103,9 +101,9 @@ func resourceNetworkAccessAuthenticationRulesResetHitcountRead(ctx context.Conte
 }
 
 func resourceNetworkAccessAuthenticationRulesResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthenticationRulesResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthenticationRulesResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkAccessAuthenticationRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkAccessAuthenticationRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
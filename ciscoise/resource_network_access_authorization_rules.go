@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceNetworkAccessAuthorizationRulesRead,
 		UpdateContext: resourceNetworkAccessAuthorizationRulesUpdate,
 		DeleteContext: resourceNetworkAccessAuthorizationRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceNetworkAccessAuthorizationRulesRead, networkAccessPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -741,6 +738,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 											},
 										},
 									},
+									"condition_expression": conditionExpressionSchema(),
 									"default": &schema.Schema{
 										Description:      `Indicates if this rule is the default one`,
 										Type:             schema.TypeString,
@@ -802,20 +800,22 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceNetworkAccessAuthorizationRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthorizationRules create")
+	logDebugf(ctx, "Beginning NetworkAccessAuthorizationRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
@@ -834,34 +834,8 @@ func resourceNetworkAccessAuthorizationRulesCreate(ctx context.Context, d *schem
 		}
 	}
 
-	if isEnableAutoImport {
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessAuthorizationRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, response2, vvPolicyID)
-				item2, err := searchNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessAuthorizationRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.NetworkAccessAuthorizationRules.CreateNetworkAccessAuthorizationRule(vvPolicyID, request1)
+	resp1 := &isegosdk.ResponseNetworkAccessAuthorizationRulesCreateNetworkAccessAuthorizationRule{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, networkAccessConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authorization", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -887,7 +861,7 @@ func resourceNetworkAccessAuthorizationRulesCreate(ctx context.Context, d *schem
 }
 
 func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthorizationRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthorizationRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -917,25 +891,25 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthorizationRules")
+		logDebugf(ctx, "Selected method: GetNetworkAccessAuthorizationRules")
 
 		response1, restyResp1, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRules(m, items1, vvName, vvID, vvPolicyID)
@@ -945,7 +919,7 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 		}
 		vItem1 := flattenNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRuleByIDItem(item1)
 
-		log.Printf("[DEBUG] Retrieved vItem1 %+v", responseInterfaceToString(vItem1))
+		logDebugf(ctx, "Retrieved vItem1 %+v", responseInterfaceToString(vItem1))
 		vItem1[0]["id"] = vvID
 		if err := d.Set("item", vItem1); err != nil {
 			diags = append(diags, diagError(
@@ -954,28 +928,36 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 			return diags
 		}
 		vItem1[0]["policy_id"] = vvPolicyID
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthorizationRules search response",
 				err))
 			return diags
 		}
+		if item1 != nil && item1.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0.rule.0", restyResp1, item1.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessAuthorizationRuleByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessAuthorizationRuleByID")
 
 		response2, restyResp2, err := client.NetworkAccessAuthorizationRules.GetNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessAuthorizationRulesGetNetworkAccessAuthorizationRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -986,12 +968,20 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 		}
 		vItem2[0]["policy_id"] = vvPolicyID
 		vItem2[0]["id"] = vvID
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessAuthorizationRuleByID response",
 				err))
 			return diags
 		}
+		if response2.Response != nil && response2.Response.Rule != nil {
+			if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0.rule.0", restyResp2, response2.Response.Rule.ID, "rule", "condition"); err != nil {
+				diags = append(diags, diagError(
+					"Failure when setting condition_expression",
+					err))
+				return diags
+			}
+		}
 		return diags
 
 	}
@@ -999,7 +989,7 @@ func resourceNetworkAccessAuthorizationRulesRead(ctx context.Context, d *schema.
 }
 
 func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthorizationRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthorizationRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1029,9 +1019,9 @@ func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schem
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -1053,15 +1043,19 @@ func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schem
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0.rule.0", request1, "rule", "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.NetworkAccessAuthorizationRules.UpdateNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID, request1)
+		response1 := &isegosdk.ResponseNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, networkAccessConditionLibrary.apiPath+"/policy-set/"+vvPolicyID+"/authorization/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessAuthorizationRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessAuthorizationRuleByID, unexpected response", ""))
@@ -1079,7 +1073,7 @@ func resourceNetworkAccessAuthorizationRulesUpdate(ctx context.Context, d *schem
 }
 
 func resourceNetworkAccessAuthorizationRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthorizationRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthorizationRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1109,9 +1103,9 @@ func resourceNetworkAccessAuthorizationRulesDelete(ctx context.Context, d *schem
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -1145,7 +1139,7 @@ func resourceNetworkAccessAuthorizationRulesDelete(ctx context.Context, d *schem
 	response1, restyResp1, err := client.NetworkAccessAuthorizationRules.DeleteNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessAuthorizationRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessAuthorizationRuleByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,8 +60,8 @@ func resourceNetworkAccessAuthorizationRulesResetHitcount() *schema.Resource {
 }
 
 func resourceNetworkAccessAuthorizationRulesResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsNetworkAccessAuthorizationRules create")
-	log.Printf("[DEBUG] Missing ResetHitCountsNetworkAccessAuthorizationRules create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsNetworkAccessAuthorizationRules create")
+	logDebugf(ctx, "Missing ResetHitCountsNetworkAccessAuthorizationRules create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -75,7 +73,7 @@ func resourceNetworkAccessAuthorizationRulesResetHitcountCreate(ctx context.Cont
 	response1, restyResp1, err := client.NetworkAccessAuthorizationRules.ResetHitCountsNetworkAccessAuthorizationRules(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsNetworkAccessAuthorizationRules", err,
@@ -83,7 +81,7 @@ func resourceNetworkAccessAuthorizationRulesResetHitcountCreate(ctx context.Cont
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNetworkAccessAuthorizationRulesResetHitCountsNetworkAccessAuthorizationRulesItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -92,7 +90,7 @@ func resourceNetworkAccessAuthorizationRulesResetHitcountCreate(ctx context.Cont
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceNetworkAccessAuthorizationRulesResetHitcountRead(ctx, d, m)
 }
@@ -103,9 +101,9 @@ func resourceNetworkAccessAuthorizationRulesResetHitcountRead(ctx context.Contex
 }
 
 func resourceNetworkAccessAuthorizationRulesResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessAuthorizationRulesResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessAuthorizationRulesResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkAccessAuthorizationRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkAccessAuthorizationRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
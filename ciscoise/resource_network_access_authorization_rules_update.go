@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -741,16 +739,16 @@ func resourceNetworkAccessAuthorizationRulesUpdateUpdateCreate(ctx context.Conte
 	response1, restyResp1, err := client.NetworkAccessAuthorizationRules.UpdateNetworkAccessAuthorizationRuleByID(vvPolicyID, vvID, request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 
 		if err != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", err.Error())
+			logDebugf(ctx, "Retrieved error response %s", err.Error())
 		}
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			err = fmt.Errorf(restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
@@ -759,7 +757,7 @@ func resourceNetworkAccessAuthorizationRulesUpdateUpdateCreate(ctx context.Conte
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenNetworkAccessAuthorizationRulesUpdateNetworkAccessAuthorizationRuleByIDItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
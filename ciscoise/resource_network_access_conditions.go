@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -72,9 +71,7 @@ TimeAndDate
 		ReadContext:   resourceNetworkAccessConditionsRead,
 		UpdateContext: resourceNetworkAccessConditionsUpdate,
 		DeleteContext: resourceNetworkAccessConditionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNetworkAccessConditionsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -370,6 +367,7 @@ TimeAndDate
 								},
 							},
 						},
+						"condition_expression": conditionExpressionSchema(),
 						"condition_type": &schema.Schema{
 							Description:      `<ul><li>Inidicates whether the record is the condition itself(data) or a logical(or,and) aggregation</li> <li>Data type enum(reference,single) indicates than "conditonId" OR "ConditionAttrs" fields should contain condition data but not both</li> <li>Logical aggreation(and,or) enum indicates that additional conditions are present under the children field</li></ul>`,
 							Type:             schema.TypeString,
@@ -573,46 +571,27 @@ TimeAndDate
 }
 
 func resourceNetworkAccessConditionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessConditions create")
+	logDebugf(ctx, "Beginning NetworkAccessConditions create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessConditionsCreateNetworkAccessCondition(ctx, "parameters.0", d)
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0", request1)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.NetworkAccessConditions.GetNetworkAccessConditionByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessConditionsRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.NetworkAccessConditions.GetNetworkAccessConditionByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.Response.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessConditionsRead(ctx, d, m)
-			}
-		}
-	}
-	resp1, restyResp1, err := client.NetworkAccessConditions.CreateNetworkAccessCondition(request1)
+	resp1 := &isegosdk.ResponseNetworkAccessConditionsCreateNetworkAccessCondition{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, networkAccessConditionLibrary.apiPath+"/condition", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -637,7 +616,7 @@ func resourceNetworkAccessConditionsCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessConditions read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessConditions read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -649,26 +628,26 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditionByName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditionByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.NetworkAccessConditions.GetNetworkAccessConditionByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenNetworkAccessConditionsGetNetworkAccessConditionByNameItemName(response1.Response)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -677,30 +656,36 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessConditionByName response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0", restyResp1, ""); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessConditionByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessConditionByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.NetworkAccessConditions.GetNetworkAccessConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenNetworkAccessConditionsGetNetworkAccessConditionByIDItemID(response2.Response)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -709,12 +694,18 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessConditionByID response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0", restyResp2, ""); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
@@ -722,7 +713,7 @@ func resourceNetworkAccessConditionsRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNetworkAccessConditionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessConditions update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessConditions update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -734,9 +725,9 @@ func resourceNetworkAccessConditionsUpdate(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -759,15 +750,19 @@ func resourceNetworkAccessConditionsUpdate(ctx context.Context, d *schema.Resour
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessConditionsUpdateNetworkAccessConditionByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0", request1)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.NetworkAccessConditions.UpdateNetworkAccessConditionByID(vvID, request1)
+		response1 := &isegosdk.ResponseNetworkAccessConditionsUpdateNetworkAccessConditionByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, networkAccessConditionLibrary.apiPath+"/condition/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessConditionByID, unexpected response", ""))
@@ -785,7 +780,7 @@ func resourceNetworkAccessConditionsUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNetworkAccessConditionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessConditions delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessConditions delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -797,9 +792,9 @@ func resourceNetworkAccessConditionsDelete(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -827,7 +822,7 @@ func resourceNetworkAccessConditionsDelete(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NetworkAccessConditions.DeleteNetworkAccessConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessConditionByID, unexpected response", ""))
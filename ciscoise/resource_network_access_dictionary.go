@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceNetworkAccessDictionary() *schema.Resource {
 		ReadContext:   resourceNetworkAccessDictionaryRead,
 		UpdateContext: resourceNetworkAccessDictionaryUpdate,
 		DeleteContext: resourceNetworkAccessDictionaryDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNetworkAccessDictionaryRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -164,7 +160,7 @@ func resourceNetworkAccessDictionary() *schema.Resource {
 }
 
 func resourceNetworkAccessDictionaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionary create")
+	logDebugf(ctx, "Beginning NetworkAccessDictionary create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -173,7 +169,7 @@ func resourceNetworkAccessDictionaryCreate(ctx context.Context, d *schema.Resour
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessDictionaryCreateNetworkAccessDictionaries(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vName, okName := resourceItem["name"]
@@ -228,7 +224,7 @@ func resourceNetworkAccessDictionaryCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionary read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionary read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -240,27 +236,27 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaries")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaries")
 
 		response1, restyResp1, err := client.NetworkAccessDictionary.GetNetworkAccessDictionaries()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessDictionaryGetNetworkAccessDictionaries(m, response1)
 		item1, err := searchNetworkAccessDictionaryGetNetworkAccessDictionaries(m, items1, vvName, vvID)
@@ -275,7 +271,7 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessDictionaries search response",
 				err))
@@ -284,20 +280,20 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaryByName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaryByName")
 		vvName := vName
 
 		response2, restyResp2, err := client.NetworkAccessDictionary.GetNetworkAccessDictionaryByName(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessDictionaryGetNetworkAccessDictionaryByNameItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -306,7 +302,7 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessDictionaryByName response",
 				err))
@@ -319,7 +315,7 @@ func resourceNetworkAccessDictionaryRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNetworkAccessDictionaryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionary update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionary update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -331,9 +327,9 @@ func resourceNetworkAccessDictionaryUpdate(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvName string
@@ -356,15 +352,15 @@ func resourceNetworkAccessDictionaryUpdate(ctx context.Context, d *schema.Resour
 		vvName = vName
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vvName)
+		logDebugf(ctx, "Name used for update operation %s", vvName)
 		request1 := expandRequestNetworkAccessDictionaryUpdateNetworkAccessDictionaryByName(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessDictionary.UpdateNetworkAccessDictionaryByName(vvName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessDictionaryByName", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessDictionaryByName, unexpected response", ""))
@@ -382,7 +378,7 @@ func resourceNetworkAccessDictionaryUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceNetworkAccessDictionaryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionary delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionary delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -394,9 +390,9 @@ func resourceNetworkAccessDictionaryDelete(ctx context.Context, d *schema.Resour
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvName string
@@ -431,7 +427,7 @@ func resourceNetworkAccessDictionaryDelete(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NetworkAccessDictionary.DeleteNetworkAccessDictionaryByName(vvName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessDictionaryByName", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessDictionaryByName, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceNetworkAccessDictionaryAttribute() *schema.Resource {
 		ReadContext:   resourceNetworkAccessDictionaryAttributeRead,
 		UpdateContext: resourceNetworkAccessDictionaryAttributeUpdate,
 		DeleteContext: resourceNetworkAccessDictionaryAttributeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByPath(resourceNetworkAccessDictionaryAttributeRead, []string{"dictionary_name", "name"}, ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -201,7 +197,7 @@ func resourceNetworkAccessDictionaryAttribute() *schema.Resource {
 }
 
 func resourceNetworkAccessDictionaryAttributeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionaryAttribute create")
+	logDebugf(ctx, "Beginning NetworkAccessDictionaryAttribute create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -210,7 +206,7 @@ func resourceNetworkAccessDictionaryAttributeCreate(ctx context.Context, d *sche
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessDictionaryAttributeCreateNetworkAccessDictionaryAttribute(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vDictionaryName, okDictionaryName := resourceItem["dictionary_name"]
@@ -262,7 +258,7 @@ func resourceNetworkAccessDictionaryAttributeCreate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionaryAttribute read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionaryAttribute read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -274,13 +270,13 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okName, okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaryAttributesByDictionaryName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaryAttributesByDictionaryName")
 		vvDictionaryName := vDictionaryName
 		vvName := vName
 
@@ -288,13 +284,13 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributesByDictionaryName(m, response1, vvDictionaryName)
 		item1, err := searchNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributesByDictionaryName(m, items1, vvName, vvDictionaryName)
@@ -309,7 +305,7 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessDictionaryAttributesByDictionaryName search response",
 				err))
@@ -318,7 +314,7 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessDictionaryAttributeByName")
+		logDebugf(ctx, "Selected method: GetNetworkAccessDictionaryAttributeByName")
 		vvName := vName
 		vvDictionaryName := vDictionaryName
 
@@ -326,13 +322,13 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessDictionaryAttributeGetNetworkAccessDictionaryAttributeByNameItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -341,7 +337,7 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessDictionaryAttributeByName response",
 				err))
@@ -354,7 +350,7 @@ func resourceNetworkAccessDictionaryAttributeRead(ctx context.Context, d *schema
 }
 
 func resourceNetworkAccessDictionaryAttributeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionaryAttribute update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionaryAttribute update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -366,9 +362,9 @@ func resourceNetworkAccessDictionaryAttributeUpdate(ctx context.Context, d *sche
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okName, okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvDictionaryName string
@@ -395,15 +391,15 @@ func resourceNetworkAccessDictionaryAttributeUpdate(ctx context.Context, d *sche
 		vvDictionaryName = vDictionaryName
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vvName)
+		logDebugf(ctx, "Name used for update operation %s", vvName)
 		request1 := expandRequestNetworkAccessDictionaryAttributeUpdateNetworkAccessDictionaryAttributeByName(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessDictionaryAttribute.UpdateNetworkAccessDictionaryAttributeByName(vvName, vvDictionaryName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessDictionaryAttributeByName", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessDictionaryAttributeByName, unexpected response", ""))
@@ -421,7 +417,7 @@ func resourceNetworkAccessDictionaryAttributeUpdate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessDictionaryAttributeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessDictionaryAttribute delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessDictionaryAttribute delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -433,9 +429,9 @@ func resourceNetworkAccessDictionaryAttributeDelete(ctx context.Context, d *sche
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okName, okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okDictionaryName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvDictionaryName string
@@ -473,7 +469,7 @@ func resourceNetworkAccessDictionaryAttributeDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessDictionaryAttribute.DeleteNetworkAccessDictionaryAttributeByName(vvName, vvDictionaryName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessDictionaryAttributeByName", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessDictionaryAttributeByName, unexpected response", ""))
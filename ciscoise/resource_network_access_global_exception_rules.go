@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -42,9 +40,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceNetworkAccessGlobalExceptionRulesRead,
 		UpdateContext: resourceNetworkAccessGlobalExceptionRulesUpdate,
 		DeleteContext: resourceNetworkAccessGlobalExceptionRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerGlobalRule(resourceNetworkAccessGlobalExceptionRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -600,17 +596,16 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceNetworkAccessGlobalExceptionRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessGlobalExceptionRules create")
+	logDebugf(ctx, "Beginning NetworkAccessGlobalExceptionRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessGlobalExceptionRulesCreateNetworkAccessPolicySetGlobalExceptionRule(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vID, okID := resourceItem["id"]
@@ -629,31 +624,6 @@ func resourceNetworkAccessGlobalExceptionRulesCreate(ctx context.Context, d *sch
 			vvName = interfaceToString(v)
 		}
 	}
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRuleByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessGlobalExceptionRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRules()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, response2)
-				item2, err := searchNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.Rule.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessGlobalExceptionRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.CreateNetworkAccessPolicySetGlobalExceptionRule(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -679,7 +649,7 @@ func resourceNetworkAccessGlobalExceptionRulesCreate(ctx context.Context, d *sch
 }
 
 func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessGlobalExceptionRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessGlobalExceptionRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -707,25 +677,25 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySetGlobalExceptionRules")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySetGlobalExceptionRules")
 
 		response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRules()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, response1)
 		item1, err := searchNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRules(m, items1, vvName, vvID)
@@ -740,7 +710,7 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetGlobalExceptionRules search response",
 				err))
@@ -749,19 +719,19 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySetGlobalExceptionRuleByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySetGlobalExceptionRuleByID")
 
 		response2, restyResp2, err := client.NetworkAccessAuthorizationGlobalExceptionRules.GetNetworkAccessPolicySetGlobalExceptionRuleByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessAuthorizationGlobalExceptionRulesGetNetworkAccessPolicySetGlobalExceptionRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -770,7 +740,7 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetGlobalExceptionRuleByID response",
 				err))
@@ -783,7 +753,7 @@ func resourceNetworkAccessGlobalExceptionRulesRead(ctx context.Context, d *schem
 }
 
 func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessGlobalExceptionRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessGlobalExceptionRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -811,9 +781,9 @@ func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *sch
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -835,15 +805,15 @@ func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *sch
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessGlobalExceptionRulesUpdateNetworkAccessPolicySetGlobalExceptionRuleByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.UpdateNetworkAccessPolicySetGlobalExceptionRuleByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessPolicySetGlobalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessPolicySetGlobalExceptionRuleByID, unexpected response", ""))
@@ -861,7 +831,7 @@ func resourceNetworkAccessGlobalExceptionRulesUpdate(ctx context.Context, d *sch
 }
 
 func resourceNetworkAccessGlobalExceptionRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessGlobalExceptionRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessGlobalExceptionRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -872,9 +842,9 @@ func resourceNetworkAccessGlobalExceptionRulesDelete(ctx context.Context, d *sch
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -910,7 +880,7 @@ func resourceNetworkAccessGlobalExceptionRulesDelete(ctx context.Context, d *sch
 	response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.DeleteNetworkAccessPolicySetGlobalExceptionRuleByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessPolicySetGlobalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessPolicySetGlobalExceptionRuleByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ func resourceNetworkAccessGlobalExceptionRulesResetHitcount() *schema.Resource {
 }
 
 func resourceNetworkAccessGlobalExceptionRulesResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsNetworkAccessGlobalExceptions create")
-	log.Printf("[DEBUG] Missing ResetHitCountsNetworkAccessGlobalExceptions create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsNetworkAccessGlobalExceptions create")
+	logDebugf(ctx, "Missing ResetHitCountsNetworkAccessGlobalExceptions create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -63,14 +61,14 @@ func resourceNetworkAccessGlobalExceptionRulesResetHitcountCreate(ctx context.Co
 	response1, restyResp1, err := client.NetworkAccessAuthorizationGlobalExceptionRules.ResetHitCountsNetworkAccessGlobalExceptions()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsNetworkAccessGlobalExceptions", err,
 			"Failure at ResetHitCountsNetworkAccessGlobalExceptions, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNetworkAccessAuthorizationGlobalExceptionRulesResetHitCountsNetworkAccessGlobalExceptionsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -90,9 +88,9 @@ func resourceNetworkAccessGlobalExceptionRulesResetHitcountRead(ctx context.Cont
 }
 
 func resourceNetworkAccessGlobalExceptionRulesResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessGlobalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessGlobalExceptionRulesResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkAccessGlobalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkAccessGlobalExceptionRulesResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
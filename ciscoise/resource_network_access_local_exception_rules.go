@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -41,9 +39,7 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 		ReadContext:   resourceNetworkAccessLocalExceptionRulesRead,
 		UpdateContext: resourceNetworkAccessLocalExceptionRulesUpdate,
 		DeleteContext: resourceNetworkAccessLocalExceptionRulesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerPolicyRule(resourceNetworkAccessLocalExceptionRulesRead, networkAccessPolicySetIDs),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -604,20 +600,19 @@ ConditionAttributes, ConditionAndBlock, ConditionOrBlock
 }
 
 func resourceNetworkAccessLocalExceptionRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessLocalExceptionRules create")
+	logDebugf(ctx, "Beginning NetworkAccessLocalExceptionRules create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessLocalExceptionRulesCreateNetworkAccessLocalExceptionRule(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vPolicyID, okPolicyID := resourceItem["policy_id"]
+	vPolicyID := resourceItem["policy_id"]
 	vvPolicyID := interfaceToString(vPolicyID)
 	vID, okID := resourceItem["id"]
 	var vvName string
@@ -636,33 +631,6 @@ func resourceNetworkAccessLocalExceptionRulesCreate(ctx context.Context, d *sche
 		}
 	}
 
-	if isEnableAutoImport {
-		if okPolicyID && vvPolicyID != "" && okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["policy_id"] = vvPolicyID
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessLocalExceptionRulesRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRules(vvPolicyID)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, response2, vvPolicyID)
-				item2, err := searchNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, items2, vvName, vvID, vvPolicyID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["policy_id"] = vvPolicyID
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessLocalExceptionRulesRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.CreateNetworkAccessLocalExceptionRule(vvPolicyID, request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -689,7 +657,7 @@ func resourceNetworkAccessLocalExceptionRulesCreate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessLocalExceptionRules read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessLocalExceptionRules read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -719,25 +687,25 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessLocalExceptionRules")
+		logDebugf(ctx, "Selected method: GetNetworkAccessLocalExceptionRules")
 
 		response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRules(vvPolicyID)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, response1, vvPolicyID)
 		item1, err := searchNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRules(m, items1, vvName, vvID, vvPolicyID)
@@ -752,7 +720,7 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessLocalExceptionRules search response",
 				err))
@@ -761,19 +729,19 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessLocalExceptionRuleByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessLocalExceptionRuleByID")
 
 		response2, restyResp2, err := client.NetworkAccessAuthorizationExceptionRules.GetNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessAuthorizationExceptionRulesGetNetworkAccessLocalExceptionRuleByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -782,7 +750,7 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessLocalExceptionRuleByID response",
 				err))
@@ -795,7 +763,7 @@ func resourceNetworkAccessLocalExceptionRulesRead(ctx context.Context, d *schema
 }
 
 func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessLocalExceptionRules update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessLocalExceptionRules update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -825,9 +793,9 @@ func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *sche
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -849,15 +817,15 @@ func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *sche
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessLocalExceptionRulesUpdateNetworkAccessLocalExceptionRuleByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.UpdateNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessLocalExceptionRuleByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessLocalExceptionRuleByID, unexpected response", ""))
@@ -875,7 +843,7 @@ func resourceNetworkAccessLocalExceptionRulesUpdate(ctx context.Context, d *sche
 }
 
 func resourceNetworkAccessLocalExceptionRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessLocalExceptionRules delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessLocalExceptionRules delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -905,9 +873,9 @@ func resourceNetworkAccessLocalExceptionRulesDelete(ctx context.Context, d *sche
 	vvPolicyID := vPolicyID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -941,7 +909,7 @@ func resourceNetworkAccessLocalExceptionRulesDelete(ctx context.Context, d *sche
 	response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.DeleteNetworkAccessLocalExceptionRuleByID(vvPolicyID, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessLocalExceptionRuleByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessLocalExceptionRuleByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -61,8 +59,8 @@ func resourceNetworkAccessLocalExceptionRulesResetHitcounts() *schema.Resource {
 }
 
 func resourceNetworkAccessLocalExceptionRulesResetHitcountsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsNetworkAccessLocalExceptions create")
-	log.Printf("[DEBUG] Missing ResetHitCountsNetworkAccessLocalExceptions create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsNetworkAccessLocalExceptions create")
+	logDebugf(ctx, "Missing ResetHitCountsNetworkAccessLocalExceptions create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -73,14 +71,14 @@ func resourceNetworkAccessLocalExceptionRulesResetHitcountsCreate(ctx context.Co
 	response1, restyResp1, err := client.NetworkAccessAuthorizationExceptionRules.ResetHitCountsNetworkAccessLocalExceptions(vvPolicyID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsNetworkAccessLocalExceptions", err,
 			"Failure at ResetHitCountsNetworkAccessLocalExceptions, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNetworkAccessAuthorizationExceptionRulesResetHitCountsNetworkAccessLocalExceptionsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -100,9 +98,9 @@ func resourceNetworkAccessLocalExceptionRulesResetHitcountsRead(ctx context.Cont
 }
 
 func resourceNetworkAccessLocalExceptionRulesResetHitcountsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessLocalExceptionRulesResetHitcounts delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessLocalExceptionRulesResetHitcounts delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkAccessLocalExceptionRulesResetHitcounts delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkAccessLocalExceptionRulesResetHitcounts delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceNetworkAccessNetworkCondition() *schema.Resource {
 		ReadContext:   resourceNetworkAccessNetworkConditionRead,
 		UpdateContext: resourceNetworkAccessNetworkConditionUpdate,
 		DeleteContext: resourceNetworkAccessNetworkConditionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNetworkAccessNetworkConditionRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -239,48 +235,22 @@ func resourceNetworkAccessNetworkCondition() *schema.Resource {
 }
 
 func resourceNetworkAccessNetworkConditionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessNetworkCondition create")
+	logDebugf(ctx, "Beginning NetworkAccessNetworkCondition create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessNetworkConditionCreateNetworkAccessNetworkCondition(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessNetworkConditions.GetNetworkAccessNetworkConditionByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessNetworkConditionRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessNetworkConditions.GetNetworkAccessNetworkConditions()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, response2)
-				item2, err := searchNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessNetworkConditionRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NetworkAccessNetworkConditions.CreateNetworkAccessNetworkCondition(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -306,7 +276,7 @@ func resourceNetworkAccessNetworkConditionCreate(ctx context.Context, d *schema.
 }
 
 func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessNetworkCondition read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessNetworkCondition read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -319,25 +289,25 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessNetworkConditions")
+		logDebugf(ctx, "Selected method: GetNetworkAccessNetworkConditions")
 
 		response1, restyResp1, err := client.NetworkAccessNetworkConditions.GetNetworkAccessNetworkConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, response1)
 		item1, err := searchNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditions(m, items1, vvName, vvID)
@@ -352,7 +322,7 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessNetworkConditions search response",
 				err))
@@ -361,19 +331,19 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessNetworkConditionByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessNetworkConditionByID")
 
 		response2, restyResp2, err := client.NetworkAccessNetworkConditions.GetNetworkAccessNetworkConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessNetworkConditionsGetNetworkAccessNetworkConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -382,7 +352,7 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessNetworkConditionByID response",
 				err))
@@ -395,7 +365,7 @@ func resourceNetworkAccessNetworkConditionRead(ctx context.Context, d *schema.Re
 }
 
 func resourceNetworkAccessNetworkConditionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessNetworkCondition update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessNetworkCondition update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -408,9 +378,9 @@ func resourceNetworkAccessNetworkConditionUpdate(ctx context.Context, d *schema.
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -432,15 +402,15 @@ func resourceNetworkAccessNetworkConditionUpdate(ctx context.Context, d *schema.
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessNetworkConditionUpdateNetworkAccessNetworkConditionByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessNetworkConditions.UpdateNetworkAccessNetworkConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessNetworkConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessNetworkConditionByID, unexpected response", ""))
@@ -458,7 +428,7 @@ func resourceNetworkAccessNetworkConditionUpdate(ctx context.Context, d *schema.
 }
 
 func resourceNetworkAccessNetworkConditionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessNetworkCondition delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessNetworkCondition delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -471,9 +441,9 @@ func resourceNetworkAccessNetworkConditionDelete(ctx context.Context, d *schema.
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -507,7 +477,7 @@ func resourceNetworkAccessNetworkConditionDelete(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.NetworkAccessNetworkConditions.DeleteNetworkAccessNetworkConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessNetworkConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessNetworkConditionByID, unexpected response", ""))
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,9 +29,7 @@ func resourceNetworkAccessPolicySet() *schema.Resource {
 		ReadContext:   resourceNetworkAccessPolicySetRead,
 		UpdateContext: resourceNetworkAccessPolicySetUpdate,
 		DeleteContext: resourceNetworkAccessPolicySetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNetworkAccessPolicySetRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -608,6 +605,7 @@ func resourceNetworkAccessPolicySet() *schema.Resource {
 								},
 							},
 						},
+						"condition_expression": conditionExpressionSchema(),
 						"default": &schema.Schema{
 							Description:      `Flag which indicates if this policy set is the default one`,
 							Type:             schema.TypeString,
@@ -705,46 +703,23 @@ func resourceNetworkAccessPolicySetCreate(ctx context.Context, d *schema.Resourc
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessPolicySetCreateNetworkAccessPolicySet(ctx, "parameters.0", d)
-
-	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+	body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0", request1, "condition")
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when expanding condition_expression", err))
+		return diags
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySetByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessPolicySetRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessPolicySetGetNetworkAccessPolicySets(m, response2)
-				item2, err := searchNetworkAccessPolicySetGetNetworkAccessPolicySets(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessPolicySetRead(ctx, d, m)
-				}
-			}
-		}
-	}
-	resp1, restyResp1, err := client.NetworkAccessPolicySet.CreateNetworkAccessPolicySet(request1)
+	resp1 := &isegosdk.ResponseNetworkAccessPolicySetCreateNetworkAccessPolicySet{}
+	restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPost, networkAccessConditionLibrary.apiPath+"/policy-set", body, resp1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -769,7 +744,7 @@ func resourceNetworkAccessPolicySetCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessPolicySet read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessPolicySet read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -782,25 +757,25 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySets")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySets")
 
 		response1, restyResp1, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySets()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessPolicySetGetNetworkAccessPolicySets(m, response1)
 		item1, err := searchNetworkAccessPolicySetGetNetworkAccessPolicySets(m, items1, vvName, vvID)
@@ -816,28 +791,34 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySets search response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0", restyResp1, item1.ID, "condition"); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessPolicySetByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessPolicySetByID")
 
 		response2, restyResp2, err := client.NetworkAccessPolicySet.GetNetworkAccessPolicySetByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessPolicySetGetNetworkAccessPolicySetByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -846,12 +827,18 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessPolicySetByID response",
 				err))
 			return diags
 		}
+		if err := setConditionExpression(ctx, d, m, networkAccessConditionLibrary, "parameters.0", restyResp2, vvID, "condition"); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting condition_expression",
+				err))
+			return diags
+		}
 		return diags
 
 	}
@@ -859,7 +846,7 @@ func resourceNetworkAccessPolicySetRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNetworkAccessPolicySetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessPolicySet update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessPolicySet update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -872,9 +859,9 @@ func resourceNetworkAccessPolicySetUpdate(ctx context.Context, d *schema.Resourc
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -896,15 +883,19 @@ func resourceNetworkAccessPolicySetUpdate(ctx context.Context, d *schema.Resourc
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessPolicySetUpdateNetworkAccessPolicySetByID(ctx, "parameters.0", d)
-		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		body, err := expandConditionExpression(ctx, d, client, networkAccessConditionLibrary, "parameters.0", request1, "condition")
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when expanding condition_expression", err))
+			return diags
 		}
-		response1, restyResp1, err := client.NetworkAccessPolicySet.UpdateNetworkAccessPolicySetByID(vvID, request1)
+		response1 := &isegosdk.ResponseNetworkAccessPolicySetUpdateNetworkAccessPolicySetByID{}
+		restyResp1, err := policyAPIRequest(ctx, m, resty.MethodPut, networkAccessConditionLibrary.apiPath+"/policy-set/"+vvID, body, response1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessPolicySetByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessPolicySetByID, unexpected response", ""))
@@ -922,7 +913,7 @@ func resourceNetworkAccessPolicySetUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceNetworkAccessPolicySetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessPolicySet delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessPolicySet delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -935,9 +926,9 @@ func resourceNetworkAccessPolicySetDelete(ctx context.Context, d *schema.Resourc
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -970,7 +961,7 @@ func resourceNetworkAccessPolicySetDelete(ctx context.Context, d *schema.Resourc
 	response1, restyResp1, err := client.NetworkAccessPolicySet.DeleteNetworkAccessPolicySetByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessPolicySetByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessPolicySetByID, unexpected response", ""))
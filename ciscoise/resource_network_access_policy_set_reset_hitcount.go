@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ func resourceNetworkAccessPolicySetResetHitcount() *schema.Resource {
 }
 
 func resourceNetworkAccessPolicySetResetHitcountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ResetHitCountsNetworkAccessPolicySets create")
-	log.Printf("[DEBUG] Missing ResetHitCountsNetworkAccessPolicySets create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ResetHitCountsNetworkAccessPolicySets create")
+	logDebugf(ctx, "Missing ResetHitCountsNetworkAccessPolicySets create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -63,14 +61,14 @@ func resourceNetworkAccessPolicySetResetHitcountCreate(ctx context.Context, d *s
 	response1, restyResp1, err := client.NetworkAccessPolicySet.ResetHitCountsNetworkAccessPolicySets()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ResetHitCountsNetworkAccessPolicySets", err,
 			"Failure at ResetHitCountsNetworkAccessPolicySets, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNetworkAccessPolicySetResetHitCountsNetworkAccessPolicySetsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -90,9 +88,9 @@ func resourceNetworkAccessPolicySetResetHitcountRead(ctx context.Context, d *sch
 }
 
 func resourceNetworkAccessPolicySetResetHitcountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessPolicySetResetHitcount delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessPolicySetResetHitcount delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkAccessPolicySetResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkAccessPolicySetResetHitcount delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
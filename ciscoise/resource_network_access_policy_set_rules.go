@@ -0,0 +1,97 @@
+package ciscoise
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var networkAccessPolicySetRules = policySetRulesDomain{
+	name:       "NetworkAccessPolicySetRules",
+	library:    networkAccessConditionLibrary,
+	policySets: networkAccessPolicySetIDs,
+	kinds: []policySetRuleKind{
+		{
+			key:        "authentication_rule",
+			attributes: policySetAuthenticationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/authentication"
+			},
+		},
+		{
+			key:        "local_exception_rule",
+			attributes: networkAccessAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/exception"
+			},
+		},
+		{
+			key:        "global_exception_rule",
+			attributes: networkAccessAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/global-exception"
+			},
+		},
+		{
+			key:        "authorization_rule",
+			attributes: networkAccessAuthorizationRuleAttributes(),
+			path: func(policyID string) string {
+				return "/policy-set/" + policyID + "/authorization"
+			},
+		},
+	},
+}
+
+func resourceNetworkAccessPolicySetRules() *schema.Resource {
+	return resourcePolicySetRules(networkAccessPolicySetRules, `It manages the rules of a Network Access policy set together.
+
+- The order of each list of rules is their rank. Apply computes the fewest creates, updates, moves and deletes.
+
+- Rules are matched by name, a renamed rule is deleted and created again.
+
+- The default rules are only updated, and must be last when declared.
+
+- The global exception rules apply to every policy set, declare them in a single resource.
+`)
+}
+
+func policySetAuthenticationRuleAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"identity_source_name": &schema.Schema{
+			Description: `Identity source name from the identity stores`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if_auth_fail": &schema.Schema{
+			Description: `Action to perform when authentication fails such as Bad credentials, disabled user and so on`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if_process_fail": &schema.Schema{
+			Description: `Action to perform when ISE is uanble to access the identity database`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"if_user_not_found": &schema.Schema{
+			Description: `Action to perform when user is not found in any of identity stores`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
+
+func networkAccessAuthorizationRuleAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"profile": &schema.Schema{
+			Description: `The authorization profile/s`,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"security_group": &schema.Schema{
+			Description: `Security group used in authorization policies`,
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+	}
+}
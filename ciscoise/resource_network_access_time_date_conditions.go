@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceNetworkAccessTimeDateConditions() *schema.Resource {
 		ReadContext:   resourceNetworkAccessTimeDateConditionsRead,
 		UpdateContext: resourceNetworkAccessTimeDateConditionsUpdate,
 		DeleteContext: resourceNetworkAccessTimeDateConditionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNetworkAccessTimeDateConditionsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -529,48 +525,22 @@ func resourceNetworkAccessTimeDateConditions() *schema.Resource {
 }
 
 func resourceNetworkAccessTimeDateConditionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessTimeDateConditions create")
+	logDebugf(ctx, "Beginning NetworkAccessTimeDateConditions create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNetworkAccessTimeDateConditionsCreateNetworkAccessTimeCondition(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditionByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceNetworkAccessTimeDateConditionsRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditions()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, response2)
-				item2, err := searchNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceNetworkAccessTimeDateConditionsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NetworkAccessTimeDateConditions.CreateNetworkAccessTimeCondition(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -596,7 +566,7 @@ func resourceNetworkAccessTimeDateConditionsCreate(ctx context.Context, d *schem
 }
 
 func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessTimeDateConditions read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessTimeDateConditions read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -609,25 +579,25 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessTimeConditions")
+		logDebugf(ctx, "Selected method: GetNetworkAccessTimeConditions")
 
 		response1, restyResp1, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditions()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, response1)
 		item1, err := searchNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditions(m, items1, vvName, vvID)
@@ -642,7 +612,7 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessTimeConditions search response",
 				err))
@@ -651,19 +621,19 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNetworkAccessTimeConditionByID")
+		logDebugf(ctx, "Selected method: GetNetworkAccessTimeConditionByID")
 
 		response2, restyResp2, err := client.NetworkAccessTimeDateConditions.GetNetworkAccessTimeConditionByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNetworkAccessTimeDateConditionsGetNetworkAccessTimeConditionByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -672,7 +642,7 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNetworkAccessTimeConditionByID response",
 				err))
@@ -685,7 +655,7 @@ func resourceNetworkAccessTimeDateConditionsRead(ctx context.Context, d *schema.
 }
 
 func resourceNetworkAccessTimeDateConditionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessTimeDateConditions update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessTimeDateConditions update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -698,9 +668,9 @@ func resourceNetworkAccessTimeDateConditionsUpdate(ctx context.Context, d *schem
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -722,15 +692,15 @@ func resourceNetworkAccessTimeDateConditionsUpdate(ctx context.Context, d *schem
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestNetworkAccessTimeDateConditionsUpdateNetworkAccessTimeConditionByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NetworkAccessTimeDateConditions.UpdateNetworkAccessTimeConditionByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNetworkAccessTimeConditionByID", err, restyResp1.String(),
 					"Failure at UpdateNetworkAccessTimeConditionByID, unexpected response", ""))
@@ -748,7 +718,7 @@ func resourceNetworkAccessTimeDateConditionsUpdate(ctx context.Context, d *schem
 }
 
 func resourceNetworkAccessTimeDateConditionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkAccessTimeDateConditions delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkAccessTimeDateConditions delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -761,9 +731,9 @@ func resourceNetworkAccessTimeDateConditionsDelete(ctx context.Context, d *schem
 	vvID := vID
 	vvName := vName
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -797,7 +767,7 @@ func resourceNetworkAccessTimeDateConditionsDelete(ctx context.Context, d *schem
 	response1, restyResp1, err := client.NetworkAccessTimeDateConditions.DeleteNetworkAccessTimeConditionByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNetworkAccessTimeConditionByID", err, restyResp1.String(),
 				"Failure at DeleteNetworkAccessTimeConditionByID, unexpected response", ""))
@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceNetworkDeviceBulkRequest() *schema.Resource {
 		CreateContext: resourceNetworkDeviceBulkRequestCreate,
 		ReadContext:   resourceNetworkDeviceBulkRequestRead,
 		DeleteContext: resourceNetworkDeviceBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceNetworkDeviceBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceNetworkDeviceBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForNetworkDevice create")
-	log.Printf("[DEBUG] Missing BulkRequestForNetworkDevice create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForNetworkDevice create")
+	logDebugf(ctx, "Missing BulkRequestForNetworkDevice create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -74,7 +76,7 @@ func resourceNetworkDeviceBulkRequestCreate(ctx context.Context, d *schema.Resou
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -82,10 +84,16 @@ func resourceNetworkDeviceBulkRequestCreate(ctx context.Context, d *schema.Resou
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForNetworkDevice", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.NetworkDevice.MonitorBulkStatusNetworkDevice(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceNetworkDeviceBulkRequestRead(ctx, d, m)
+	return append(diags, resourceNetworkDeviceBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceNetworkDeviceBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -94,9 +102,9 @@ func resourceNetworkDeviceBulkRequestRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceNetworkDeviceBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NetworkDeviceBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NetworkDeviceBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NetworkDeviceBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NetworkDeviceBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
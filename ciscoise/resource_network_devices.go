@@ -0,0 +1,620 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Number of devices listed per page, the ERS maximum.
+const NETWORK_DEVICES_PAGE_SIZE = 100
+
+var networkDeviceBulkType = ersBulkType{
+	name:      "NetworkDevice",
+	path:      "networkdevice",
+	mediaType: "vnd.com.cisco.ise.network.networkdevice.1.1",
+	monitor: func(client *isegosdk.Client, bulkID string) (interface{}, *resty.Response, error) {
+		return client.NetworkDevice.MonitorBulkStatusNetworkDevice(bulkID)
+	},
+}
+
+var networkDevicesSource = sourceSpec{
+	itemsKey: "device",
+	columns: []string{"name", "description", "profile_name", "model_name", "software_version", "coa_port",
+		"network_device_groups", "ip_addresses", "radius_shared_secret", "tacacs_shared_secret", "tacacs_connect_mode_options"},
+	item: networkDevicesSourceItem,
+}
+
+type networkDeviceBulkResource struct {
+	ID string `json:"id,omitempty"`
+	*isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDevice
+}
+
+func resourceNetworkDevices() *schema.Resource {
+	return &schema.Resource{
+		Description: `It manages a set of NetworkDevice through the ERS bulk API.
+
+- Devices are listed page by page on read. The listing holds the ID and the description of each device, the other attributes keep their applied value. A device is read by ID only when the listing cannot fill it, when its ID or description is not the one in state. Shared secrets are not returned by ISE and keep their declared value.
+
+- Creates, updates and deletes are computed from the changes of the device blocks and sent as bulk requests.
+
+- With authoritative_network_device_group, devices of that group and its children which are not declared are deleted on apply. Destroy only deletes the devices declared.
+
+- Devices can be loaded from a CSV or JSON source instead of device blocks, each row is planned as a device.
+`,
+
+		CreateContext: resourceNetworkDevicesCreate,
+		ReadContext:   resourceNetworkDevicesRead,
+		UpdateContext: resourceNetworkDevicesUpdate,
+		DeleteContext: resourceNetworkDevicesDelete,
+		CustomizeDiff: resourceNetworkDevicesCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+			Update: schema.DefaultTimeout(TASK_TIMEOUT),
+			Delete: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
+
+		Schema: sourceSchema(map[string]*schema.Schema{
+			"last_updated": &schema.Schema{
+				Description: `Unix timestamp records the last time that the resource was updated.`,
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"authoritative_network_device_group": &schema.Schema{
+				Description: `Full name of a Location or Device Type network device group, e.g. Location#All Locations#Site1.
+Devices of this group and its children which are not declared are deleted.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNetworkDeviceGroupFilter,
+			},
+			"device_ids": &schema.Schema{
+				Description: `ID of each declared device, keyed by name.`,
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"device": &schema.Schema{
+				Description:  `Network devices, keyed by name.`,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"device", "source_file", "source_content"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"profile_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"model_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"software_version": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"coa_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1700,
+						},
+						"network_device_group_list": &schema.Schema{
+							Description: `List of Network Device Group names for this node`,
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"network_device_iplist": &schema.Schema{
+							Description: `List of IP Subnets for this node`,
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+
+									"get_ipaddress_exclude": &schema.Schema{
+										Description: `It can be either single IP address or IP range address`,
+										Type:        schema.TypeString,
+										Optional:    true,
+									},
+									"ipaddress": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mask": &schema.Schema{
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  32,
+									},
+								},
+							},
+						},
+						"radius_shared_secret": &schema.Schema{
+							Description: `Enables RADIUS authentication with this shared secret`,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"tacacs_shared_secret": &schema.Schema{
+							Description: `Enables TACACS+ authentication with this shared secret`,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"tacacs_connect_mode_options": &schema.Schema{
+							Description: `Allowed values:
+- OFF,
+- ON_LEGACY,
+- ON_DRAFT_COMPLIANT`,
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		}, networkDevicesSource),
+	}
+}
+
+func resourceNetworkDevicesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning NetworkDevices create")
+	diags := resourceNetworkDevicesApply(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() && d.Get("device").(*schema.Set).Len() == 0 {
+		// No device was applied, so the resource is not created
+		return diags
+	}
+	d.SetId(getUnixTimeString())
+	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, readNetworkDevices(ctx, d, m, true)...)
+}
+
+func resourceNetworkDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return readNetworkDevices(ctx, d, m, false)
+}
+
+// readNetworkDevices refreshes the devices from the paged listing. applied
+// tells that the devices of d were just applied, so a device without a
+// recorded ID was created with them.
+func readNetworkDevices(ctx context.Context, d *schema.ResourceData, m interface{}, applied bool) diag.Diagnostics {
+	logDebugf(ctx, "Beginning NetworkDevices read for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+
+	existing, err := listNetworkDevices(ctx, client, nil)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing GetNetworkDevice", err))
+		return diags
+	}
+	byName := make(map[string]isegosdk.ResponseNetworkDeviceGetNetworkDeviceSearchResultResources)
+	for _, item := range existing {
+		byName[item.Name] = item
+	}
+
+	recorded := mapInterfaceToMapString(d.Get("device_ids").(map[string]interface{}))
+	var devices []interface{}
+	deviceIDs := make(map[string]interface{})
+	for _, item := range d.Get("device").(*schema.Set).List() {
+		device := item.(map[string]interface{})
+		name := interfaceToString(device["name"])
+		found, ok := byName[name]
+		if !ok {
+			logDebugf(ctx, "Network device %s not found, removing it from the state", name)
+			continue
+		}
+		if recorded[name] == "" && !applied {
+			// A device of the authoritative group which is not declared, it
+			// is listed again below while it exists
+			continue
+		}
+		if (recorded[name] == found.ID || recorded[name] == "") && found.Description == interfaceToString(device["description"]) {
+			devices = append(devices, device)
+			deviceIDs[name] = found.ID
+			continue
+		}
+		response, restyResp, err := client.NetworkDevice.GetNetworkDeviceByID(found.ID)
+		if err != nil || response == nil || response.NetworkDevice == nil {
+			if restyResp != nil && restyResp.StatusCode() == 404 {
+				logDebugf(ctx, "Network device %s not found, removing it from the state", name)
+				continue
+			}
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			diags = append(diags, diagError(
+				fmt.Sprintf("Failure when executing GetNetworkDeviceByID for %s", name), err))
+			return diags
+		}
+		devices = append(devices, flattenNetworkDevicesDevice(response.NetworkDevice, device))
+		deviceIDs[name] = found.ID
+	}
+
+	if group := d.Get("authoritative_network_device_group").(string); group != "" {
+		groupDevices, err := listNetworkDeviceGroupDevices(ctx, client, group)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when executing GetNetworkDevice", err))
+			return diags
+		}
+		// The devices not declared are shown for deletion, they have no
+		// recorded ID so that destroy leaves them
+		for _, found := range groupDevices {
+			if _, ok := deviceIDs[found.Name]; ok {
+				continue
+			}
+			logDebugf(ctx, "Network device %s of %s is not declared", found.Name, group)
+			devices = append(devices, map[string]interface{}{
+				"name":        found.Name,
+				"description": found.Description,
+			})
+		}
+	}
+
+	if err := d.Set("device", devices); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetNetworkDevice response",
+			err))
+		return diags
+	}
+	if err := d.Set("device_ids", deviceIDs); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetNetworkDevice response",
+			err))
+		return diags
+	}
+	return diags
+}
+
+func resourceNetworkDevicesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning NetworkDevices update for id=[%s]", d.Id())
+	var diags diag.Diagnostics
+	if d.HasChange("device") {
+		diags = resourceNetworkDevicesApply(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+		_ = d.Set("last_updated", getUnixTimeString())
+		return append(diags, readNetworkDevices(ctx, d, m, true)...)
+	}
+	return append(diags, resourceNetworkDevicesRead(ctx, d, m)...)
+}
+
+func resourceNetworkDevicesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logDebugf(ctx, "Beginning NetworkDevices delete for id=[%s]", d.Id())
+	deviceIDs := mapInterfaceToMapString(d.Get("device_ids").(map[string]interface{}))
+	names := make([]string, 0, len(deviceIDs))
+	for name := range deviceIDs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	_, diags := submitERSBulk(waitCtx, m, networkDeviceBulkType, "delete", names, deviceIDs, nil)
+	if diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNetworkDevicesCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if err := customizeSourceDiff(diff, networkDevicesSource); err != nil {
+		return err
+	}
+	names := make(map[string]bool)
+	for _, item := range diff.Get("device").(*schema.Set).List() {
+		name := interfaceToString(item.(map[string]interface{})["name"])
+		if name == "" {
+			continue
+		}
+		if names[name] {
+			return fmt.Errorf("network device %s is declared more than once", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+// resourceNetworkDevicesApply sends the deletes, updates and creates between
+// the prior and the planned devices. Devices whose operation failed are set
+// back to their prior value so that the next plan retries them.
+func resourceNetworkDevicesApply(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldDevices, newDevices := d.GetChange("device")
+	prior := itemsByName(oldDevices.(*schema.Set))
+	planned := itemsByName(newDevices.(*schema.Set))
+	deviceIDs := mapInterfaceToMapString(d.Get("device_ids").(map[string]interface{}))
+	undeclared := make(map[string]bool)
+	if group := d.Get("authoritative_network_device_group").(string); group != "" {
+		// The devices of the group not declared have no recorded ID
+		groupDevices, err := listNetworkDeviceGroupDevices(ctx, m.(ClientConfig).Client, group)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when executing GetNetworkDevice", err))
+			return diags
+		}
+		for _, found := range groupDevices {
+			if _, ok := planned[found.Name]; ok || deviceIDs[found.Name] != "" {
+				continue
+			}
+			deviceIDs[found.Name] = found.ID
+			undeclared[found.Name] = true
+			if _, ok := prior[found.Name]; !ok {
+				prior[found.Name] = map[string]interface{}{"name": found.Name}
+			}
+		}
+	}
+	create, update, remove := diffItemsByName(prior, planned, deviceIDs)
+	logDebugf(ctx, "Network devices to create %v, update %v, delete %v", create, update, remove)
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	failed := make(map[string]bool)
+	for _, operation := range []struct {
+		name  string
+		names []string
+	}{{"delete", remove}, {"update", update}, {"create", create}} {
+		items := networkDevicesBulkItems(operation.names, deviceIDs, planned, operation.name == "update")
+		operationFailed, operationDiags := submitERSBulk(waitCtx, m, networkDeviceBulkType, operation.name, operation.names, deviceIDs, items)
+		diags = append(diags, operationDiags...)
+		for name := range operationFailed {
+			failed[name] = true
+		}
+	}
+	if len(failed) == 0 {
+		return diags
+	}
+
+	var devices []interface{}
+	for name, device := range planned {
+		if !failed[name] {
+			devices = append(devices, device)
+		}
+	}
+	for name, device := range prior {
+		// The read lists again the devices not declared which are left
+		if failed[name] && !undeclared[name] {
+			devices = append(devices, device)
+		}
+	}
+	_ = d.Set("device", devices)
+	return diags
+}
+
+// flattenNetworkDevicesDevice sets the attributes read into device. The shared
+// secrets are kept, and the groups and TACACS+ mode ISE assigns by default are
+// only refreshed when declared.
+func flattenNetworkDevicesDevice(item *isegosdk.ResponseNetworkDeviceGetNetworkDeviceByIDNetworkDevice, device map[string]interface{}) map[string]interface{} {
+	device["description"] = item.Description
+	device["profile_name"] = item.ProfileName
+	device["model_name"] = item.ModelName
+	device["software_version"] = item.SoftwareVersion
+	if item.CoaPort != nil {
+		device["coa_port"] = *item.CoaPort
+	}
+	groups := []interface{}{}
+	for _, group := range item.NetworkDeviceGroupList {
+		groups = append(groups, group)
+	}
+	refreshDeclared(device, "network_device_group_list", groups)
+	ipList := []interface{}{}
+	if item.NetworkDeviceIPList != nil {
+		for _, ip := range *item.NetworkDeviceIPList {
+			mask := 32
+			if ip.Mask != nil {
+				mask = *ip.Mask
+			}
+			ipList = append(ipList, map[string]interface{}{
+				"ipaddress":             ip.IPaddress,
+				"mask":                  mask,
+				"get_ipaddress_exclude": ip.GetIPaddressExclude,
+			})
+		}
+	}
+	device["network_device_iplist"] = ipList
+	if item.TacacsSettings != nil {
+		refreshDeclared(device, "tacacs_connect_mode_options", item.TacacsSettings.ConnectModeOptions)
+	}
+	return device
+}
+
+func networkDevicesBulkItems(names []string, deviceIDs map[string]string, devices map[string]map[string]interface{}, withID bool) map[string]interface{} {
+	items := make(map[string]interface{})
+	for _, name := range names {
+		resource := networkDeviceBulkResource{
+			RequestNetworkDeviceCreateNetworkDeviceNetworkDevice: expandNetworkDevicesDevice(devices[name]),
+		}
+		if withID {
+			resource.ID = deviceIDs[name]
+		}
+		items[name] = resource
+	}
+	return items
+}
+
+// listNetworkDevices returns every network device matching filter, following
+// the pages of the search result.
+func listNetworkDevices(ctx context.Context, client *isegosdk.Client, filter []string) ([]isegosdk.ResponseNetworkDeviceGetNetworkDeviceSearchResultResources, error) {
+	queryParams := isegosdk.GetNetworkDeviceQueryParams{
+		Page:   1,
+		Size:   NETWORK_DEVICES_PAGE_SIZE,
+		Filter: filter,
+	}
+	var items []isegosdk.ResponseNetworkDeviceGetNetworkDeviceSearchResultResources
+	for {
+		response, restyResp, err := client.NetworkDevice.GetNetworkDevice(&queryParams)
+		if err != nil || response == nil {
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			return nil, err
+		}
+		if response.SearchResult == nil || response.SearchResult.Resources == nil || len(*response.SearchResult.Resources) == 0 {
+			return items, nil
+		}
+		items = append(items, *response.SearchResult.Resources...)
+		if response.SearchResult.NextPage == nil || response.SearchResult.NextPage.Rel != "next" {
+			return items, nil
+		}
+		queryParams.Page, queryParams.Size, err = getNextPageAndSizeParams(response.SearchResult.NextPage.Href)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// listNetworkDeviceGroupDevices returns the devices of group and of its
+// children.
+func listNetworkDeviceGroupDevices(ctx context.Context, client *isegosdk.Client, group string) ([]isegosdk.ResponseNetworkDeviceGetNetworkDeviceSearchResultResources, error) {
+	field, err := networkDeviceGroupFilterField(group)
+	if err != nil {
+		return nil, err
+	}
+	items, err := listNetworkDevices(ctx, client, []string{fmt.Sprintf("%s.EQ.%s", field, group)})
+	if err != nil {
+		return nil, err
+	}
+	children, err := listNetworkDevices(ctx, client, []string{fmt.Sprintf("%s.STARTSW.%s#", field, group)})
+	if err != nil {
+		return nil, err
+	}
+	return append(items, children...), nil
+}
+
+// networkDeviceGroupFilterField returns the ERS filter field of the root of
+// group, the only groups the network devices can be filtered by.
+func networkDeviceGroupFilterField(group string) (string, error) {
+	switch strings.SplitN(group, "#", 2)[0] {
+	case "Location":
+		return "location", nil
+	case "Device Type":
+		return "type", nil
+	}
+	return "", fmt.Errorf("network device group %s is not a Location or Device Type group", group)
+}
+
+func validateNetworkDeviceGroupFilter(v interface{}, k string) ([]string, []error) {
+	if _, err := networkDeviceGroupFilterField(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+	return nil, nil
+}
+
+func expandNetworkDevicesDevice(device map[string]interface{}) *isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDevice {
+	request := isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDevice{}
+	request.Name = interfaceToString(device["name"])
+	request.Description = interfaceToString(device["description"])
+	request.ProfileName = interfaceToString(device["profile_name"])
+	request.ModelName = interfaceToString(device["model_name"])
+	request.SoftwareVersion = interfaceToString(device["software_version"])
+	request.CoaPort = interfaceToIntPtr(device["coa_port"])
+	request.NetworkDeviceGroupList = interfaceToSliceString(device["network_device_group_list"])
+	if v, ok := device["network_device_iplist"].([]interface{}); ok {
+		ipList := []isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDeviceNetworkDeviceIPList{}
+		for _, item := range v {
+			ip, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ipList = append(ipList, isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDeviceNetworkDeviceIPList{
+				IPaddress:           interfaceToString(ip["ipaddress"]),
+				Mask:                interfaceToIntPtr(ip["mask"]),
+				GetIPaddressExclude: interfaceToString(ip["get_ipaddress_exclude"]),
+			})
+		}
+		request.NetworkDeviceIPList = &ipList
+	}
+	if secret := interfaceToString(device["radius_shared_secret"]); secret != "" {
+		request.AuthenticationSettings = &isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDeviceAuthenticationSettings{
+			NetworkProtocol:    "RADIUS",
+			RadiusSharedSecret: secret,
+		}
+	}
+	if secret := interfaceToString(device["tacacs_shared_secret"]); secret != "" {
+		request.TacacsSettings = &isegosdk.RequestNetworkDeviceCreateNetworkDeviceNetworkDeviceTacacsSettings{
+			SharedSecret:       secret,
+			ConnectModeOptions: interfaceToString(device["tacacs_connect_mode_options"]),
+		}
+	}
+	return &request
+}
+
+// networkDevicesSourceItem reads a device from a source record. Groups and
+// addresses are lists, each address an IP with an optional /mask.
+func networkDevicesSourceItem(record map[string]string) (map[string]interface{}, error) {
+	if record["name"] == "" {
+		return nil, fmt.Errorf("name is empty")
+	}
+	coaPort := 1700
+	if record["coa_port"] != "" {
+		port, err := strconv.Atoi(record["coa_port"])
+		if err != nil {
+			return nil, fmt.Errorf("coa_port: %v", err)
+		}
+		coaPort = port
+	}
+	ipList := []interface{}{}
+	for _, address := range sourceList(record["ip_addresses"]) {
+		ip, mask := address.(string), 32
+		if i := strings.Index(ip, "/"); i >= 0 {
+			var err error
+			if mask, err = strconv.Atoi(ip[i+1:]); err != nil {
+				return nil, fmt.Errorf("ip_addresses: bad mask in %s", ip)
+			}
+			ip = ip[:i]
+		}
+		ipList = append(ipList, map[string]interface{}{
+			"ipaddress":             ip,
+			"mask":                  mask,
+			"get_ipaddress_exclude": "",
+		})
+	}
+	if len(ipList) == 0 {
+		return nil, fmt.Errorf("ip_addresses is empty")
+	}
+	return map[string]interface{}{
+		"name":                        record["name"],
+		"description":                 record["description"],
+		"profile_name":                record["profile_name"],
+		"model_name":                  record["model_name"],
+		"software_version":            record["software_version"],
+		"coa_port":                    coaPort,
+		"network_device_group_list":   sourceList(record["network_device_groups"]),
+		"network_device_iplist":       ipList,
+		"radius_shared_secret":        record["radius_shared_secret"],
+		"tacacs_shared_secret":        record["tacacs_shared_secret"],
+		"tacacs_connect_mode_options": record["tacacs_connect_mode_options"],
+	}, nil
+}
@@ -0,0 +1,190 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDiffItemsByName(t *testing.T) {
+	prior := map[string]map[string]interface{}{
+		"switch1": {"name": "switch1", "description": "access"},
+		"switch2": {"name": "switch2", "description": "access"},
+		"switch3": {"name": "switch3", "description": "access"},
+	}
+	planned := map[string]map[string]interface{}{
+		"switch1": {"name": "switch1", "description": "access"},
+		"switch2": {"name": "switch2", "description": "core"},
+		"switch4": {"name": "switch4", "description": "access"},
+		"switch5": {"name": "switch5", "description": "access"},
+	}
+	deviceIDs := map[string]string{"switch1": "1", "switch2": "2", "switch3": "3"}
+	create, update, remove := diffItemsByName(prior, planned, deviceIDs)
+	if !reflect.DeepEqual(create, []string{"switch4", "switch5"}) {
+		t.Errorf("bad: unexpected creates %v", create)
+	}
+	if !reflect.DeepEqual(update, []string{"switch2"}) {
+		t.Errorf("bad: unexpected updates %v", update)
+	}
+	if !reflect.DeepEqual(remove, []string{"switch3"}) {
+		t.Errorf("bad: unexpected deletes %v", remove)
+	}
+}
+
+func TestNetworkDeviceGroupFilterField(t *testing.T) {
+	tests := map[string]string{
+		"Location#All Locations#Site1": "location",
+		"Device Type#All Device Types": "type",
+		"IPSEC#Is IPSEC Device#No":     "",
+	}
+	for group, want := range tests {
+		field, err := networkDeviceGroupFilterField(group)
+		if field != want || (err != nil) != (want == "") {
+			t.Errorf("bad: expect %q for %s, got %q, %v", want, group, field, err)
+		}
+	}
+}
+
+func TestNetworkDeviceBulkRequestBody(t *testing.T) {
+	device := map[string]interface{}{
+		"name":                      "switch1",
+		"coa_port":                  1700,
+		"network_device_group_list": []interface{}{"Location#All Locations#Site1"},
+		"network_device_iplist": []interface{}{
+			map[string]interface{}{"ipaddress": "10.0.0.1", "mask": 32},
+		},
+		"radius_shared_secret": "secret",
+	}
+	items := networkDevicesBulkItems([]string{"switch1"}, map[string]string{"switch1": "1"}, map[string]map[string]interface{}{"switch1": device}, true)
+	request := ersBulkRequestBody{
+		OperationType:     "update",
+		ResourceMediaType: networkDeviceBulkType.mediaType,
+		ResourcesList:     []interface{}{map[string]interface{}{networkDeviceBulkType.name: items["switch1"]}},
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, want := range []string{`"id":"1"`, `"name":"switch1"`, `"coaPort":1700`, `"ipaddress":"10.0.0.1"`, `"radiusSharedSecret":"secret"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("bad: expect %s in %s", want, data)
+		}
+	}
+	if strings.Contains(string(data), "idList") {
+		t.Errorf("bad: expect no idList in an update, got %s", data)
+	}
+}
+
+func TestFlattenNetworkDevicesDevice(t *testing.T) {
+	coaPort, mask := 3799, 24
+	item := &isegosdk.ResponseNetworkDeviceGetNetworkDeviceByIDNetworkDevice{
+		Name:                   "switch1",
+		Description:            "core",
+		ModelName:              "C9300",
+		CoaPort:                &coaPort,
+		NetworkDeviceGroupList: []string{"Location#All Locations", "Device Type#All Device Types"},
+		NetworkDeviceIPList: &[]isegosdk.ResponseNetworkDeviceGetNetworkDeviceByIDNetworkDeviceNetworkDeviceIPList{
+			{IPaddress: "10.0.0.0", Mask: &mask},
+		},
+		TacacsSettings: &isegosdk.ResponseNetworkDeviceGetNetworkDeviceByIDNetworkDeviceTacacsSettings{ConnectModeOptions: "OFF"},
+	}
+	device := flattenNetworkDevicesDevice(item, map[string]interface{}{
+		"name":                        "switch1",
+		"description":                 "access",
+		"coa_port":                    1700,
+		"network_device_group_list":   []interface{}{},
+		"tacacs_connect_mode_options": "",
+		"radius_shared_secret":        "secret",
+	})
+	if device["description"] != "core" || device["model_name"] != "C9300" || device["coa_port"] != 3799 {
+		t.Errorf("bad: expect the attributes read, got %v", device)
+	}
+	if ipList := device["network_device_iplist"].([]interface{}); len(ipList) != 1 || ipList[0].(map[string]interface{})["mask"] != 24 {
+		t.Errorf("bad: unexpected addresses %v", ipList)
+	}
+	if groups := device["network_device_group_list"].([]interface{}); len(groups) != 0 || device["tacacs_connect_mode_options"] != "" {
+		t.Errorf("bad: expect the default groups and mode not to be refreshed when not declared, got %v", device)
+	}
+	if device["radius_shared_secret"] != "secret" {
+		t.Errorf("bad: expect the shared secret to be kept, got %v", device["radius_shared_secret"])
+	}
+
+	device = flattenNetworkDevicesDevice(item, map[string]interface{}{
+		"network_device_group_list": []interface{}{"Location#All Locations"},
+	})
+	if groups := device["network_device_group_list"].([]interface{}); len(groups) != 2 {
+		t.Errorf("bad: expect the declared groups to be refreshed, got %v", groups)
+	}
+}
+
+func TestReadNetworkDevices(t *testing.T) {
+	var byID []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/ers/config/networkdevice":
+			resources := `[{"id": "1", "name": "switch1", "description": "access"}, {"id": "22", "name": "switch2", "description": "access"}, {"id": "3", "name": "switch3", "description": "access"}]`
+			if filter := r.URL.Query().Get("filter"); strings.Contains(filter, ".STARTSW.") {
+				resources = `[]`
+			} else if filter != "" {
+				resources = `[{"id": "3", "name": "switch3", "description": "access"}]`
+			}
+			w.Write([]byte(`{"SearchResult": {"total": 3, "resources": ` + resources + `}}`))
+		case strings.HasPrefix(r.URL.Path, "/ers/config/networkdevice/"):
+			byID = append(byID, strings.TrimPrefix(r.URL.Path, "/ers/config/networkdevice/"))
+			w.Write([]byte(`{"NetworkDevice": {"id": "22", "name": "switch2", "description": "access", "modelName": "C9300"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	d := schema.TestResourceDataRaw(t, resourceNetworkDevices().Schema, map[string]interface{}{
+		"authoritative_network_device_group": "Location#All Locations#Site1",
+		"device": []interface{}{
+			map[string]interface{}{"name": "switch1", "description": "access", "model_name": "C9200"},
+			map[string]interface{}{"name": "switch2", "description": "access", "model_name": "C9200"},
+		},
+	})
+	d.SetId("1")
+	_ = d.Set("device_ids", map[string]interface{}{"switch1": "1", "switch2": "2"})
+
+	if diags := resourceNetworkDevicesRead(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if !reflect.DeepEqual(byID, []string{"22"}) {
+		t.Errorf("bad: expect only the device whose ID changed to be read by ID, got %v", byID)
+	}
+	devices := itemsByName(d.Get("device").(*schema.Set))
+	if devices["switch1"]["model_name"] != "C9200" || devices["switch2"]["model_name"] != "C9300" {
+		t.Errorf("bad: unexpected devices %v", devices)
+	}
+	if _, ok := devices["switch3"]; !ok {
+		t.Errorf("bad: expect the device of the group which is not declared to be shown, got %v", devices)
+	}
+	deviceIDs := d.Get("device_ids").(map[string]interface{})
+	if !reflect.DeepEqual(deviceIDs, map[string]interface{}{"switch1": "1", "switch2": "22"}) {
+		t.Errorf("bad: expect only the declared devices to be recorded for destroy, got %v", deviceIDs)
+	}
+
+	// A refresh keeps the device not declared out of the recorded ones
+	byID = nil
+	if diags := resourceNetworkDevicesRead(ctx, d, clientConfig); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if len(byID) != 0 || d.Get("device_ids.switch3") != "" {
+		t.Errorf("bad: unexpected reads by ID %v, device_ids %v", byID, d.Get("device_ids"))
+	}
+}
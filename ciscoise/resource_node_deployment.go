@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,8 +32,11 @@ standalone node.
 		ReadContext:   resourceNodeDeploymentRead,
 		UpdateContext: resourceNodeDeploymentUpdate,
 		DeleteContext: resourceNodeDeploymentDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		Importer:      importerByNameOrID(resourceNodeDeploymentRead, "", "hostname"),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(NODE_DEPLOYMENT_TIMEOUT),
+			Update: schema.DefaultTimeout(NODE_DEPLOYMENT_TIMEOUT),
+			Delete: schema.DefaultTimeout(NODE_DEPLOYMENT_TIMEOUT),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -159,7 +161,7 @@ standalone node.
 }
 
 func resourceNodeDeploymentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeDeployment create")
+	logDebugf(ctx, "Beginning NodeDeployment create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -168,7 +170,7 @@ func resourceNodeDeploymentCreate(ctx context.Context, d *schema.ResourceData, m
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNodeDeploymentRegisterNode(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vHostname, okHostname := resourceItem["hostname"]
@@ -198,7 +200,18 @@ func resourceNodeDeploymentCreate(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 	}
-	resp1, restyResp1, err := client.NodeDeployment.RegisterNode(request1)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var resp1 *isegosdk.ResponseNodeDeploymentRegisterNode
+	var restyResp1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		resp1, restyResp1, err = client.NodeDeployment.RegisterNode(request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for RegisterNode", errWait))
+		return diags
+	}
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
 			diags = append(diags, diagErrorWithResponse(
@@ -217,7 +230,7 @@ func resourceNodeDeploymentCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeDeployment read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeDeployment read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -230,26 +243,26 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 	vFQDN, _ := resourceMap["fqdn"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostname}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetDeploymentNodes")
+		logDebugf(ctx, "Selected method: GetDeploymentNodes")
 		queryParams1 := isegosdk.GetDeploymentNodesQueryParams{}
 
 		response1, restyResp1, err := client.NodeDeployment.GetDeploymentNodes(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNodeDeploymentGetDeploymentNodes(m, response1)
 		item1, err := searchNodeDeploymentGetDeploymentNodes(m, items1, vHostname, vFQDN, "")
@@ -264,7 +277,7 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetDeploymentNodes search response",
 				err))
@@ -273,20 +286,20 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNodeDetails")
+		logDebugf(ctx, "Selected method: GetNodeDetails")
 		vvHostname := vHostname
 
 		response2, restyResp2, err := client.NodeDeployment.GetNodeDetails(vvHostname)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNodeDeploymentGetNodeDetailsItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -295,7 +308,7 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNodeDetails response",
 				err))
@@ -308,7 +321,7 @@ func resourceNodeDeploymentRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeDeployment update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeDeployment update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -321,9 +334,9 @@ func resourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m
 	vFQDN, _ := resourceMap["fqdn"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostname}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvHostname string
@@ -346,15 +359,26 @@ func resourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvHostname = vHostname
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vvHostname)
+		logDebugf(ctx, "Name used for update operation %s", vvHostname)
 		request1 := expandRequestNodeDeploymentUpdateDeploymentNode(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+		var response1 *isegosdk.ResponseNodeDeploymentUpdateDeploymentNode
+		var restyResp1 *resty.Response
+		var err error
+		if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+			response1, restyResp1, err = client.NodeDeployment.UpdateDeploymentNode(vvHostname, request1)
+		}); errWait != nil {
+			diags = append(diags, diagError(
+				"Failure when waiting for UpdateDeploymentNode", errWait))
+			return diags
 		}
-		response1, restyResp1, err := client.NodeDeployment.UpdateDeploymentNode(vvHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateDeploymentNode", err, restyResp1.String(),
 					"Failure at UpdateDeploymentNode, unexpected response", ""))
@@ -372,7 +396,7 @@ func resourceNodeDeploymentUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceNodeDeploymentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeDeployment delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeDeployment delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -385,9 +409,9 @@ func resourceNodeDeploymentDelete(ctx context.Context, d *schema.ResourceData, m
 	vFQDN, _ := resourceMap["fqdn"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostname}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvHostname string
@@ -419,10 +443,21 @@ func resourceNodeDeploymentDelete(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 	}
-	response1, restyResp1, err := client.NodeDeployment.DeleteDeploymentNode(vvHostname)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	var response1 *isegosdk.ResponseNodeDeploymentDeleteDeploymentNode
+	var restyResp1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, restyResp1, err = client.NodeDeployment.DeleteDeploymentNode(vvHostname)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for DeleteDeploymentNode", errWait))
+		return diags
+	}
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteDeploymentNode", err, restyResp1.String(),
 				"Failure at DeleteDeploymentNode, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,8 +19,12 @@ Approximate execution time 300 seconds.
 		CreateContext: resourceNodeDeploymentSyncCreate,
 		ReadContext:   resourceNodeDeploymentSyncRead,
 		DeleteContext: resourceNodeDeploymentSyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": waitForTaskSchema(),
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -68,8 +70,8 @@ Approximate execution time 300 seconds.
 }
 
 func resourceNodeDeploymentSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SyncNode create")
-	log.Printf("[DEBUG] Missing SyncNode create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning SyncNode create")
+	logDebugf(ctx, "Missing SyncNode create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -80,7 +82,7 @@ func resourceNodeDeploymentSyncCreate(ctx context.Context, d *schema.ResourceDat
 	response1, restyResp1, err := client.NodeDeployment.SyncNode(vvHostname)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SyncNode", err, restyResp1.String(),
 				"Failure at SyncNode, unexpected response", ""))
@@ -92,7 +94,7 @@ func resourceNodeDeploymentSyncCreate(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenNodeDeploymentSyncNodeItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -101,6 +103,13 @@ func resourceNodeDeploymentSyncCreate(ctx context.Context, d *schema.ResourceDat
 			err))
 		return diags
 	}
+	if d.Get("wait_for_completion").(bool) && response1.Response != nil {
+		taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if diags := waitForTask(taskCtx, client, response1.Response.ID, "SyncNode"); diags.HasError() {
+			return diags
+		}
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
@@ -113,9 +122,9 @@ func resourceNodeDeploymentSyncRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceNodeDeploymentSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeDeploymenySync delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeDeploymenySync delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NodeDeploymenySync delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NodeDeploymenySync delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,9 +31,7 @@ longer carried out among the nodes.
 		ReadContext:   resourceNodeGroupRead,
 		UpdateContext: resourceNodeGroupUpdate,
 		DeleteContext: resourceNodeGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNodeGroupRead, "", "node_group_name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -160,7 +156,7 @@ longer carried out among the nodes.
 }
 
 func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroup create")
+	logDebugf(ctx, "Beginning NodeGroup create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -169,7 +165,7 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, m inte
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestNodeGroupCreateNodeGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vNodeGroupName, okNodeGroupName := resourceItem["node_group_name"]
@@ -213,7 +209,7 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroup read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeGroup read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -224,26 +220,26 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 	vNodeGroupName, okNodeGroupName := resourceMap["node_group_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okNodeGroupName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvNodeGroupName := vNodeGroupName
-		log.Printf("[DEBUG] Selected method: GetNodeGroups")
+		logDebugf(ctx, "Selected method: GetNodeGroups")
 
 		response1, restyResp1, err := client.NodeGroup.GetNodeGroups()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNodeGroupGetNodeGroups(m, response1)
 		item1, err := searchNodeGroupGetNodeGroups(m, items1, vvNodeGroupName, "")
@@ -258,7 +254,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNodeGroups search response",
 				err))
@@ -267,20 +263,20 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetNodeGroup")
+		logDebugf(ctx, "Selected method: GetNodeGroup")
 		vvNodeGroupName := vNodeGroupName
 
 		response2, restyResp2, err := client.NodeGroup.GetNodeGroup(vvNodeGroupName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNodeGroupGetNodeGroupItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -289,7 +285,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNodeGroup response",
 				err))
@@ -302,7 +298,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroup update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeGroup update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -313,9 +309,9 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vNodeGroupName, okNodeGroupName := resourceMap["node_group_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okNodeGroupName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvNodeGroupName string
@@ -338,15 +334,15 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		vvNodeGroupName = vNodeGroupName
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vvNodeGroupName)
+		logDebugf(ctx, "Name used for update operation %s", vvNodeGroupName)
 		request1 := expandRequestNodeGroupUpdateNodeGroup(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NodeGroup.UpdateNodeGroup(vvNodeGroupName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNodeGroup", err, restyResp1.String(),
 					"Failure at UpdateNodeGroup, unexpected response", ""))
@@ -364,7 +360,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeGroup delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -375,9 +371,9 @@ func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vNodeGroupName, okNodeGroupName := resourceMap["node_group_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okNodeGroupName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvNodeGroupName string
@@ -409,7 +405,7 @@ func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, m inte
 	response1, restyResp1, err := client.NodeGroup.DeleteNodeGroup(vvNodeGroupName, nil)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNodeGroup", err, restyResp1.String(),
 				"Failure at DeleteNodeGroup, unexpected response", ""))
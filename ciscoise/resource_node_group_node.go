@@ -5,8 +5,6 @@ import (
 
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,9 +28,7 @@ delete the node, but failover is no longer carried out if the node is not part a
 		ReadContext:   resourceNodeGroupNodeRead,
 		UpdateContext: resourceNodeGroupNodeCreate,
 		DeleteContext: resourceNodeGroupNodeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByPath(resourceNodeGroupNodeRead, []string{"node_group_name", "hostname"}, ""),
 
 		Schema: map[string]*schema.Schema{
 			"parameters": &schema.Schema{
@@ -90,7 +86,7 @@ delete the node, but failover is no longer carried out if the node is not part a
 }
 
 func resourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroupNode read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeGroupNode read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -103,7 +99,7 @@ func resourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m in
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNodes")
+		logDebugf(ctx, "Selected method: GetNodes")
 		vvNodeGroupName := interfaceToString(vNodeGroupName)
 		vvHostname := interfaceToString(vHostname)
 
@@ -111,13 +107,13 @@ func resourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m in
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		response_nodes, err := searchNodeGroupGetNodes(m, response1.Response, vvHostname)
 		if err != nil || response_nodes == nil || len(*response_nodes) == 0 {
@@ -137,7 +133,7 @@ func resourceNodeGroupNodeRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceNodeGroupNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeGroupNode create")
+	logDebugf(ctx, "Beginning NodeGroupNode create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -164,17 +160,17 @@ func resourceNodeGroupNodeCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: AddNode")
+		logDebugf(ctx, "Selected method: AddNode")
 		request1 := expandRequestNodeGroupNodeCreateAddNode(ctx, "parameters.0", d)
 		response1, restyResp1, err := client.NodeGroup.AddNode(vvNodeGroupName, request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing AddNode", err,
@@ -182,7 +178,7 @@ func resourceNodeGroupNodeCreate(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeGroupAddNodeItem(response1)
 		if err := d.Set("item", vItem1); err != nil {
@@ -232,19 +228,19 @@ func resourceNodeGroupNodeDelete(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: RemoveNode")
+		logDebugf(ctx, "Selected method: RemoveNode")
 		vvNodeGroupName := vNodeGroupName.(string)
 		request1 := expandRequestNodeGroupNodeDeleteRemoveNode(ctx, "parameters.0", d)
 
 		response1, restyResp1, err := client.NodeGroup.RemoveNode(vvNodeGroupName, request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing RemoveNode", err,
@@ -252,7 +248,7 @@ func resourceNodeGroupNodeDelete(ctx context.Context, d *schema.ResourceData, m
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeGroupRemoveNodeItem(response1)
 		if err := d.Set("item", vItem1); err != nil {
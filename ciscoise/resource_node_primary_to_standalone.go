@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -69,8 +67,8 @@ node.
 }
 
 func resourceNodePrimaryToStandaloneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning MakeStandalone create")
-	log.Printf("[DEBUG] Missing MakeStandalone create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning MakeStandalone create")
+	logDebugf(ctx, "Missing MakeStandalone create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -78,14 +76,14 @@ func resourceNodePrimaryToStandaloneCreate(ctx context.Context, d *schema.Resour
 	response1, restyResp1, err := client.NodeDeployment.MakeStandalone()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing MakeStandalone", err,
 			"Failure at MakeStandalone, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenNodeDeploymentMakeStandaloneItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -105,9 +103,9 @@ func resourceNodePrimaryToStandaloneRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNodePrimaryToStandaloneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodePrimaryToStandalone delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodePrimaryToStandalone delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NodePrimaryToStandalone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NodePrimaryToStandalone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
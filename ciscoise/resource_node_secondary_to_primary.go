@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -70,8 +68,8 @@ setting is enabled in the secondary PAN.
 }
 
 func resourceNodeSecondaryToPrimaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PromoteNode create")
-	log.Printf("[DEBUG] Missing PromoteNode create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning PromoteNode create")
+	logDebugf(ctx, "Missing PromoteNode create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -80,7 +78,7 @@ func resourceNodeSecondaryToPrimaryCreate(ctx context.Context, d *schema.Resourc
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing PromoteNode", err,
@@ -108,9 +106,9 @@ func resourceNodeSecondaryToPrimaryRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceNodeSecondaryToPrimaryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeSecondaryToPrimary delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeSecondaryToPrimary delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NodeSecondaryToPrimary delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NodeSecondaryToPrimary delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,9 +38,7 @@ Ex: Below payload will disable NMAP, PxGrid and SNMPTRAP probes
 		ReadContext:   resourceNodeServicesProfilerProbeConfigRead,
 		UpdateContext: resourceNodeServicesProfilerProbeConfigUpdate,
 		DeleteContext: resourceNodeServicesProfilerProbeConfigDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNodeServicesProfilerProbeConfigRead, "", "hostname"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -508,8 +504,8 @@ Ex: Below payload will disable NMAP, PxGrid and SNMPTRAP probes
 }
 
 func resourceNodeServicesProfilerProbeConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesProfilerProbeConfig create")
-	log.Printf("[DEBUG] Missing NodeServicesProfilerProbeConfig create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning NodeServicesProfilerProbeConfig create")
+	logDebugf(ctx, "Missing NodeServicesProfilerProbeConfig create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -519,15 +515,15 @@ func resourceNodeServicesProfilerProbeConfigCreate(ctx context.Context, d *schem
 	resourceMap := make(map[string]string)
 	vHostname := interfaceToString(resourceItem["hostname"])
 	vvHostname := vHostname
-	log.Printf("[DEBUG] Name used for update operation %s", vHostname)
+	logDebugf(ctx, "Name used for update operation %s", vHostname)
 	request1 := expandRequestNodeServicesProfilerProbeConfigSetProfilerProbeConfig(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.NodeServices.SetProfilerProbeConfig(vvHostname, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SetProfilerProbeConfig", err, restyResp1.String(),
 				"Failure at SetProfilerProbeConfig, unexpected response", ""))
@@ -544,7 +540,7 @@ func resourceNodeServicesProfilerProbeConfigCreate(ctx context.Context, d *schem
 }
 
 func resourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesProfilerProbeConfig read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesProfilerProbeConfig read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -556,20 +552,20 @@ func resourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schema.
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetProfilerProbeConfig")
+		logDebugf(ctx, "Selected method: GetProfilerProbeConfig")
 		vvHostname := vHostname
 
 		response1, restyResp1, err := client.NodeServices.GetProfilerProbeConfig(vvHostname)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeServicesGetProfilerProbeConfigItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -578,7 +574,7 @@ func resourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schema.
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetProfilerProbeConfig response",
 				err))
@@ -591,7 +587,7 @@ func resourceNodeServicesProfilerProbeConfigRead(ctx context.Context, d *schema.
 }
 
 func resourceNodeServicesProfilerProbeConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesProfilerProbeConfig update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesProfilerProbeConfig update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -603,15 +599,15 @@ func resourceNodeServicesProfilerProbeConfigUpdate(ctx context.Context, d *schem
 
 	var vvHostname string = vHostname
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vHostname)
+		logDebugf(ctx, "Name used for update operation %s", vHostname)
 		request1 := expandRequestNodeServicesProfilerProbeConfigSetProfilerProbeConfig(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NodeServices.SetProfilerProbeConfig(vvHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SetProfilerProbeConfig", err, restyResp1.String(),
 					"Failure at SetProfilerProbeConfig, unexpected response", ""))
@@ -629,9 +625,9 @@ func resourceNodeServicesProfilerProbeConfigUpdate(ctx context.Context, d *schem
 }
 
 func resourceNodeServicesProfilerProbeConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesProfilerProbeConfig delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesProfilerProbeConfig delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NodeServicesProfilerProbeConfig delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NodeServicesProfilerProbeConfig delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestNodeServicesProfilerProbeConfigSetProfilerProbeConfig(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestNodeServicesSetProfilerProbeConfig {
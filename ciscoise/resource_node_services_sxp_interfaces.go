@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,9 +21,7 @@ func resourceNodeServicesSxpInterfaces() *schema.Resource {
 		ReadContext:   resourceNodeServicesSxpInterfacesRead,
 		UpdateContext: resourceNodeServicesSxpInterfacesUpdate,
 		DeleteContext: resourceNodeServicesSxpInterfacesDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceNodeServicesSxpInterfacesRead, "", "hostname"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -73,8 +69,8 @@ func resourceNodeServicesSxpInterfaces() *schema.Resource {
 }
 
 func resourceNodeServicesSxpInterfacesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesSxpInterfaces create")
-	log.Printf("[DEBUG] Missing NodeServicesSxpInterfaces create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning NodeServicesSxpInterfaces create")
+	logDebugf(ctx, "Missing NodeServicesSxpInterfaces create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -84,15 +80,15 @@ func resourceNodeServicesSxpInterfacesCreate(ctx context.Context, d *schema.Reso
 	resourceMap := make(map[string]string)
 
 	vHostname := interfaceToString(resourceItem["hostname"])
-	log.Printf("[DEBUG] Name used for update operation %s", vHostname)
+	logDebugf(ctx, "Name used for update operation %s", vHostname)
 	request1 := expandRequestNodeServicesSxpInterfacesSetSxpInterface(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.NodeServices.SetSxpInterface(vHostname, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing SetSxpInterface", err, restyResp1.String(),
 				"Failure at SetSxpInterface, unexpected response", ""))
@@ -110,7 +106,7 @@ func resourceNodeServicesSxpInterfacesCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesSxpInterfaces read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesSxpInterfaces read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -122,20 +118,20 @@ func resourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Resour
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSxpInterface")
+		logDebugf(ctx, "Selected method: GetSxpInterface")
 		vvHostname := vHostname
 
 		response1, restyResp1, err := client.NodeServices.GetSxpInterface(vvHostname)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenNodeServicesGetSxpInterfaceItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -144,7 +140,7 @@ func resourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Resour
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpInterface response",
 				err))
@@ -157,7 +153,7 @@ func resourceNodeServicesSxpInterfacesRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceNodeServicesSxpInterfacesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesSxpInterfaces update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesSxpInterfaces update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -168,15 +164,15 @@ func resourceNodeServicesSxpInterfacesUpdate(ctx context.Context, d *schema.Reso
 	vHostname, _ := resourceMap["hostname"]
 
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vHostname)
+		logDebugf(ctx, "Name used for update operation %s", vHostname)
 		request1 := expandRequestNodeServicesSxpInterfacesSetSxpInterface(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NodeServices.SetSxpInterface(vHostname, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing SetSxpInterface", err, restyResp1.String(),
 					"Failure at SetSxpInterface, unexpected response", ""))
@@ -194,9 +190,9 @@ func resourceNodeServicesSxpInterfacesUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceNodeServicesSxpInterfacesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning NodeServicesSxpInterfaces delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning NodeServicesSxpInterfaces delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing NodeServicesSxpInterfaces delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing NodeServicesSxpInterfaces delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestNodeServicesSxpInterfacesSetSxpInterface(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestNodeServicesSetSxpInterface {
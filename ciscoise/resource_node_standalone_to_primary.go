@@ -2,9 +2,9 @@ package ciscoise
 
 import (
 	"context"
-	"log"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,8 +24,7 @@ node (PAN).
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(HOTPATCH_INSTALL_TIMEOUT_SLEEP),
-			Delete: schema.DefaultTimeout(HOTPATCH_ROLLBACK_TIMEOUT_SLEEP),
+			Create: schema.DefaultTimeout(NODE_DEPLOYMENT_TIMEOUT),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,18 +73,27 @@ node (PAN).
 }
 
 func resourceNodeStandaloneToPrimaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	clientConfig := m.(ClientConfig)
-	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 
-	log.Printf("[DEBUG] Selected method: MakePrimary")
+	logDebugf(ctx, "Selected method: MakePrimary")
 
-	response1, restyResp1, err := client.NodeDeployment.MakePrimary()
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *isegosdk.ResponseNodeDeploymentMakePrimary
+	var restyResp1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, restyResp1, err = client.NodeDeployment.MakePrimary()
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for MakePrimary", errWait))
+		return diags
+	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing MakePrimary", err,
@@ -93,7 +101,7 @@ func resourceNodeStandaloneToPrimaryCreate(ctx context.Context, d *schema.Resour
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenNodeDeploymentMakePrimaryItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -153,8 +151,8 @@ secondary_health_check_node_hostname
 */
 
 func resourcePanHaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PanHa create")
-	log.Printf("[DEBUG] Missing PanHa create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning PanHa create")
+	logDebugf(ctx, "Missing PanHa create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -182,18 +180,18 @@ func resourcePanHaCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		}
 	}
 
-	log.Printf("[DEBUG] Selected method: UpdatePanHa")
+	logDebugf(ctx, "Selected method: UpdatePanHa")
 	request1 := expandRequestPanHaUpdateUpdatePanHa(ctx, "parameters.0", d)
 
 	response1, restyResp1, err := client.PanHa.UpdatePanHa(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing UpdatePanHa", err,
@@ -201,7 +199,7 @@ func resourcePanHaCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	resourceMap["id"] = getUnixTimeString()
 	resourceMap["primary_health_check_node_hostname"] = primary_health_check_node_hostname
@@ -211,7 +209,7 @@ func resourcePanHaCreate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PanHa read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PanHa read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -219,19 +217,19 @@ func resourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPanHaStatus")
+		logDebugf(ctx, "Selected method: GetPanHaStatus")
 
 		response1, restyResp1, err := client.PanHa.GetPanHaStatus()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPanHaGetPanHaStatusItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -240,7 +238,7 @@ func resourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interface{
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetPanHaStatus response",
 				err))
@@ -253,25 +251,25 @@ func resourcePanHaRead(ctx context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourcePanHaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PanHa update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PanHa update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Selected method: UpdatePanHa")
+		logDebugf(ctx, "Selected method: UpdatePanHa")
 		request1 := expandRequestPanHaUpdateUpdatePanHa(ctx, "parameters.0", d)
 
 		response1, restyResp1, err := client.PanHa.UpdatePanHa(request1)
 
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing UpdatePanHa", err,
@@ -279,7 +277,7 @@ func resourcePanHaUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 		d.Set("last_updated", getUnixTimeString())
 	}
 
@@ -287,9 +285,9 @@ func resourcePanHaUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourcePanHaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PanHa delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PanHa delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PanHa delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PanHa delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -2,10 +2,8 @@ package ciscoise
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-	"time"
-
-	"log"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
@@ -30,12 +28,10 @@ a certain period of time.
 		ReadContext:   resourcePatchRead,
 		UpdateContext: resourcePatchUpdate,
 		DeleteContext: resourcePatchDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourcePatchRead, "", "patch_number"),
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(PATCH_INSTALL_TIMEOUT_SLEEP),
-			Delete: schema.DefaultTimeout(PATCH_ROLLBACK_TIMEOUT_SLEEP),
+			Create: schema.DefaultTimeout(PATCH_INSTALL_TIMEOUT),
+			Delete: schema.DefaultTimeout(PATCH_ROLLBACK_TIMEOUT),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,7 +90,7 @@ a certain period of time.
 }
 
 func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Patch create")
+	logDebugf(ctx, "Beginning Patch create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -111,7 +107,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 	if okPatchName {
 		vvPatchName = vPatchName.(string)
 	}
-	log.Printf("[DEBUG] Patch create params %d %s", vPatchNumber, vvPatchName)
+	logDebugf(ctx, "Patch create params %d %s", vPatchNumber, vvPatchName)
 
 	getResponse1, _, err := client.Patching.ListInstalledPatches()
 	if err == nil && getResponse1 != nil {
@@ -124,23 +120,23 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 			return resourcePatchRead(ctx, d, m)
 		}
 		if err != nil {
-			log.Printf("[DEBUG] ListInstalledPatches searchPatch Error: %s", err.Error())
+			logDebugf(ctx, "ListInstalledPatches searchPatch Error: %s", err.Error())
 		}
 	}
 	if err != nil {
-		log.Printf("[DEBUG] ListInstalledPatches Error: %s", err.Error())
+		logDebugf(ctx, "ListInstalledPatches Error: %s", err.Error())
 	}
 
 	request1 := expandRequestPatchInstallInstallPatch(ctx, "parameters.0", d)
 	response1, restyResp1, err := client.Patching.InstallPatch(request1)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if response1 != nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			diags = append(diags, diagErrorWithAlt(
 				"Failure when executing InstallPatch", err,
@@ -153,31 +149,26 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", restyResp1.String())
+	logDebugf(ctx, "Retrieved response %s", restyResp1.String())
 
+	taskID := ""
 	if response1.Response != nil {
-		taskID := response1.Response.ID
-		if taskID != "" {
-			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
-			if err != nil || taskResponse == nil {
-				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-				}
-				diags = append(diags, diagErrorWithAlt(
-					"Failure when executing GetTaskStatusByID", err,
-					"Failure at GetTaskStatusByID, unexpected response", ""))
-				return diags
-			}
-			for err != nil || taskResponse == nil || taskResponse.ExecutionStatus == "IN_PROGRESS" {
-				time.Sleep(PATCH_INSTALL_TIMEOUT_SLEEP)
-				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
-				if err != nil || taskResponse == nil {
-					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-					}
-				}
-			}
+		taskID = response1.Response.ID
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	errWait := patchWaiter.wait(waitCtx, fmt.Sprintf("patch %d installation", vvPatchNumber), patchCheck(ctx, client, taskID, true, func() (bool, error) {
+		getResponse, _, err := client.Patching.ListInstalledPatches()
+		if err != nil {
+			return false, err
 		}
+		item, err := searchPatch(m, getResponse, &vvPatchNumber)
+		return item != nil, err
+	}))
+	if errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for InstallPatch", errWait))
+		return diags
 	}
 
 	resourceMap := make(map[string]string)
@@ -188,7 +179,7 @@ func resourcePatchCreate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourcePatchRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Patch read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Patch read for id=[%s]", d.Id())
 
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
@@ -203,12 +194,12 @@ func resourcePatchRead(ctx context.Context, d *schema.ResourceData, m interface{
 	response1, restyResp1, err := client.Patching.ListInstalledPatches()
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	item1, err := searchPatch(m, response1, vvPatchNumber)
 	if err != nil || item1 == nil {
 		d.SetId("")
@@ -226,14 +217,14 @@ func resourcePatchRead(ctx context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourcePatchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Patch update for id=[%s]", d.Id())
-	log.Printf("[DEBUG] Missing Patch update on Cisco ISE. It will only be update it on Terraform")
+	logDebugf(ctx, "Beginning Patch update for id=[%s]", d.Id())
+	logDebugf(ctx, "Missing Patch update on Cisco ISE. It will only be update it on Terraform")
 	// _ = d.Set("last_updated", getUnixTimeString())
 	return resourcePatchRead(ctx, d, m)
 }
 
 func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Patch delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Patch delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -247,13 +238,13 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	getResp1, restyResp1, err := client.Patching.ListInstalledPatches()
 	if err != nil || getResp1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*getResp1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*getResp1))
 	item1, err := searchPatch(m, getResp1, vvPatchNumber)
 	if err != nil || item1 == nil {
 		d.SetId("")
@@ -265,12 +256,12 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	response1, restyResp1, err := client.Patching.RollbackPatch(request1)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing RollbackPatch", err,
@@ -278,31 +269,26 @@ func resourcePatchDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
+	taskID := ""
 	if response1.Response != nil {
-		taskID := response1.Response.ID
-		if taskID != "" {
-			taskResponse, restyResp2, err := client.Tasks.GetTaskStatusByID(taskID)
-			if err != nil || taskResponse == nil {
-				if restyResp2 != nil {
-					log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-				}
-				diags = append(diags, diagErrorWithAlt(
-					"Failure when executing GetTaskStatusByID", err,
-					"Failure at GetTaskStatusByID, unexpected response", ""))
-				return diags
-			}
-			for err != nil || taskResponse == nil || taskResponse.ExecutionStatus == "IN_PROGRESS" {
-				time.Sleep(PATCH_ROLLBACK_TIMEOUT_SLEEP)
-				taskResponse, restyResp2, err = client.Tasks.GetTaskStatusByID(taskID)
-				if err != nil || taskResponse == nil {
-					if restyResp2 != nil {
-						log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
-					}
-				}
-			}
+		taskID = response1.Response.ID
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	errWait := patchWaiter.wait(waitCtx, fmt.Sprintf("patch %d rollback", *vvPatchNumber), patchCheck(ctx, client, taskID, false, func() (bool, error) {
+		getResponse, _, err := client.Patching.ListInstalledPatches()
+		if err != nil {
+			return false, err
 		}
+		item, err := searchPatch(m, getResponse, vvPatchNumber)
+		return item != nil, err
+	}))
+	if errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for RollbackPatch", errWait))
+		return diags
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -70,9 +68,12 @@ func resourcePersonasCheckStandalone() *schema.Resource {
 }
 
 func resourcePersonasCheckStandaloneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasCheckStandalone")
+	logDebugf(ctx, "Beginning PersonasCheckStandalone")
 	var diags diag.Diagnostics
+	clientConfig := m.(ClientConfig)
 	node := expandRequestPersonasCheckStandalone(ctx, "parameters.0", d)
+	node.config = clientConfig.Config
+	node.ctx = ctx
 	isStandAlone, err := node.IsStandAlone()
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
@@ -111,9 +112,9 @@ func resourcePersonasCheckStandaloneRead(ctx context.Context, d *schema.Resource
 }
 
 func resourcePersonasCheckStandaloneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasCheckStandalone delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PersonasCheckStandalone delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PersonasCheckStandalone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PersonasCheckStandalone delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -95,10 +93,15 @@ func resourcePersonasExportCerts() *schema.Resource {
 }
 
 func resourcePersonasExportCertsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasExportCerts")
+	logDebugf(ctx, "Beginning PersonasExportCerts")
 	var diags diag.Diagnostics
+	clientConfig := m.(ClientConfig)
 	node := expandRequestPersonasExportCerts(ctx, "parameters.0", d)
+	node.config = clientConfig.Config
+	node.ctx = ctx
 	primaryNode := expandRequestPersonasExportCertsPrimary(ctx, "parameters.0", d)
+	primaryNode.config = clientConfig.Config
+	primaryNode.ctx = ctx
 
 	err := node.ImportCertificateIntoPrimary(primaryNode)
 
@@ -128,9 +131,9 @@ func resourcePersonasExportCertsRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePersonasExportCertsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasExportCerts delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PersonasExportCerts delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PersonasExportCerts delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PersonasExportCerts delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
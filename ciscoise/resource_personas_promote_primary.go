@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -64,9 +62,12 @@ func resourcePersonasPromotePrimary() *schema.Resource {
 }
 
 func resourcePersonasPromotePrimaryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasPromotePrimary")
+	logDebugf(ctx, "Beginning PersonasPromotePrimary")
 	var diags diag.Diagnostics
+	clientConfig := m.(ClientConfig)
 	node := expandRequestPersonasPromotePrimary(ctx, "parameters.0", d)
+	node.config = clientConfig.Config
+	node.ctx = ctx
 
 	err := node.PromoteToPrimary()
 
@@ -95,9 +96,9 @@ func resourcePersonasPromotePrimaryRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourcePersonasPromotePrimaryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasPromotePrimary delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PersonasPromotePrimary delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PersonasPromotePrimary delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PersonasPromotePrimary delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -101,10 +99,15 @@ func resourcePersonasRegisterNode() *schema.Resource {
 }
 
 func resourcePersonasRegisterNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasRegisterNode")
+	logDebugf(ctx, "Beginning PersonasRegisterNode")
 	var diags diag.Diagnostics
+	clientConfig := m.(ClientConfig)
 	node := expandRequestPersonasRegisterNode(ctx, "parameters.0", d)
+	node.config = clientConfig.Config
+	node.ctx = ctx
 	primaryNode := expandRequestPersonasRegisterNodePrimary(ctx, "parameters.0", d)
+	primaryNode.config = clientConfig.Config
+	primaryNode.ctx = ctx
 
 	primaryAppServerIsRunning, err := primaryNode.AppServerIsRunning()
 	if err != nil {
@@ -147,9 +150,9 @@ func resourcePersonasRegisterNodeRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePersonasRegisterNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasRegisterNode delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PersonasRegisterNode delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PersonasRegisterNode delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PersonasRegisterNode delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -88,9 +86,12 @@ func resourcePersonasUpdateRolesServices() *schema.Resource {
 }
 
 func resourcePersonasUpdateRolesServicesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasUpdateRolesServices")
+	logDebugf(ctx, "Beginning PersonasUpdateRolesServices")
 	var diags diag.Diagnostics
+	clientConfig := m.(ClientConfig)
 	node := expandRequestPersonasUpdateRolesServices(ctx, "parameters.0", d)
+	node.config = clientConfig.Config
+	node.ctx = ctx
 	err := node.UpdateRolesServices()
 	if err != nil {
 		diags = append(diags, diagErrorWithAlt(
@@ -116,9 +117,9 @@ func resourcePersonasUpdateRolesServicesRead(ctx context.Context, d *schema.Reso
 }
 
 func resourcePersonasUpdateRolesServicesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PersonasUpdateRolesServices delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PersonasUpdateRolesServices delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PersonasUpdateRolesServices delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PersonasUpdateRolesServices delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
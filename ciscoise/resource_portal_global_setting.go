@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,9 +22,7 @@ func resourcePortalGlobalSetting() *schema.Resource {
 		ReadContext:   resourcePortalGlobalSettingRead,
 		UpdateContext: resourcePortalGlobalSettingUpdate,
 		DeleteContext: resourcePortalGlobalSettingDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourcePortalGlobalSettingRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -126,8 +122,8 @@ func resourcePortalGlobalSetting() *schema.Resource {
 }
 
 func resourcePortalGlobalSettingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalGlobalSetting create")
-	log.Printf("[DEBUG] Missing PortalGlobalSetting create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning PortalGlobalSetting create")
+	logDebugf(ctx, "Missing PortalGlobalSetting create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -136,15 +132,15 @@ func resourcePortalGlobalSettingCreate(ctx context.Context, d *schema.ResourceDa
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
 	vvID := interfaceToString(resourceItem["id"])
-	log.Printf("[DEBUG] ID used for update operation %s", vvID)
+	logDebugf(ctx, "ID used for update operation %s", vvID)
 	request1 := expandRequestPortalGlobalSettingUpdatePortalGlobalSettingByID(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.PortalGlobalSetting.UpdatePortalGlobalSettingByID(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdatePortalGlobalSettingByID", err, restyResp1.String(),
 				"Failure at UpdatePortalGlobalSettingByID, unexpected response", ""))
@@ -161,7 +157,7 @@ func resourcePortalGlobalSettingCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalGlobalSetting read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalGlobalSetting read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -173,27 +169,27 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetPortalGlobalSettings")
+		logDebugf(ctx, "Selected method: GetPortalGlobalSettings")
 		queryParams1 := isegosdk.GetPortalGlobalSettingsQueryParams{}
 
 		response1, restyResp1, err := client.PortalGlobalSetting.GetPortalGlobalSettings(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsPortalGlobalSettingGetPortalGlobalSettings(m, response1, &queryParams1)
 		item1, err := searchPortalGlobalSettingGetPortalGlobalSettings(m, items1, "", vvID)
@@ -208,7 +204,7 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetPortalGlobalSettings search response",
 				err))
@@ -217,20 +213,20 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetPortalGlobalSettingByID")
+		logDebugf(ctx, "Selected method: GetPortalGlobalSettingByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.PortalGlobalSetting.GetPortalGlobalSettingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPortalGlobalSettingGetPortalGlobalSettingByIDItem(response2.PortalCustomizationGlobalSetting)
 		if err := d.Set("item", vItem2); err != nil {
@@ -239,7 +235,7 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetPortalGlobalSettingByID response",
 				err))
@@ -252,7 +248,7 @@ func resourcePortalGlobalSettingRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePortalGlobalSettingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalGlobalSetting update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalGlobalSetting update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -264,9 +260,9 @@ func resourcePortalGlobalSettingUpdate(ctx context.Context, d *schema.ResourceDa
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -287,15 +283,15 @@ func resourcePortalGlobalSettingUpdate(ctx context.Context, d *schema.ResourceDa
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestPortalGlobalSettingUpdatePortalGlobalSettingByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.PortalGlobalSetting.UpdatePortalGlobalSettingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdatePortalGlobalSettingByID", err, restyResp1.String(),
 					"Failure at UpdatePortalGlobalSettingByID, unexpected response", ""))
@@ -313,9 +309,9 @@ func resourcePortalGlobalSettingUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePortalGlobalSettingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalGlobalSetting delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalGlobalSetting delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PortalGlobalSetting delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PortalGlobalSetting delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestPortalGlobalSettingUpdatePortalGlobalSettingByID(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestPortalGlobalSettingUpdatePortalGlobalSettingByID {
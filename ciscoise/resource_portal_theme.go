@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourcePortalTheme() *schema.Resource {
 		ReadContext:   resourcePortalThemeRead,
 		UpdateContext: resourcePortalThemeUpdate,
 		DeleteContext: resourcePortalThemeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourcePortalThemeRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -147,50 +143,22 @@ func resourcePortalTheme() *schema.Resource {
 }
 
 func resourcePortalThemeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalTheme create")
+	logDebugf(ctx, "Beginning PortalTheme create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestPortalThemeCreatePortalTheme(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.PortalTheme.GetPortalThemeByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourcePortalThemeRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetPortalThemesQueryParams{}
-
-			response2, _, err := client.PortalTheme.GetPortalThemes(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsPortalThemeGetPortalThemes(m, response2, &queryParams2)
-				item2, err := searchPortalThemeGetPortalThemes(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourcePortalThemeRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.PortalTheme.CreatePortalTheme(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -214,7 +182,7 @@ func resourcePortalThemeCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalTheme read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalTheme read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -227,28 +195,28 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetPortalThemes")
+		logDebugf(ctx, "Selected method: GetPortalThemes")
 		queryParams1 := isegosdk.GetPortalThemesQueryParams{}
 
 		response1, restyResp1, err := client.PortalTheme.GetPortalThemes(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsPortalThemeGetPortalThemes(m, response1, &queryParams1)
 		item1, err := searchPortalThemeGetPortalThemes(m, items1, vvName, vvID)
@@ -263,7 +231,7 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetPortalThemes search response",
 				err))
@@ -272,20 +240,20 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetPortalThemeByID")
+		logDebugf(ctx, "Selected method: GetPortalThemeByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.PortalTheme.GetPortalThemeByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPortalThemeGetPortalThemeByIDItem(response2.PortalTheme)
 		if err := d.Set("item", vItem2); err != nil {
@@ -294,7 +262,7 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetPortalThemeByID response",
 				err))
@@ -307,7 +275,7 @@ func resourcePortalThemeRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourcePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalTheme update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalTheme update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -320,9 +288,9 @@ func resourcePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m in
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -347,15 +315,15 @@ func resourcePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m in
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestPortalThemeUpdatePortalThemeByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.PortalTheme.UpdatePortalThemeByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdatePortalThemeByID", err, restyResp1.String(),
 					"Failure at UpdatePortalThemeByID, unexpected response", ""))
@@ -373,7 +341,7 @@ func resourcePortalThemeUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourcePortalThemeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PortalTheme delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PortalTheme delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -385,9 +353,9 @@ func resourcePortalThemeDelete(ctx context.Context, d *schema.ResourceData, m in
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -423,7 +391,7 @@ func resourcePortalThemeDelete(ctx context.Context, d *schema.ResourceData, m in
 	restyResp1, err := client.PortalTheme.DeletePortalThemeByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeletePortalThemeByID", err, restyResp1.String(),
 				"Failure at DeletePortalThemeByID, unexpected response", ""))
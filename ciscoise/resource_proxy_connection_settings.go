@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -154,8 +152,8 @@ pxGrid Cloud
 }
 
 func resourceProxyConnectionSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ProxyConnectionSettings create")
-	log.Printf("[DEBUG] Missing ProxyConnectionSettings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ProxyConnectionSettings create")
+	logDebugf(ctx, "Missing ProxyConnectionSettings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -163,15 +161,15 @@ func resourceProxyConnectionSettingsCreate(ctx context.Context, d *schema.Resour
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
-	log.Printf("[DEBUG] Name used for update operation")
+	logDebugf(ctx, "Name used for update operation")
 	request1 := expandRequestProxyConnectionSettingsUpdateProxyConnection(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Proxy.UpdateProxyConnection(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateProxyConnection", err, restyResp1.String(),
 				"Failure at UpdateProxyConnection, unexpected response", ""))
@@ -189,7 +187,7 @@ func resourceProxyConnectionSettingsCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceProxyConnectionSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ProxyConnectionSettings read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ProxyConnectionSettings read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -197,19 +195,19 @@ func resourceProxyConnectionSettingsRead(ctx context.Context, d *schema.Resource
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetProxyConnection")
+		logDebugf(ctx, "Selected method: GetProxyConnection")
 
 		response1, restyResp1, err := client.Proxy.GetProxyConnection()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenProxyGetProxyConnectionItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -218,7 +216,7 @@ func resourceProxyConnectionSettingsRead(ctx context.Context, d *schema.Resource
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetProxyConnection response",
 				err))
@@ -231,22 +229,22 @@ func resourceProxyConnectionSettingsRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceProxyConnectionSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ProxyConnectionSettings update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ProxyConnectionSettings update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation")
+		logDebugf(ctx, "Name used for update operation")
 		request1 := expandRequestProxyConnectionSettingsUpdateProxyConnection(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Proxy.UpdateProxyConnection(request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateProxyConnection", err, restyResp1.String(),
 					"Failure at UpdateProxyConnection, unexpected response", ""))
@@ -264,9 +262,9 @@ func resourceProxyConnectionSettingsUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceProxyConnectionSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ProxyConnectionSettings delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ProxyConnectionSettings delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing ProxyConnectionSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing ProxyConnectionSettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestProxyConnectionSettingsUpdateProxyConnection(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestProxyUpdateProxyConnection {
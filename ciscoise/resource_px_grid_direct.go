@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourcePxGridDirect() *schema.Resource {
 		ReadContext:   resourcePxGridDirectRead,
 		UpdateContext: resourcePxGridDirectUpdate,
 		DeleteContext: resourcePxGridDirectDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourcePxGridDirectRead, "", "connector_name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -292,41 +288,17 @@ func resourcePxGridDirect() *schema.Resource {
 func resourcePxGridDirectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestPxGridDirectCreateConnectorConfig(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vConnectorName, okConnectorName := resourceItem["connector_name"]
+	vConnectorName := resourceItem["connector_name"]
 	vvConnectorName := interfaceToString(vConnectorName)
-	if isEnableAutoImport {
-		if okConnectorName && vvConnectorName != "" {
-			getResponse2, _, err := client.PxGridDirect.GetConnectorConfigByConnectorName(vvConnectorName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["connector_name"] = vvConnectorName
-				d.SetId(joinResourceID(resourceMap))
-				return resourcePxGridDirectRead(ctx, d, m)
-			}
-		} else {
-			response2, _, err := client.PxGridDirect.GetConnectorConfig()
-			if response2 != nil && err == nil {
-				items2 := getAllItemsPxGridDirectGetConnectorConfig(m, response2)
-				item2, err := searchPxGridDirectGetConnectorConfig(m, items2, vvConnectorName)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["connector_name"] = vvConnectorName
-					d.SetId(joinResourceID(resourceMap))
-					return resourcePxGridDirectRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, err := client.PxGridDirect.CreateConnectorConfig(request1)
 	if err != nil || resp1 == nil {
 		diags = append(diags, diagError(
@@ -351,25 +323,25 @@ func resourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m int
 	vvConnectorName := vConnectorName
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectorName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetConnectorConfig")
+		logDebugf(ctx, "Selected method: GetConnectorConfig")
 
 		response1, restyResp1, err := client.PxGridDirect.GetConnectorConfig()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsPxGridDirectGetConnectorConfig(m, response1)
 		item1, err := searchPxGridDirectGetConnectorConfig(m, items1, vvConnectorName)
@@ -385,7 +357,7 @@ func resourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetConnectorConfig search response",
 				err))
@@ -394,19 +366,19 @@ func resourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetConnectorConfigByConnectorName")
+		logDebugf(ctx, "Selected method: GetConnectorConfigByConnectorName")
 
 		response2, restyResp2, err := client.PxGridDirect.GetConnectorConfigByConnectorName(vvConnectorName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenPxGridDirectGetConnectorConfigByConnectorNameItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -415,7 +387,7 @@ func resourcePxGridDirectRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetConnectorConfigByConnectorName response",
 				err))
@@ -440,11 +412,11 @@ func resourcePxGridDirectUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] Name used for update operation %s", vvConnectorName)
+		logDebugf(ctx, "Name used for update operation %s", vvConnectorName)
 
 		request1 := expandRequestPxGridDirectUpdateConnectorConfigByConnectorName(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, err := client.PxGridDirect.UpdateConnectorConfigByConnectorName(vvConnectorName, request1)
 
@@ -476,9 +448,9 @@ func resourcePxGridDirectDelete(ctx context.Context, d *schema.ResourceData, m i
 	vvConnectorName := vConnectorName
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okConnectorName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
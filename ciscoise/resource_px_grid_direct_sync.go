@@ -2,7 +2,6 @@ package ciscoise
 
 import (
 	"context"
-	"log"
 
 	"reflect"
 
@@ -125,7 +124,7 @@ func resourcePxGridDirectSyncCreate(ctx context.Context, d *schema.ResourceData,
 
 	response1, err := client.PxGridDirect.SyncNowConnector(request1)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
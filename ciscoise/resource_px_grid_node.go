@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,9 +18,7 @@ func resourcePxGridNode() *schema.Resource {
 		ReadContext:   resourcePxGridNodeRead,
 		UpdateContext: resourcePxGridNodeUpdate,
 		DeleteContext: resourcePxGridNodeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourcePxGridNodeRead, "", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -144,7 +140,7 @@ func resourcePxGridNode() *schema.Resource {
 }
 
 func resourcePxGridNodeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxGridNode create")
+	logDebugf(ctx, "Beginning PxGridNode create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -169,7 +165,7 @@ func resourcePxGridNodeCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxGridNode read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxGridNode read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -182,17 +178,17 @@ func resourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	if okName && vName != "" {
 		vvName := vName
-		log.Printf("[DEBUG] Selected method: GetPxGridNodeByName")
+		logDebugf(ctx, "Selected method: GetPxGridNodeByName")
 		response1, restyResp1, err := client.PxGridNode.GetPxGridNodeByName(vvName)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenPxGridNodeGetPxGridNodeByNameItemName(response1.PxgridNode)
 		if err := d.Set("item", vItem1); err != nil {
@@ -206,14 +202,14 @@ func resourcePxGridNodeRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourcePxGridNodeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxGridNode update for id=[%s]", d.Id())
-	log.Printf("[DEBUG] Missing PxGridNode update on Cisco ISE. It will only be update it on Terraform")
+	logDebugf(ctx, "Beginning PxGridNode update for id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxGridNode update on Cisco ISE. It will only be update it on Terraform")
 	// d.Set("last_updated", getUnixTimeString())
 	return resourcePxGridNodeRead(ctx, d, m)
 }
 
 func resourcePxGridNodeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxGridNode delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxGridNode delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -233,7 +229,7 @@ func resourcePxGridNodeDelete(ctx context.Context, d *schema.ResourceData, m int
 	restyResp1, err := client.PxGridNode.DeletePxGridNodeByName(vName)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeletePxGridNodeByName", err, restyResp1.String(),
 				"Failure at DeletePxGridNodeByName, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,8 +60,8 @@ func resourcePxGridSettingsAutoApprove() *schema.Resource {
 }
 
 func resourcePxGridSettingsAutoApproveCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AutoapprovePxGridSettings create")
-	log.Printf("[DEBUG] Missing AutoapprovePxGridSettings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning AutoapprovePxGridSettings create")
+	logDebugf(ctx, "Missing AutoapprovePxGridSettings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -72,7 +70,7 @@ func resourcePxGridSettingsAutoApproveCreate(ctx context.Context, d *schema.Reso
 	response1, err := client.PxGridSettings.AutoapprovePxGridSettings(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing AutoapprovePxGridSettings", err, response1.String(),
 				"Failure at AutoapprovePxGridSettings, unexpected response", ""))
@@ -83,7 +81,7 @@ func resourcePxGridSettingsAutoApproveCreate(ctx context.Context, d *schema.Reso
 			"Failure at AutoapprovePxGridSettings, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting AutoapprovePxGridSettings response",
@@ -102,9 +100,9 @@ func resourcePxGridSettingsAutoApproveRead(ctx context.Context, d *schema.Resour
 }
 
 func resourcePxGridSettingsAutoApproveDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxGridSettingsAutoApprove delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxGridSettingsAutoApprove delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxGridSettingsAutoApprove delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxGridSettingsAutoApprove delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
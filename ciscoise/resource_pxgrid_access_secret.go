@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ func resourcePxgridAccessSecret() *schema.Resource {
 }
 
 func resourcePxgridAccessSecretCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning AccessSecret create")
-	log.Printf("[DEBUG] Missing AccessSecret create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning AccessSecret create")
+	logDebugf(ctx, "Missing AccessSecret create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -63,7 +61,7 @@ func resourcePxgridAccessSecretCreate(ctx context.Context, d *schema.ResourceDat
 
 	request1 := expandRequestPxgridAccessSecretAccessSecret(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, err := client.Consumer.AccessSecret(request1)
 	if err != nil || response1 == nil {
@@ -73,7 +71,7 @@ func resourcePxgridAccessSecretCreate(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -93,9 +91,9 @@ func resourcePxgridAccessSecretRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePxgridAccessSecretDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridAccessSecret delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridAccessSecret delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridAccessSecret delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridAccessSecret delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ func resourcePxgridAccountCreate() *schema.Resource {
 }
 
 func resourcePxgridAccountCreateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning CreateAccount create")
-	log.Printf("[DEBUG] Missing CreateAccount create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning CreateAccount create")
+	logDebugf(ctx, "Missing CreateAccount create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -63,7 +61,7 @@ func resourcePxgridAccountCreateCreate(ctx context.Context, d *schema.ResourceDa
 
 	request1 := expandRequestPxgridAccountCreateCreateAccount(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, err := client.Consumer.CreateAccount(request1)
 	if err != nil || response1 == nil {
@@ -73,7 +71,7 @@ func resourcePxgridAccountCreateCreate(ctx context.Context, d *schema.ResourceDa
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -93,9 +91,9 @@ func resourcePxgridAccountCreateRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePxgridAccountCreateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridAccountCreate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridAccountCreate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridAccountCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridAccountCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestPxgridAccountCreateCreateAccount(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestConfigurationCreateAccount {
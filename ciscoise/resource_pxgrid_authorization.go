@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,8 +42,8 @@ func resourcePxgridAuthorization() *schema.Resource {
 }
 
 func resourcePxgridAuthorizationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Authorization create")
-	log.Printf("[DEBUG] Missing Authorization create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning Authorization create")
+	logDebugf(ctx, "Missing Authorization create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -53,7 +51,7 @@ func resourcePxgridAuthorizationCreate(ctx context.Context, d *schema.ResourceDa
 	response1, err := client.Provider.Authorization()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing Authorization", err, response1.String(),
 				"Failure at Authorization, unexpected response", ""))
@@ -64,7 +62,7 @@ func resourcePxgridAuthorizationCreate(ctx context.Context, d *schema.ResourceDa
 			"Failure at Authorization, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting Authorization response",
@@ -83,8 +81,8 @@ func resourcePxgridAuthorizationRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePxgridAuthorizationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridAuthorization delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridAuthorization delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridAuthorization delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridAuthorization delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
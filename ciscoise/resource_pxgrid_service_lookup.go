@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,20 +52,20 @@ func resourcePxgridServiceLookup() *schema.Resource {
 }
 
 func resourcePxgridServiceLookupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning LookupService create")
-	log.Printf("[DEBUG] Missing LookupService create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning LookupService create")
+	logDebugf(ctx, "Missing LookupService create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 	request1 := expandRequestPxgridServiceLookupLookupService(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, err := client.Consumer.LookupService(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing LookupService", err, response1.String(),
 				"Failure at LookupService, unexpected response", ""))
@@ -78,7 +76,7 @@ func resourcePxgridServiceLookupCreate(ctx context.Context, d *schema.ResourceDa
 			"Failure at LookupService, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -99,9 +97,9 @@ func resourcePxgridServiceLookupRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourcePxgridServiceLookupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridServiceLookup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridServiceLookup delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridServiceLookup delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridServiceLookup delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,8 +51,8 @@ func resourcePxgridServiceRegister() *schema.Resource {
 }
 
 func resourcePxgridServiceRegisterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RegisterService create")
-	log.Printf("[DEBUG] Missing RegisterService create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning RegisterService create")
+	logDebugf(ctx, "Missing RegisterService create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -62,7 +60,7 @@ func resourcePxgridServiceRegisterCreate(ctx context.Context, d *schema.Resource
 
 	request1 := expandRequestPxgridServiceRegisterRegisterService(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, err := client.Provider.RegisterService(request1)
 	if err != nil || response1 == nil {
@@ -72,7 +70,7 @@ func resourcePxgridServiceRegisterCreate(ctx context.Context, d *schema.Resource
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -92,9 +90,9 @@ func resourcePxgridServiceRegisterRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourcePxgridServiceRegisterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridServiceRegister delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridServiceRegister delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridServiceRegister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridServiceRegister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,8 +42,8 @@ func resourcePxgridServiceReregister() *schema.Resource {
 }
 
 func resourcePxgridServiceReregisterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ReregisterService create")
-	log.Printf("[DEBUG] Missing ReregisterService create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ReregisterService create")
+	logDebugf(ctx, "Missing ReregisterService create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -60,7 +58,7 @@ func resourcePxgridServiceReregisterCreate(ctx context.Context, d *schema.Resour
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -80,8 +78,8 @@ func resourcePxgridServiceReregisterRead(ctx context.Context, d *schema.Resource
 }
 
 func resourcePxgridServiceReregisterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridServiceReregister delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridServiceReregister delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridServiceReregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridServiceReregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -44,8 +42,8 @@ func resourcePxgridServiceUnregister() *schema.Resource {
 }
 
 func resourcePxgridServiceUnregisterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning UnregisterService create")
-	log.Printf("[DEBUG] Missing UnregisterService create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning UnregisterService create")
+	logDebugf(ctx, "Missing UnregisterService create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -53,7 +51,7 @@ func resourcePxgridServiceUnregisterCreate(ctx context.Context, d *schema.Resour
 	response1, err := client.Provider.UnregisterService()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UnregisterService", err, response1.String(),
 				"Failure at UnregisterService, unexpected response", ""))
@@ -64,7 +62,7 @@ func resourcePxgridServiceUnregisterCreate(ctx context.Context, d *schema.Resour
 			"Failure at UnregisterService, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting UnregisterService response",
@@ -83,8 +81,8 @@ func resourcePxgridServiceUnregisterRead(ctx context.Context, d *schema.Resource
 }
 
 func resourcePxgridServiceUnregisterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning PxgridServiceUnregister delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning PxgridServiceUnregister delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing PxgridServiceUnregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing PxgridServiceUnregister delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
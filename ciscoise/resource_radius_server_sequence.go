@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceRadiusServerSequence() *schema.Resource {
 		ReadContext:   resourceRadiusServerSequenceRead,
 		UpdateContext: resourceRadiusServerSequenceUpdate,
 		DeleteContext: resourceRadiusServerSequenceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceRadiusServerSequenceRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -420,50 +416,22 @@ func resourceRadiusServerSequence() *schema.Resource {
 }
 
 func resourceRadiusServerSequenceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RadiusServerSequence create")
+	logDebugf(ctx, "Beginning RadiusServerSequence create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestRadiusServerSequenceCreateRadiusServerSequence(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vName, _ := resourceItem["name"]
 	vvID := interfaceToString(vID)
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.RadiusServerSequence.GetRadiusServerSequenceByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceRadiusServerSequenceRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetRadiusServerSequenceQueryParams{}
-
-			response2, _, err := client.RadiusServerSequence.GetRadiusServerSequence(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsRadiusServerSequenceGetRadiusServerSequence(m, response2, &queryParams2)
-				item2, err := searchRadiusServerSequenceGetRadiusServerSequence(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceRadiusServerSequenceRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.RadiusServerSequence.CreateRadiusServerSequence(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -487,7 +455,7 @@ func resourceRadiusServerSequenceCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RadiusServerSequence read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RadiusServerSequence read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -499,28 +467,28 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvID := vID
 		vvName := vName
-		log.Printf("[DEBUG] Selected method: GetRadiusServerSequence")
+		logDebugf(ctx, "Selected method: GetRadiusServerSequence")
 		queryParams1 := isegosdk.GetRadiusServerSequenceQueryParams{}
 
 		response1, restyResp1, err := client.RadiusServerSequence.GetRadiusServerSequence(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsRadiusServerSequenceGetRadiusServerSequence(m, response1, &queryParams1)
 		item1, err := searchRadiusServerSequenceGetRadiusServerSequence(m, items1, vvName, vvID)
@@ -535,7 +503,7 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRadiusServerSequence search response",
 				err))
@@ -544,20 +512,20 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRadiusServerSequenceByID")
+		logDebugf(ctx, "Selected method: GetRadiusServerSequenceByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.RadiusServerSequence.GetRadiusServerSequenceByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenRadiusServerSequenceGetRadiusServerSequenceByIDItem(response2.RadiusServerSequence)
 		if err := d.Set("item", vItem2); err != nil {
@@ -566,7 +534,7 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRadiusServerSequenceByID response",
 				err))
@@ -579,7 +547,7 @@ func resourceRadiusServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceRadiusServerSequenceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RadiusServerSequence update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RadiusServerSequence update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -591,9 +559,9 @@ func resourceRadiusServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -618,15 +586,15 @@ func resourceRadiusServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestRadiusServerSequenceUpdateRadiusServerSequenceByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.RadiusServerSequence.UpdateRadiusServerSequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRadiusServerSequenceByID", err, restyResp1.String(),
 					"Failure at UpdateRadiusServerSequenceByID, unexpected response", ""))
@@ -644,7 +612,7 @@ func resourceRadiusServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceRadiusServerSequenceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RadiusServerSequence delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RadiusServerSequence delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -656,9 +624,9 @@ func resourceRadiusServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -694,7 +662,7 @@ func resourceRadiusServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.RadiusServerSequence.DeleteRadiusServerSequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRadiusServerSequenceByID", err, restyResp1.String(),
 				"Failure at DeleteRadiusServerSequenceByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,8 +20,12 @@ func resourceRenewCertificate() *schema.Resource {
 		CreateContext: resourceRenewCertificateCreate,
 		ReadContext:   resourceRenewCertificateRead,
 		DeleteContext: resourceRenewCertificateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
 		Schema: map[string]*schema.Schema{
+			"wait_for_completion": waitForTaskSchema(),
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -89,8 +91,8 @@ func resourceRenewCertificate() *schema.Resource {
 }
 
 func resourceRenewCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RenewCerts create")
-	log.Printf("[DEBUG] Missing RenewCerts create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning RenewCerts create")
+	logDebugf(ctx, "Missing RenewCerts create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -98,12 +100,12 @@ func resourceRenewCertificateCreate(ctx context.Context, d *schema.ResourceData,
 
 	request1 := expandRequestRenewCertificateRenewCerts(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.RenewCerts(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing RenewCerts", err, restyResp1.String(),
 				"Failure at RenewCerts, unexpected response", ""))
@@ -115,7 +117,7 @@ func resourceRenewCertificateCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesRenewCertsItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -124,6 +126,13 @@ func resourceRenewCertificateCreate(ctx context.Context, d *schema.ResourceData,
 			err))
 		return diags
 	}
+	if d.Get("wait_for_completion").(bool) && response1.Response != nil {
+		taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+		if diags := waitForTask(taskCtx, client, response1.Response.ID, "RenewCerts"); diags.HasError() {
+			return diags
+		}
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
@@ -136,9 +145,9 @@ func resourceRenewCertificateRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceRenewCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RenewCertificate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RenewCertificate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing RenewCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing RenewCertificate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestRenewCertificateRenewCerts(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestCertificatesRenewCerts {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceRepository() *schema.Resource {
 		ReadContext:   resourceRepositoryRead,
 		UpdateContext: resourceRepositoryUpdate,
 		DeleteContext: resourceRepositoryDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceRepositoryRead, "", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -136,7 +132,7 @@ func resourceRepository() *schema.Resource {
 }
 
 func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Repository create")
+	logDebugf(ctx, "Beginning Repository create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -145,7 +141,7 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m int
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestRepositoryCreateRepository(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vName, okName := resourceItem["name"]
@@ -189,7 +185,7 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Repository read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Repository read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -200,26 +196,26 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvName := vName
-		log.Printf("[DEBUG] Selected method: GetRepositories")
+		logDebugf(ctx, "Selected method: GetRepositories")
 
 		response1, restyResp1, err := client.Repository.GetRepositories()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsRepositoryGetRepositories(m, response1)
 		item1, err := searchRepositoryGetRepositories(m, items1, vvName, "")
@@ -234,7 +230,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRepositories search response",
 				err))
@@ -243,20 +239,20 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetRepository")
+		logDebugf(ctx, "Selected method: GetRepository")
 		vvName := vName
 
 		response2, restyResp2, err := client.Repository.GetRepository(vvName)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenRepositoryGetRepositoryItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -265,7 +261,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRepository response",
 				err))
@@ -278,7 +274,7 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Repository update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Repository update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -289,9 +285,9 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvName string
@@ -314,15 +310,15 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m int
 		vvName = vName
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation %s", vvName)
+		logDebugf(ctx, "Name used for update operation %s", vvName)
 		request1 := expandRequestRepositoryUpdateRepository(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Repository.UpdateRepository(vvName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRepository", err, restyResp1.String(),
 					"Failure at UpdateRepository, unexpected response", ""))
@@ -340,7 +336,7 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Repository delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Repository delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -351,9 +347,9 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvName string
@@ -388,7 +384,7 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, m int
 	response1, restyResp1, err := client.Repository.DeleteRepository(vvName)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRepository", err, restyResp1.String(),
 				"Failure at DeleteRepository, unexpected response", ""))
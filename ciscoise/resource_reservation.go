@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceReservation() *schema.Resource {
 		ReadContext:   resourceReservationRead,
 		UpdateContext: resourceReservationUpdate,
 		DeleteContext: resourceReservationDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceReservationRead, "", "client_id"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -126,43 +122,17 @@ func resourceReservation() *schema.Resource {
 func resourceReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestReservationReserveSgtRange(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vClientID, okClientID := resourceItem["client_id"]
+	vClientID := resourceItem["client_id"]
 	vvClientID := interfaceToString(vClientID)
-	if isEnableAutoImport {
-		if okClientID && vvClientID != "" {
-			getResponse2, _, err := client.SgtRangeReservation.GetSgtReservedRange(vvClientID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["client_id"] = vvClientID
-				d.SetId(joinResourceID(resourceMap))
-				return resourceReservationRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSgtReservedRangesQueryParams{}
-
-			response2, _, err := client.SgtRangeReservation.GetSgtReservedRanges(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSgtRangeReservationGetSgtReservedRanges(m, response2, &queryParams2)
-				item2, err := searchSgtRangeReservationGetSgtReservedRanges(m, items2, vvClientID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["client_id"] = vvClientID
-					d.SetId(joinResourceID(resourceMap))
-					return resourceReservationRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.SgtRangeReservation.ReserveSgtRange(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -192,26 +162,26 @@ func resourceReservationRead(ctx context.Context, d *schema.ResourceData, m inte
 	vvClientID := vClientID
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okClientID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSgtReservedRanges")
+		logDebugf(ctx, "Selected method: GetSgtReservedRanges")
 		queryParams1 := isegosdk.GetSgtReservedRangesQueryParams{}
 
 		response1, restyResp1, err := client.SgtRangeReservation.GetSgtReservedRanges(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSgtRangeReservationGetSgtReservedRanges(m, response1, nil)
 		item1, err := searchSgtRangeReservationGetSgtReservedRanges(m, items1, vvClientID)
@@ -227,7 +197,7 @@ func resourceReservationRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSgtReservedRanges search response",
 				err))
@@ -236,19 +206,19 @@ func resourceReservationRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSgtReservedRange")
+		logDebugf(ctx, "Selected method: GetSgtReservedRange")
 
 		response2, restyResp2, err := client.SgtRangeReservation.GetSgtReservedRange(vvClientID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSgtRangeReservationGetSgtReservedRangeItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -257,7 +227,7 @@ func resourceReservationRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSgtReservedRange response",
 				err))
@@ -282,11 +252,11 @@ func resourceReservationUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] ID used for update operation %s", vvClientID)
+		logDebugf(ctx, "ID used for update operation %s", vvClientID)
 
 		request1 := expandRequestReservationUpdateReservedRange(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, restyResp1, err := client.SgtRangeReservation.UpdateReservedRange(vvClientID, request1)
 
@@ -294,7 +264,7 @@ func resourceReservationUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 			if restyResp1 != nil {
 
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 
 				diags = append(diags, diagErrorWithAltAndResponse(
 
@@ -333,9 +303,9 @@ func resourceReservationDelete(ctx context.Context, d *schema.ResourceData, m in
 	vvClientID := vClientID
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okClientID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -369,7 +339,7 @@ func resourceReservationDelete(ctx context.Context, d *schema.ResourceData, m in
 	response1, restyResp1, err := client.SgtRangeReservation.DeleteSgtReserveRange(vvClientID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSgtReserveRange", err, restyResp1.String(),
 				"Failure at DeleteSgtReserveRange, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -32,9 +30,7 @@ func resourceRestIDStore() *schema.Resource {
 		ReadContext:   resourceRestIDStoreRead,
 		UpdateContext: resourceRestIDStoreUpdate,
 		DeleteContext: resourceRestIDStoreDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceRestIDStoreRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -243,45 +239,22 @@ Options are:
 }
 
 func resourceRestIDStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RestIDStore create")
+	logDebugf(ctx, "Beginning RestIDStore create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestRestIDStoreCreateRestIDStore(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.RestidStore.GetRestIDStoreByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceRestIDStoreRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.RestidStore.GetRestIDStoreByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.ERSRestIDStore.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceRestIDStoreRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.RestidStore.CreateRestIDStore(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -305,7 +278,7 @@ func resourceRestIDStoreCreate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RestIDStore read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RestIDStore read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -317,26 +290,26 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetRestIDStoreByName")
+		logDebugf(ctx, "Selected method: GetRestIDStoreByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.RestidStore.GetRestIDStoreByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenRestidStoreGetRestIDStoreByNameItemName(response1.ERSRestIDStore)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -345,7 +318,7 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRestIDStoreByName response",
 				err))
@@ -355,20 +328,20 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetRestIDStoreByID")
+		logDebugf(ctx, "Selected method: GetRestIDStoreByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.RestidStore.GetRestIDStoreByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenRestidStoreGetRestIDStoreByIDItemID(response2.ERSRestIDStore)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -377,7 +350,7 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetRestIDStoreByID response",
 				err))
@@ -390,7 +363,7 @@ func resourceRestIDStoreRead(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceRestIDStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RestIDStore update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RestIDStore update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -402,9 +375,9 @@ func resourceRestIDStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -427,15 +400,15 @@ func resourceRestIDStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestRestIDStoreUpdateRestIDStoreByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.RestidStore.UpdateRestIDStoreByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateRestIDStoreByID", err, restyResp1.String(),
 					"Failure at UpdateRestIDStoreByID, unexpected response", ""))
@@ -453,7 +426,7 @@ func resourceRestIDStoreUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceRestIDStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning RestIDStore delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning RestIDStore delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -465,9 +438,9 @@ func resourceRestIDStoreDelete(ctx context.Context, d *schema.ResourceData, m in
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -495,7 +468,7 @@ func resourceRestIDStoreDelete(ctx context.Context, d *schema.ResourceData, m in
 	restyResp1, err := client.RestidStore.DeleteRestIDStoreByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteRestIDStoreByID", err, restyResp1.String(),
 				"Failure at DeleteRestIDStoreByID, unexpected response", ""))
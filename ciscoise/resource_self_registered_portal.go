@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSelfRegisteredPortal() *schema.Resource {
 		ReadContext:   resourceSelfRegisteredPortalRead,
 		UpdateContext: resourceSelfRegisteredPortalUpdate,
 		DeleteContext: resourceSelfRegisteredPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSelfRegisteredPortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -2811,50 +2807,22 @@ Only valid when emptyFieldDisplay = DISPLAYWITHDEFAULTVALUE`,
 }
 
 func resourceSelfRegisteredPortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SelfRegisteredPortal create")
+	logDebugf(ctx, "Beginning SelfRegisteredPortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSelfRegisteredPortalCreateSelfRegisteredPortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SelfRegisteredPortal.GetSelfRegisteredPortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSelfRegisteredPortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSelfRegisteredPortalsQueryParams{}
-
-			response2, _, err := client.SelfRegisteredPortal.GetSelfRegisteredPortals(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSelfRegisteredPortalGetSelfRegisteredPortals(m, response2, &queryParams2)
-				item2, err := searchSelfRegisteredPortalGetSelfRegisteredPortals(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSelfRegisteredPortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SelfRegisteredPortal.CreateSelfRegisteredPortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -2878,7 +2846,7 @@ func resourceSelfRegisteredPortalCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SelfRegisteredPortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SelfRegisteredPortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -2890,28 +2858,28 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSelfRegisteredPortals")
+		logDebugf(ctx, "Selected method: GetSelfRegisteredPortals")
 		queryParams1 := isegosdk.GetSelfRegisteredPortalsQueryParams{}
 
 		response1, restyResp1, err := client.SelfRegisteredPortal.GetSelfRegisteredPortals(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSelfRegisteredPortalGetSelfRegisteredPortals(m, response1, &queryParams1)
 		item1, err := searchSelfRegisteredPortalGetSelfRegisteredPortals(m, items1, vvName, vvID)
@@ -2926,7 +2894,7 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSelfRegisteredPortals search response",
 				err))
@@ -2935,20 +2903,20 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSelfRegisteredPortalByID")
+		logDebugf(ctx, "Selected method: GetSelfRegisteredPortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SelfRegisteredPortal.GetSelfRegisteredPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSelfRegisteredPortalGetSelfRegisteredPortalByIDItem(response2.SelfRegPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -2957,7 +2925,7 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSelfRegisteredPortalByID response",
 				err))
@@ -2970,7 +2938,7 @@ func resourceSelfRegisteredPortalRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceSelfRegisteredPortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SelfRegisteredPortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SelfRegisteredPortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -2982,9 +2950,9 @@ func resourceSelfRegisteredPortalUpdate(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -3009,15 +2977,15 @@ func resourceSelfRegisteredPortalUpdate(ctx context.Context, d *schema.ResourceD
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSelfRegisteredPortalUpdateSelfRegisteredPortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SelfRegisteredPortal.UpdateSelfRegisteredPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSelfRegisteredPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSelfRegisteredPortalByID, unexpected response", ""))
@@ -3035,7 +3003,7 @@ func resourceSelfRegisteredPortalUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSelfRegisteredPortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SelfRegisteredPortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SelfRegisteredPortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -3047,9 +3015,9 @@ func resourceSelfRegisteredPortalDelete(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -3085,7 +3053,7 @@ func resourceSelfRegisteredPortalDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.SelfRegisteredPortal.DeleteSelfRegisteredPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSelfRegisteredPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSelfRegisteredPortalByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -289,8 +287,8 @@ Wildcard certificate and SAML certificate can be generated only on PPAN or Stand
 }
 
 func resourceSelfsignedCertificateGenerateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning GenerateSelfSignedCertificate create")
-	log.Printf("[DEBUG] Missing GenerateSelfSignedCertificate create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning GenerateSelfSignedCertificate create")
+	logDebugf(ctx, "Missing GenerateSelfSignedCertificate create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -298,12 +296,12 @@ func resourceSelfsignedCertificateGenerateCreate(ctx context.Context, d *schema.
 
 	request1 := expandRequestSelfsignedCertificateGenerateGenerateSelfSignedCertificate(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.GenerateSelfSignedCertificate(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing GenerateSelfSignedCertificate", err, restyResp1.String(),
 				"Failure at GenerateSelfSignedCertificate, unexpected response", ""))
@@ -315,7 +313,7 @@ func resourceSelfsignedCertificateGenerateCreate(ctx context.Context, d *schema.
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesGenerateSelfSignedCertificateItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -336,9 +334,9 @@ func resourceSelfsignedCertificateGenerateRead(ctx context.Context, d *schema.Re
 }
 
 func resourceSelfsignedCertificateGenerateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SelfsignedCertificateGenerate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SelfsignedCertificateGenerate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SelfsignedCertificateGenerate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SelfsignedCertificateGenerate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
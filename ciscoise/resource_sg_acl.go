@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSgACL() *schema.Resource {
 		ReadContext:   resourceSgACLRead,
 		UpdateContext: resourceSgACLUpdate,
 		DeleteContext: resourceSgACLDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSgACLRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -199,50 +195,22 @@ func resourceSgACL() *schema.Resource {
 }
 
 func resourceSgACLCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgACL create")
+	logDebugf(ctx, "Beginning SgACL create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSgACLCreateSecurityGroupsACL(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SecurityGroupsACLs.GetSecurityGroupsACLByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSgACLRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSecurityGroupsACLQueryParams{}
-
-			response2, _, err := client.SecurityGroupsACLs.GetSecurityGroupsACL(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSecurityGroupsACLsGetSecurityGroupsACL(m, response2, &queryParams2)
-				item2, err := searchSecurityGroupsACLsGetSecurityGroupsACL(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSgACLRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SecurityGroupsACLs.CreateSecurityGroupsACL(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -266,7 +234,7 @@ func resourceSgACLCreate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgACL read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgACL read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -278,28 +246,28 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsACL")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsACL")
 		queryParams1 := isegosdk.GetSecurityGroupsACLQueryParams{}
 
 		response1, restyResp1, err := client.SecurityGroupsACLs.GetSecurityGroupsACL(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSecurityGroupsACLsGetSecurityGroupsACL(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupsACLsGetSecurityGroupsACL(m, items1, vvName, vvID)
@@ -314,7 +282,7 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsACL search response",
 				err))
@@ -323,20 +291,20 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsACLByID")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsACLByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SecurityGroupsACLs.GetSecurityGroupsACLByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSecurityGroupsACLsGetSecurityGroupsACLByIDItem(response2.Sgacl)
 		if err := d.Set("item", vItem2); err != nil {
@@ -345,7 +313,7 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsACLByID response",
 				err))
@@ -358,7 +326,7 @@ func resourceSgACLRead(ctx context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourceSgACLUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgACL update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgACL update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -371,9 +339,9 @@ func resourceSgACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -398,15 +366,15 @@ func resourceSgACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSgACLUpdateSecurityGroupsACLByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SecurityGroupsACLs.UpdateSecurityGroupsACLByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupsACLByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupsACLByID, unexpected response", ""))
@@ -424,7 +392,7 @@ func resourceSgACLUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceSgACLDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgACL delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgACL delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -437,9 +405,9 @@ func resourceSgACLDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -475,7 +443,7 @@ func resourceSgACLDelete(ctx context.Context, d *schema.ResourceData, m interfac
 	restyResp1, err := client.SecurityGroupsACLs.DeleteSecurityGroupsACLByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupsACLByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupsACLByID, unexpected response", ""))
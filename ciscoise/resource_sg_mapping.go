@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSgMapping() *schema.Resource {
 		ReadContext:   resourceSgMappingRead,
 		UpdateContext: resourceSgMappingUpdate,
 		DeleteContext: resourceSgMappingDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSgMappingRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -203,50 +199,22 @@ func resourceSgMapping() *schema.Resource {
 }
 
 func resourceSgMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMapping create")
+	logDebugf(ctx, "Beginning SgMapping create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSgMappingCreateIPToSgtMapping(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.IPToSgtMapping.GetIPToSgtMappingByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSgMappingRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetIPToSgtMappingQueryParams{}
-
-			response2, _, err := client.IPToSgtMapping.GetIPToSgtMapping(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsIPToSgtMappingGetIPToSgtMapping(m, response2, &queryParams2)
-				item2, err := searchIPToSgtMappingGetIPToSgtMapping(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSgMappingRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.IPToSgtMapping.CreateIPToSgtMapping(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -270,7 +238,7 @@ func resourceSgMappingCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMapping read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMapping read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -282,28 +250,28 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetIPToSgtMapping")
+		logDebugf(ctx, "Selected method: GetIPToSgtMapping")
 		queryParams1 := isegosdk.GetIPToSgtMappingQueryParams{}
 
 		response1, restyResp1, err := client.IPToSgtMapping.GetIPToSgtMapping(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsIPToSgtMappingGetIPToSgtMapping(m, response1, &queryParams1)
 		item1, err := searchIPToSgtMappingGetIPToSgtMapping(m, items1, vvName, vvID)
@@ -318,7 +286,7 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPToSgtMapping search response",
 				err))
@@ -327,20 +295,20 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIPToSgtMappingByID")
+		logDebugf(ctx, "Selected method: GetIPToSgtMappingByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.IPToSgtMapping.GetIPToSgtMappingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenIPToSgtMappingGetIPToSgtMappingByIDItem(response2.SgMapping)
 		if err := d.Set("item", vItem2); err != nil {
@@ -349,7 +317,7 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPToSgtMappingByID response",
 				err))
@@ -362,7 +330,7 @@ func resourceSgMappingRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceSgMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMapping update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMapping update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -374,9 +342,9 @@ func resourceSgMappingUpdate(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -400,15 +368,15 @@ func resourceSgMappingUpdate(ctx context.Context, d *schema.ResourceData, m inte
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSgMappingUpdateIPToSgtMappingByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.IPToSgtMapping.UpdateIPToSgtMappingByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIPToSgtMappingByID", err, restyResp1.String(),
 					"Failure at UpdateIPToSgtMappingByID, unexpected response", ""))
@@ -426,7 +394,7 @@ func resourceSgMappingUpdate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceSgMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMapping delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMapping delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -438,9 +406,9 @@ func resourceSgMappingDelete(ctx context.Context, d *schema.ResourceData, m inte
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -476,7 +444,7 @@ func resourceSgMappingDelete(ctx context.Context, d *schema.ResourceData, m inte
 	restyResp1, err := client.IPToSgtMapping.DeleteIPToSgtMappingByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIPToSgtMappingByID", err, restyResp1.String(),
 				"Failure at DeleteIPToSgtMappingByID, unexpected response", ""))
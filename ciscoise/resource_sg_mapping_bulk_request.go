@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceSgMappingBulkRequest() *schema.Resource {
 		CreateContext: resourceSgMappingBulkRequestCreate,
 		ReadContext:   resourceSgMappingBulkRequestRead,
 		DeleteContext: resourceSgMappingBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceSgMappingBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceSgMappingBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForIPToSgtMapping create")
-	log.Printf("[DEBUG] Missing BulkRequestForIPToSgtMapping create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForIPToSgtMapping create")
+	logDebugf(ctx, "Missing BulkRequestForIPToSgtMapping create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -69,7 +71,7 @@ func resourceSgMappingBulkRequestCreate(ctx context.Context, d *schema.ResourceD
 	response1, err := client.IPToSgtMapping.BulkRequestForIPToSgtMapping(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing BulkRequestForIPToSgtMapping", err, response1.String(),
 				"Failure at BulkRequestForIPToSgtMapping, unexpected response", ""))
@@ -80,17 +82,23 @@ func resourceSgMappingBulkRequestCreate(ctx context.Context, d *schema.ResourceD
 			"Failure at BulkRequestForIPToSgtMapping, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting BulkRequestForIPToSgtMapping response",
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForIPToSgtMapping", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.IPToSgtMapping.MonitorBulkStatusIPToSgtMapping(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
-	return resourceSgMappingBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSgMappingBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSgMappingBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -99,9 +107,9 @@ func resourceSgMappingBulkRequestRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceSgMappingBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgMappingBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgMappingBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
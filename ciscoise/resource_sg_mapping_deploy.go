@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,15 +50,15 @@ Only one Deploy process can run at any given time
 }
 
 func resourceSgMappingDeployCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeployIPToSgtMappingByID create")
-	log.Printf("[DEBUG] Missing DeployIPToSgtMappingByID create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeployIPToSgtMappingByID create")
+	logDebugf(ctx, "Missing DeployIPToSgtMappingByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 	vID := d.Get("parameters.0.id")
 
-	log.Printf("[DEBUG] Selected method: DeployIPToSgtMappingByID")
+	logDebugf(ctx, "Selected method: DeployIPToSgtMappingByID")
 	vvID := vID.(string)
 
 	response1, err := client.IPToSgtMapping.DeployIPToSgtMappingByID(vvID)
@@ -72,7 +70,7 @@ func resourceSgMappingDeployCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -93,8 +91,8 @@ func resourceSgMappingDeployRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSgMappingDeployDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingDeploy delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingDeploy delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgMappingDeploy delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgMappingDeploy delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,8 +43,8 @@ Only one Deploy process can run at any given time
 }
 
 func resourceSgMappingDeployAllCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeployAllIPToSgtMapping create")
-	log.Printf("[DEBUG] Missing DeployAllIPToSgtMapping create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeployAllIPToSgtMapping create")
+	logDebugf(ctx, "Missing DeployAllIPToSgtMapping create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -54,7 +52,7 @@ func resourceSgMappingDeployAllCreate(ctx context.Context, d *schema.ResourceDat
 	response1, err := client.IPToSgtMapping.DeployAllIPToSgtMapping()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeployAllIPToSgtMapping", err, response1.String(),
 				"Failure at DeployAllIPToSgtMapping, unexpected response", ""))
@@ -65,7 +63,7 @@ func resourceSgMappingDeployAllCreate(ctx context.Context, d *schema.ResourceDat
 			"Failure at DeployAllIPToSgtMapping, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting DeployAllIPToSgtMapping response",
@@ -84,8 +82,8 @@ func resourceSgMappingDeployAllRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSgMappingDeployAllDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingDeployAll delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingDeployAll delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgMappingDeployAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgMappingDeployAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
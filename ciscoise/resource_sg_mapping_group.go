@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSgMappingGroup() *schema.Resource {
 		ReadContext:   resourceSgMappingGroupRead,
 		UpdateContext: resourceSgMappingGroupUpdate,
 		DeleteContext: resourceSgMappingGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSgMappingGroupRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -161,7 +157,7 @@ func resourceSgMappingGroup() *schema.Resource {
 }
 
 func resourceSgMappingGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroup create")
+	logDebugf(ctx, "Beginning SgMappingGroup create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -170,7 +166,7 @@ func resourceSgMappingGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSgMappingGroupCreateIPToSgtMappingGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	vID, okID := resourceItem["id"]
@@ -225,7 +221,7 @@ func resourceSgMappingGroupCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroup read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingGroup read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -237,28 +233,28 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetIPToSgtMappingGroup")
+		logDebugf(ctx, "Selected method: GetIPToSgtMappingGroup")
 		queryParams1 := isegosdk.GetIPToSgtMappingGroupQueryParams{}
 
 		response1, restyResp1, err := client.IPToSgtMappingGroup.GetIPToSgtMappingGroup(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsIPToSgtMappingGroupGetIPToSgtMappingGroup(m, response1, &queryParams1)
 		item1, err := searchIPToSgtMappingGroupGetIPToSgtMappingGroup(m, items1, vvName, vvID)
@@ -273,7 +269,7 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPToSgtMappingGroup search response",
 				err))
@@ -282,20 +278,20 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetIPToSgtMappingGroupByID")
+		logDebugf(ctx, "Selected method: GetIPToSgtMappingGroupByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.IPToSgtMappingGroup.GetIPToSgtMappingGroupByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenIPToSgtMappingGroupGetIPToSgtMappingGroupByIDItem(response2.SgMappingGroup)
 		if err := d.Set("item", vItem2); err != nil {
@@ -304,7 +300,7 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetIPToSgtMappingGroupByID response",
 				err))
@@ -317,7 +313,7 @@ func resourceSgMappingGroupRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSgMappingGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroup update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingGroup update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -329,9 +325,9 @@ func resourceSgMappingGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -351,15 +347,15 @@ func resourceSgMappingGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSgMappingGroupUpdateIPToSgtMappingGroupByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.IPToSgtMappingGroup.UpdateIPToSgtMappingGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateIPToSgtMappingGroupByID", err, restyResp1.String(),
 					"Failure at UpdateIPToSgtMappingGroupByID, unexpected response", ""))
@@ -377,7 +373,7 @@ func resourceSgMappingGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSgMappingGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingGroup delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -389,9 +385,9 @@ func resourceSgMappingGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -422,7 +418,7 @@ func resourceSgMappingGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.IPToSgtMappingGroup.DeleteIPToSgtMappingGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteIPToSgtMappingGroupByID", err, restyResp1.String(),
 				"Failure at DeleteIPToSgtMappingGroupByID, unexpected response", ""))
@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -52,8 +50,8 @@ Only one Deploy process can run at any given time
 }
 
 func resourceSgMappingGroupDeployCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeployIPToSgtMappingGroupByID create")
-	log.Printf("[DEBUG] Missing DeployIPToSgtMappingGroupByID create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeployIPToSgtMappingGroupByID create")
+	logDebugf(ctx, "Missing DeployIPToSgtMappingGroupByID create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -64,7 +62,7 @@ func resourceSgMappingGroupDeployCreate(ctx context.Context, d *schema.ResourceD
 	response1, err := client.IPToSgtMappingGroup.DeployIPToSgtMappingGroupByID(vvID)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeployIPToSgtMappingGroupByID", err, response1.String(),
 				"Failure at DeployIPToSgtMappingGroupByID, unexpected response", ""))
@@ -75,7 +73,7 @@ func resourceSgMappingGroupDeployCreate(ctx context.Context, d *schema.ResourceD
 			"Failure at DeployIPToSgtMappingGroupByID, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting DeployIPToSgtMappingGroupByID response",
@@ -94,8 +92,8 @@ func resourceSgMappingGroupDeployRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceSgMappingGroupDeployDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroupDeploy delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingGroupDeploy delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgMappingGroupDeploy delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgMappingGroupDeploy delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
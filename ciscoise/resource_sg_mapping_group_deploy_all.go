@@ -3,8 +3,6 @@ package ciscoise
 import (
 	"context"
 
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -45,8 +43,8 @@ Only one Deploy process can run at any given time
 }
 
 func resourceSgMappingGroupDeployAllCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning DeployAllIPToSgtMappingGroup create")
-	log.Printf("[DEBUG] Missing DeployAllIPToSgtMappingGroup create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning DeployAllIPToSgtMappingGroup create")
+	logDebugf(ctx, "Missing DeployAllIPToSgtMappingGroup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 	d.Set("parameters", nil)
@@ -54,7 +52,7 @@ func resourceSgMappingGroupDeployAllCreate(ctx context.Context, d *schema.Resour
 	response1, err := client.IPToSgtMappingGroup.DeployAllIPToSgtMappingGroup()
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeployAllIPToSgtMappingGroup", err, response1.String(),
 				"Failure at DeployAllIPToSgtMappingGroup, unexpected response", ""))
@@ -65,7 +63,7 @@ func resourceSgMappingGroupDeployAllCreate(ctx context.Context, d *schema.Resour
 			"Failure at DeployAllIPToSgtMappingGroup, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting DeployAllIPToSgtMappingGroup response",
@@ -84,8 +82,8 @@ func resourceSgMappingGroupDeployAllRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceSgMappingGroupDeployAllDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgMappingGroupDeployAll delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgMappingGroupDeployAll delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgMappingGroupDeployAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgMappingGroupDeployAll delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
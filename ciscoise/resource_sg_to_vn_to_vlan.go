@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSgToVnToVLAN() *schema.Resource {
 		ReadContext:   resourceSgToVnToVLANRead,
 		UpdateContext: resourceSgToVnToVLANUpdate,
 		DeleteContext: resourceSgToVnToVLANDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSgToVnToVLANRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -288,50 +284,22 @@ func resourceSgToVnToVLAN() *schema.Resource {
 }
 
 func resourceSgToVnToVLANCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgToVnToVLAN create")
+	logDebugf(ctx, "Beginning SgToVnToVLAN create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSgToVnToVLANCreateSecurityGroupsToVnToVLAN(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SecurityGroupToVirtualNetwork.GetSecurityGroupsToVnToVLANByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSgToVnToVLANRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSecurityGroupsToVnToVLANQueryParams{}
-
-			response2, _, err := client.SecurityGroupToVirtualNetwork.GetSecurityGroupsToVnToVLAN(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, response2, &queryParams2)
-				item2, err := searchSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSgToVnToVLANRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SecurityGroupToVirtualNetwork.CreateSecurityGroupsToVnToVLAN(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -355,7 +323,7 @@ func resourceSgToVnToVLANCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgToVnToVLAN read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgToVnToVLAN read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -367,28 +335,28 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsToVnToVLAN")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsToVnToVLAN")
 		queryParams1 := isegosdk.GetSecurityGroupsToVnToVLANQueryParams{}
 
 		response1, restyResp1, err := client.SecurityGroupToVirtualNetwork.GetSecurityGroupsToVnToVLAN(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLAN(m, items1, vvName, vvID)
@@ -403,7 +371,7 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsToVnToVLAN search response",
 				err))
@@ -412,20 +380,20 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupsToVnToVLANByID")
+		logDebugf(ctx, "Selected method: GetSecurityGroupsToVnToVLANByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SecurityGroupToVirtualNetwork.GetSecurityGroupsToVnToVLANByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSecurityGroupToVirtualNetworkGetSecurityGroupsToVnToVLANByIDItem(response2.SgtVnVLANContainer)
 		if err := d.Set("item", vItem2); err != nil {
@@ -434,7 +402,7 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroupsToVnToVLANByID response",
 				err))
@@ -447,7 +415,7 @@ func resourceSgToVnToVLANRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceSgToVnToVLANUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgToVnToVLAN update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgToVnToVLAN update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -459,9 +427,9 @@ func resourceSgToVnToVLANUpdate(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -486,15 +454,15 @@ func resourceSgToVnToVLANUpdate(ctx context.Context, d *schema.ResourceData, m i
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSgToVnToVLANUpdateSecurityGroupsToVnToVLANByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SecurityGroupToVirtualNetwork.UpdateSecurityGroupsToVnToVLANByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupsToVnToVLANByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupsToVnToVLANByID, unexpected response", ""))
@@ -512,7 +480,7 @@ func resourceSgToVnToVLANUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSgToVnToVLANDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgToVnToVLAN delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgToVnToVLAN delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -524,9 +492,9 @@ func resourceSgToVnToVLANDelete(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -562,7 +530,7 @@ func resourceSgToVnToVLANDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.SecurityGroupToVirtualNetwork.DeleteSecurityGroupsToVnToVLANByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupsToVnToVLANByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupsToVnToVLANByID, unexpected response", ""))
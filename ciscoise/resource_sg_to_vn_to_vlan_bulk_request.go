@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceSgToVnToVLANBulkRequest() *schema.Resource {
 		CreateContext: resourceSgToVnToVLANBulkRequestCreate,
 		ReadContext:   resourceSgToVnToVLANBulkRequestRead,
 		DeleteContext: resourceSgToVnToVLANBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceSgToVnToVLANBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceSgToVnToVLANBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForSecurityGroupsToVnToVLAN create")
-	log.Printf("[DEBUG] Missing BulkRequestForSecurityGroupsToVnToVLAN create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForSecurityGroupsToVnToVLAN create")
+	logDebugf(ctx, "Missing BulkRequestForSecurityGroupsToVnToVLAN create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,7 +70,7 @@ func resourceSgToVnToVLANBulkRequestCreate(ctx context.Context, d *schema.Resour
 	response1, err := client.SecurityGroupToVirtualNetwork.BulkRequestForSecurityGroupsToVnToVLAN(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing BulkRequestForSecurityGroupsToVnToVLAN", err, response1.String(),
 				"Failure at BulkRequestForSecurityGroupsToVnToVLAN, unexpected response", ""))
@@ -79,17 +81,23 @@ func resourceSgToVnToVLANBulkRequestCreate(ctx context.Context, d *schema.Resour
 			"Failure at BulkRequestForSecurityGroupsToVnToVLAN, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting BulkRequestForSecurityGroupsToVnToVLAN response",
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForSecurityGroupsToVnToVLAN", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.SecurityGroupToVirtualNetwork.MonitorBulkStatusSecurityGroupsToVnToVLAN(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
-	return resourceSgToVnToVLANBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSgToVnToVLANBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSgToVnToVLANBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -98,9 +106,9 @@ func resourceSgToVnToVLANBulkRequestRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceSgToVnToVLANBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgToVnToVLANBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgToVnToVLANBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgToVnToVLANBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgToVnToVLANBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
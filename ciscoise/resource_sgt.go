@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSgt() *schema.Resource {
 		ReadContext:   resourceSgtRead,
 		UpdateContext: resourceSgtUpdate,
 		DeleteContext: resourceSgtDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSgtRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -191,53 +187,25 @@ func resourceSgt() *schema.Resource {
 }
 
 func resourceSgtCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Sgt create")
+	logDebugf(ctx, "Beginning Sgt create")
 
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
-	log.Printf("[INFO] Is new resource => %t", d.IsNewResource())
-	log.Printf("[INFO] Is EnableAutoImport => %t", m.(ClientConfig).EnableAutoImport)
+	logInfof(ctx, "Is new resource => %t", d.IsNewResource())
+	logInfof(ctx, "Is EnableAutoImport => %t", m.(ClientConfig).EnableAutoImport)
 
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSgtCreateSecurityGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SecurityGroups.GetSecurityGroupByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSgtRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSecurityGroupsQueryParams{}
-
-			response2, _, err := client.SecurityGroups.GetSecurityGroups(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSecurityGroupsGetSecurityGroups(m, response2, &queryParams2)
-				item2, err := searchSecurityGroupsGetSecurityGroups(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSgtRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SecurityGroups.CreateSecurityGroup(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -261,7 +229,7 @@ func resourceSgtCreate(ctx context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Sgt read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Sgt read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -272,28 +240,28 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	vID, okID := resourceMap["id"]
 	vName, okName := resourceMap["name"]
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSecurityGroups")
+		logDebugf(ctx, "Selected method: GetSecurityGroups")
 		queryParams1 := isegosdk.GetSecurityGroupsQueryParams{}
 
 		response1, restyResp1, err := client.SecurityGroups.GetSecurityGroups(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSecurityGroupsGetSecurityGroups(m, response1, &queryParams1)
 		item1, err := searchSecurityGroupsGetSecurityGroups(m, items1, vvName, vvID)
@@ -302,7 +270,7 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 			return diags
 		}
 		vItem1 := flattenSecurityGroupsGetSecurityGroupByIDItem(item1)
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroups search response",
 				err))
@@ -324,20 +292,20 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSecurityGroupByID")
+		logDebugf(ctx, "Selected method: GetSecurityGroupByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SecurityGroups.GetSecurityGroupByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSecurityGroupsGetSecurityGroupByIDItem(response2.Sgt)
 		if err := d.Set("item", vItem2); err != nil {
@@ -346,7 +314,7 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSecurityGroups search response",
 				err))
@@ -364,7 +332,7 @@ func resourceSgtRead(ctx context.Context, d *schema.ResourceData, m interface{})
 }
 
 func resourceSgtUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Sgt update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Sgt update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -376,9 +344,9 @@ func resourceSgtUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -403,16 +371,16 @@ func resourceSgtUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] if dentro")
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "if dentro")
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSgtUpdateSecurityGroupByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SecurityGroups.UpdateSecurityGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSecurityGroupByID", err, restyResp1.String(),
 					"Failure at UpdateSecurityGroupByID, unexpected response", ""))
@@ -430,7 +398,7 @@ func resourceSgtUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 }
 
 func resourceSgtDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning Sgt delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning Sgt delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -442,9 +410,9 @@ func resourceSgtDelete(ctx context.Context, d *schema.ResourceData, m interface{
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -480,7 +448,7 @@ func resourceSgtDelete(ctx context.Context, d *schema.ResourceData, m interface{
 	restyResp1, err := client.SecurityGroups.DeleteSecurityGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSecurityGroupByID", err, restyResp1.String(),
 				"Failure at DeleteSecurityGroupByID, unexpected response", ""))
@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceSgtBulkRequest() *schema.Resource {
 		CreateContext: resourceSgtBulkRequestCreate,
 		ReadContext:   resourceSgtBulkRequestRead,
 		DeleteContext: resourceSgtBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceSgtBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceSgtBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForSecurityGroup create")
-	log.Printf("[DEBUG] Missing BulkRequestForSecurityGroup create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForSecurityGroup create")
+	logDebugf(ctx, "Missing BulkRequestForSecurityGroup create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -68,7 +70,7 @@ func resourceSgtBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m
 	response1, err := client.SecurityGroups.BulkRequestForSecurityGroup(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing BulkRequestForSecurityGroup", err, response1.String(),
 				"Failure at BulkRequestForSecurityGroup, unexpected response", ""))
@@ -79,17 +81,23 @@ func resourceSgtBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m
 			"Failure at BulkRequestForSecurityGroup, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting BulkRequestForSecurityGroup response",
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForSecurityGroup", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.SecurityGroups.MonitorBulkStatusSecurityGroup(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
 
 	d.SetId(getUnixTimeString())
-	return resourceSgtBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSgtBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSgtBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -98,9 +106,9 @@ func resourceSgtBulkRequestRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSgtBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SgtBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SgtBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SgtBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SgtBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
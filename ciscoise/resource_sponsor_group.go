@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSponsorGroup() *schema.Resource {
 		ReadContext:   resourceSponsorGroupRead,
 		UpdateContext: resourceSponsorGroupUpdate,
 		DeleteContext: resourceSponsorGroupDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSponsorGroupRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -482,50 +478,22 @@ func resourceSponsorGroup() *schema.Resource {
 }
 
 func resourceSponsorGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorGroup create")
+	logDebugf(ctx, "Beginning SponsorGroup create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSponsorGroupCreateSponsorGroup(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SponsorGroup.GetSponsorGroupByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSponsorGroupRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSponsorGroupQueryParams{}
-
-			response2, _, err := client.SponsorGroup.GetSponsorGroup(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSponsorGroupGetSponsorGroup(m, response2, &queryParams2)
-				item2, err := searchSponsorGroupGetSponsorGroup(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSponsorGroupRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SponsorGroup.CreateSponsorGroup(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -549,7 +517,7 @@ func resourceSponsorGroupCreate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorGroup read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorGroup read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -561,28 +529,28 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSponsorGroup")
+		logDebugf(ctx, "Selected method: GetSponsorGroup")
 		queryParams1 := isegosdk.GetSponsorGroupQueryParams{}
 
 		response1, restyResp1, err := client.SponsorGroup.GetSponsorGroup(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSponsorGroupGetSponsorGroup(m, response1, &queryParams1)
 		item1, err := searchSponsorGroupGetSponsorGroup(m, items1, vvName, vvID)
@@ -597,7 +565,7 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsorGroup search response",
 				err))
@@ -606,20 +574,20 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSponsorGroupByID")
+		logDebugf(ctx, "Selected method: GetSponsorGroupByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SponsorGroup.GetSponsorGroupByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSponsorGroupGetSponsorGroupByIDItem(response2.SponsorGroup)
 		if err := d.Set("item", vItem2); err != nil {
@@ -628,7 +596,7 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsorGroupByID response",
 				err))
@@ -641,7 +609,7 @@ func resourceSponsorGroupRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceSponsorGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorGroup update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorGroup update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -653,9 +621,9 @@ func resourceSponsorGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -680,15 +648,15 @@ func resourceSponsorGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSponsorGroupUpdateSponsorGroupByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SponsorGroup.UpdateSponsorGroupByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsorGroupByID", err, restyResp1.String(),
 					"Failure at UpdateSponsorGroupByID, unexpected response", ""))
@@ -706,7 +674,7 @@ func resourceSponsorGroupUpdate(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSponsorGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorGroup delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorGroup delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -718,9 +686,9 @@ func resourceSponsorGroupDelete(ctx context.Context, d *schema.ResourceData, m i
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -756,7 +724,7 @@ func resourceSponsorGroupDelete(ctx context.Context, d *schema.ResourceData, m i
 	restyResp1, err := client.SponsorGroup.DeleteSponsorGroupByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsorGroupByID", err, restyResp1.String(),
 				"Failure at DeleteSponsorGroupByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSponsorPortal() *schema.Resource {
 		ReadContext:   resourceSponsorPortalRead,
 		UpdateContext: resourceSponsorPortalUpdate,
 		DeleteContext: resourceSponsorPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSponsorPortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1181,50 +1177,22 @@ Only valid when emptyFieldDisplay = DISPLAYWITHDEFAULTVALUE`,
 }
 
 func resourceSponsorPortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorPortal create")
+	logDebugf(ctx, "Beginning SponsorPortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSponsorPortalCreateSponsorPortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SponsorPortal.GetSponsorPortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSponsorPortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSponsorPortalQueryParams{}
-
-			response2, _, err := client.SponsorPortal.GetSponsorPortal(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSponsorPortalGetSponsorPortal(m, response2, &queryParams2)
-				item2, err := searchSponsorPortalGetSponsorPortal(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSponsorPortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SponsorPortal.CreateSponsorPortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1248,7 +1216,7 @@ func resourceSponsorPortalCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorPortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorPortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1260,28 +1228,28 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSponsorPortal")
+		logDebugf(ctx, "Selected method: GetSponsorPortal")
 		queryParams1 := isegosdk.GetSponsorPortalQueryParams{}
 
 		response1, restyResp1, err := client.SponsorPortal.GetSponsorPortal(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSponsorPortalGetSponsorPortal(m, response1, &queryParams1)
 		item1, err := searchSponsorPortalGetSponsorPortal(m, items1, vvName, vvID)
@@ -1296,7 +1264,7 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsorPortal search response",
 				err))
@@ -1305,20 +1273,20 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSponsorPortalByID")
+		logDebugf(ctx, "Selected method: GetSponsorPortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SponsorPortal.GetSponsorPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSponsorPortalGetSponsorPortalByIDItem(response2.SponsorPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1327,7 +1295,7 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsorPortalByID response",
 				err))
@@ -1340,7 +1308,7 @@ func resourceSponsorPortalRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceSponsorPortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorPortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorPortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1352,9 +1320,9 @@ func resourceSponsorPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1379,15 +1347,15 @@ func resourceSponsorPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSponsorPortalUpdateSponsorPortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SponsorPortal.UpdateSponsorPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsorPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSponsorPortalByID, unexpected response", ""))
@@ -1405,7 +1373,7 @@ func resourceSponsorPortalUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSponsorPortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsorPortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsorPortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1417,9 +1385,9 @@ func resourceSponsorPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1455,7 +1423,7 @@ func resourceSponsorPortalDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.SponsorPortal.DeleteSponsorPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsorPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSponsorPortalByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSponsoredGuestPortal() *schema.Resource {
 		ReadContext:   resourceSponsoredGuestPortalRead,
 		UpdateContext: resourceSponsoredGuestPortalUpdate,
 		DeleteContext: resourceSponsoredGuestPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSponsoredGuestPortalRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -1615,50 +1611,22 @@ Only valid when emptyFieldDisplay = DISPLAYWITHDEFAULTVALUE`,
 }
 
 func resourceSponsoredGuestPortalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsoredGuestPortal create")
+	logDebugf(ctx, "Beginning SponsoredGuestPortal create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSponsoredGuestPortalCreateSponsoredGuestPortal(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SponsoredGuestPortal.GetSponsoredGuestPortalByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSponsoredGuestPortalRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSponsoredGuestPortalsQueryParams{}
-
-			response2, _, err := client.SponsoredGuestPortal.GetSponsoredGuestPortals(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSponsoredGuestPortalGetSponsoredGuestPortals(m, response2, &queryParams2)
-				item2, err := searchSponsoredGuestPortalGetSponsoredGuestPortals(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSponsoredGuestPortalRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SponsoredGuestPortal.CreateSponsoredGuestPortal(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -1682,7 +1650,7 @@ func resourceSponsoredGuestPortalCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsoredGuestPortal read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsoredGuestPortal read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1694,28 +1662,28 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvID := vID
 		vvName := vName
-		log.Printf("[DEBUG] Selected method: GetSponsoredGuestPortals")
+		logDebugf(ctx, "Selected method: GetSponsoredGuestPortals")
 		queryParams1 := isegosdk.GetSponsoredGuestPortalsQueryParams{}
 
 		response1, restyResp1, err := client.SponsoredGuestPortal.GetSponsoredGuestPortals(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSponsoredGuestPortalGetSponsoredGuestPortals(m, response1, &queryParams1)
 		item1, err := searchSponsoredGuestPortalGetSponsoredGuestPortals(m, items1, vvName, vvID)
@@ -1730,7 +1698,7 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsoredGuestPortals search response",
 				err))
@@ -1739,20 +1707,20 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSponsoredGuestPortalByID")
+		logDebugf(ctx, "Selected method: GetSponsoredGuestPortalByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SponsoredGuestPortal.GetSponsoredGuestPortalByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSponsoredGuestPortalGetSponsoredGuestPortalByIDItem(response2.SponsoredGuestPortal)
 		if err := d.Set("item", vItem2); err != nil {
@@ -1761,7 +1729,7 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSponsoredGuestPortalByID response",
 				err))
@@ -1774,7 +1742,7 @@ func resourceSponsoredGuestPortalRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceSponsoredGuestPortalUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsoredGuestPortal update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsoredGuestPortal update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1787,9 +1755,9 @@ func resourceSponsoredGuestPortalUpdate(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1814,15 +1782,15 @@ func resourceSponsoredGuestPortalUpdate(ctx context.Context, d *schema.ResourceD
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSponsoredGuestPortalUpdateSponsoredGuestPortalByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SponsoredGuestPortal.UpdateSponsoredGuestPortalByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSponsoredGuestPortalByID", err, restyResp1.String(),
 					"Failure at UpdateSponsoredGuestPortalByID, unexpected response", ""))
@@ -1840,7 +1808,7 @@ func resourceSponsoredGuestPortalUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceSponsoredGuestPortalDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SponsoredGuestPortal delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SponsoredGuestPortal delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -1852,9 +1820,9 @@ func resourceSponsoredGuestPortalDelete(ctx context.Context, d *schema.ResourceD
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -1890,7 +1858,7 @@ func resourceSponsoredGuestPortalDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.SponsoredGuestPortal.DeleteSponsoredGuestPortalByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSponsoredGuestPortalByID", err, restyResp1.String(),
 				"Failure at DeleteSponsoredGuestPortalByID, unexpected response", ""))
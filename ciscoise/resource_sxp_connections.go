@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSxpConnections() *schema.Resource {
 		ReadContext:   resourceSxpConnectionsRead,
 		UpdateContext: resourceSxpConnectionsUpdate,
 		DeleteContext: resourceSxpConnectionsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSxpConnectionsRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -196,46 +192,20 @@ func resourceSxpConnections() *schema.Resource {
 }
 
 func resourceSxpConnectionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpConnections create")
+	logDebugf(ctx, "Beginning SxpConnections create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSxpConnectionsCreateSxpConnections(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SxpConnections.GetSxpConnectionsByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSxpConnectionsRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSxpConnectionsQueryParams{}
-
-			response2, _, err := client.SxpConnections.GetSxpConnections(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSxpConnectionsGetSxpConnections(m, response2, &queryParams2)
-				item2, err := searchSxpConnectionsGetSxpConnections(m, items2, "", vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSxpConnectionsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SxpConnections.CreateSxpConnections(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -258,7 +228,7 @@ func resourceSxpConnectionsCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpConnections read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpConnections read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -269,27 +239,27 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSxpConnections")
+		logDebugf(ctx, "Selected method: GetSxpConnections")
 		queryParams1 := isegosdk.GetSxpConnectionsQueryParams{}
 
 		response1, restyResp1, err := client.SxpConnections.GetSxpConnections(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSxpConnectionsGetSxpConnections(m, response1, &queryParams1)
 		item1, err := searchSxpConnectionsGetSxpConnections(m, items1, "", vvID)
@@ -304,7 +274,7 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpConnections search response",
 				err))
@@ -313,20 +283,20 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSxpConnectionsByID")
+		logDebugf(ctx, "Selected method: GetSxpConnectionsByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SxpConnections.GetSxpConnectionsByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSxpConnectionsGetSxpConnectionsByIDItem(response2.ERSSxpConnection)
 		if err := d.Set("item", vItem2); err != nil {
@@ -335,7 +305,7 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpConnectionsByID response",
 				err))
@@ -348,7 +318,7 @@ func resourceSxpConnectionsRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSxpConnectionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpConnections update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpConnections update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -359,9 +329,9 @@ func resourceSxpConnectionsUpdate(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -371,15 +341,15 @@ func resourceSxpConnectionsUpdate(ctx context.Context, d *schema.ResourceData, m
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSxpConnectionsUpdateSxpConnectionsByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SxpConnections.UpdateSxpConnectionsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSxpConnectionsByID", err, restyResp1.String(),
 					"Failure at UpdateSxpConnectionsByID, unexpected response", ""))
@@ -397,7 +367,7 @@ func resourceSxpConnectionsUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSxpConnectionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpConnections delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpConnections delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -408,9 +378,9 @@ func resourceSxpConnectionsDelete(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -446,7 +416,7 @@ func resourceSxpConnectionsDelete(ctx context.Context, d *schema.ResourceData, m
 	restyResp1, err := client.SxpConnections.DeleteSxpConnectionsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpConnectionsByID", err, restyResp1.String(),
 				"Failure at DeleteSxpConnectionsByID, unexpected response", ""))
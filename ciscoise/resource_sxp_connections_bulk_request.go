@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceSxpConnectionsBulkRequest() *schema.Resource {
 		CreateContext: resourceSxpConnectionsBulkRequestCreate,
 		ReadContext:   resourceSxpConnectionsBulkRequestRead,
 		DeleteContext: resourceSxpConnectionsBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceSxpConnectionsBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceSxpConnectionsBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForSxpConnections create")
-	log.Printf("[DEBUG] Missing BulkRequestForSxpConnections create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForSxpConnections create")
+	logDebugf(ctx, "Missing BulkRequestForSxpConnections create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -74,7 +76,7 @@ func resourceSxpConnectionsBulkRequestCreate(ctx context.Context, d *schema.Reso
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -82,10 +84,16 @@ func resourceSxpConnectionsBulkRequestCreate(ctx context.Context, d *schema.Reso
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForSxpConnections", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.SxpConnections.MonitorBulkStatusSxpConnections(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceSxpConnectionsBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSxpConnectionsBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSxpConnectionsBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -94,9 +102,9 @@ func resourceSxpConnectionsBulkRequestRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceSxpConnectionsBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpConnectionsBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpConnectionsBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SxpConnectionsBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SxpConnectionsBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceSxpLocalBindings() *schema.Resource {
 		ReadContext:   resourceSxpLocalBindingsRead,
 		UpdateContext: resourceSxpLocalBindingsUpdate,
 		DeleteContext: resourceSxpLocalBindingsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSxpLocalBindingsRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -185,46 +181,20 @@ func resourceSxpLocalBindings() *schema.Resource {
 }
 
 func resourceSxpLocalBindingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpLocalBindings create")
+	logDebugf(ctx, "Beginning SxpLocalBindings create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSxpLocalBindingsCreateSxpLocalBindings(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SxpLocalBindings.GetSxpLocalBindingsByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSxpLocalBindingsRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSxpLocalBindingsQueryParams{}
-
-			response2, _, err := client.SxpLocalBindings.GetSxpLocalBindings(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSxpLocalBindingsGetSxpLocalBindings(m, response2, &queryParams2)
-				item2, err := searchSxpLocalBindingsGetSxpLocalBindings(m, items2, "", vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSxpLocalBindingsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SxpLocalBindings.CreateSxpLocalBindings(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -247,7 +217,7 @@ func resourceSxpLocalBindingsCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpLocalBindings read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpLocalBindings read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -258,27 +228,27 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetSxpLocalBindings")
+		logDebugf(ctx, "Selected method: GetSxpLocalBindings")
 		queryParams1 := isegosdk.GetSxpLocalBindingsQueryParams{}
 
 		response1, restyResp1, err := client.SxpLocalBindings.GetSxpLocalBindings(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSxpLocalBindingsGetSxpLocalBindings(m, response1, &queryParams1)
 		item1, err := searchSxpLocalBindingsGetSxpLocalBindings(m, items1, "", vvID)
@@ -293,7 +263,7 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpLocalBindings search response",
 				err))
@@ -302,20 +272,20 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSxpLocalBindingsByID")
+		logDebugf(ctx, "Selected method: GetSxpLocalBindingsByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SxpLocalBindings.GetSxpLocalBindingsByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSxpLocalBindingsGetSxpLocalBindingsByIDItem(response2.ERSSxpLocalBindings)
 		if err := d.Set("item", vItem2); err != nil {
@@ -324,7 +294,7 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpLocalBindingsByID response",
 				err))
@@ -337,7 +307,7 @@ func resourceSxpLocalBindingsRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSxpLocalBindingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpLocalBindings update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpLocalBindings update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -348,9 +318,9 @@ func resourceSxpLocalBindingsUpdate(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -359,15 +329,15 @@ func resourceSxpLocalBindingsUpdate(ctx context.Context, d *schema.ResourceData,
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSxpLocalBindingsUpdateSxpLocalBindingsByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SxpLocalBindings.UpdateSxpLocalBindingsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSxpLocalBindingsByID", err, restyResp1.String(),
 					"Failure at UpdateSxpLocalBindingsByID, unexpected response", ""))
@@ -385,7 +355,7 @@ func resourceSxpLocalBindingsUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSxpLocalBindingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpLocalBindings delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpLocalBindings delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -396,9 +366,9 @@ func resourceSxpLocalBindingsDelete(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -434,7 +404,7 @@ func resourceSxpLocalBindingsDelete(ctx context.Context, d *schema.ResourceData,
 	restyResp1, err := client.SxpLocalBindings.DeleteSxpLocalBindingsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpLocalBindingsByID", err, restyResp1.String(),
 				"Failure at DeleteSxpLocalBindingsByID, unexpected response", ""))
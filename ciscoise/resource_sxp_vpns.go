@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,9 +24,7 @@ func resourceSxpVpns() *schema.Resource {
 		ReadContext:   resourceSxpVpnsRead,
 		UpdateContext: resourceSxpVpnsUpdate,
 		DeleteContext: resourceSxpVpnsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceSxpVpnsRead, "id", "sxp_vpn_name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -123,50 +119,22 @@ func resourceSxpVpns() *schema.Resource {
 }
 
 func resourceSxpVpnsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpVpns create")
+	logDebugf(ctx, "Beginning SxpVpns create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestSxpVpnsCreateSxpVpn(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vName, _ := resourceItem["sxp_vpn_name"]
 	vvID := interfaceToString(vID)
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SxpVpns.GetSxpVpnByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["sxp_vpn_name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceSxpVpnsRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSxpVpnsQueryParams{}
-
-			response2, _, err := client.SxpVpns.GetSxpVpns(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSxpVpnsGetSxpVpns(m, response2, &queryParams2)
-				item2, err := searchSxpVpnsGetSxpVpns(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = item2.ID
-					resourceMap["sxp_vpn_name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceSxpVpnsRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	restyResp1, err := client.SxpVpns.CreateSxpVpn(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -190,7 +158,7 @@ func resourceSxpVpnsCreate(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpVpns read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpVpns read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -202,28 +170,28 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 	vName, _ := resourceMap["sxp_vpn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 	vvName := vName
 	vvID := vID
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSxpVpns")
+		logDebugf(ctx, "Selected method: GetSxpVpns")
 		queryParams1 := isegosdk.GetSxpVpnsQueryParams{}
 
 		response1, restyResp1, err := client.SxpVpns.GetSxpVpns(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSxpVpnsGetSxpVpns(m, response1, &queryParams1)
 		item1, err := searchSxpVpnsGetSxpVpns(m, items1, vvName, vvID)
@@ -238,7 +206,7 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpVpns search response",
 				err))
@@ -247,20 +215,20 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSxpVpnByID")
+		logDebugf(ctx, "Selected method: GetSxpVpnByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SxpVpns.GetSxpVpnByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSxpVpnsGetSxpVpnByIDItem(response2.ERSSxpVpn)
 		if err := d.Set("item", vItem2); err != nil {
@@ -269,7 +237,7 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSxpVpnByID response",
 				err))
@@ -282,14 +250,14 @@ func resourceSxpVpnsRead(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceSxpVpnsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpVpns update for id=[%s]", d.Id())
-	log.Printf("[DEBUG] Missing SxpVpns update on Cisco ISE. It will only be update it on Terraform")
+	logDebugf(ctx, "Beginning SxpVpns update for id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SxpVpns update on Cisco ISE. It will only be update it on Terraform")
 	// d.Set("last_updated", getUnixTimeString())
 	return resourceSxpVpnsRead(ctx, d, m)
 }
 
 func resourceSxpVpnsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpVpns delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpVpns delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -301,9 +269,9 @@ func resourceSxpVpnsDelete(ctx context.Context, d *schema.ResourceData, m interf
 	vName, _ := resourceMap["sxp_vpn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -339,7 +307,7 @@ func resourceSxpVpnsDelete(ctx context.Context, d *schema.ResourceData, m interf
 	restyResp1, err := client.SxpVpns.DeleteSxpVpnByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSxpVpnByID", err, restyResp1.String(),
 				"Failure at DeleteSxpVpnByID, unexpected response", ""))
@@ -4,9 +4,8 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +20,11 @@ func resourceSxpVpnsBulkRequest() *schema.Resource {
 		CreateContext: resourceSxpVpnsBulkRequestCreate,
 		ReadContext:   resourceSxpVpnsBulkRequestRead,
 		DeleteContext: resourceSxpVpnsBulkRequestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: bulkRequestSchema(map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Description: `Unix timestamp records the last time that the resource was updated.`,
 				Type:        schema.TypeString,
@@ -53,13 +55,13 @@ func resourceSxpVpnsBulkRequest() *schema.Resource {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func resourceSxpVpnsBulkRequestCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkRequestForSxpVpns create")
-	log.Printf("[DEBUG] Missing BulkRequestForSxpVpns create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkRequestForSxpVpns create")
+	logDebugf(ctx, "Missing BulkRequestForSxpVpns create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -74,7 +76,7 @@ func resourceSxpVpnsBulkRequestCreate(ctx context.Context, d *schema.ResourceDat
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
@@ -82,10 +84,16 @@ func resourceSxpVpnsBulkRequestCreate(ctx context.Context, d *schema.ResourceDat
 			err))
 		return diags
 	}
+	diags = append(diags, waitForBulkRequest(ctx, d, "BulkRequestForSxpVpns", response1, func(bulkID string) (interface{}, *resty.Response, error) {
+		return client.SxpVpns.MonitorBulkStatusSxpVpns(bulkID)
+	})...)
+	if diags.HasError() {
+		return diags
+	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
-	return resourceSxpVpnsBulkRequestRead(ctx, d, m)
+	return append(diags, resourceSxpVpnsBulkRequestRead(ctx, d, m)...)
 }
 
 func resourceSxpVpnsBulkRequestRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -94,9 +102,9 @@ func resourceSxpVpnsBulkRequestRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSxpVpnsBulkRequestDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SxpVpnsBulkRequest delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SxpVpnsBulkRequest delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SxpVpnsBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SxpVpnsBulkRequest delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
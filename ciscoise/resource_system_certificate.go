@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -33,9 +31,7 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 		ReadContext:   resourceSystemCertificateRead,
 		UpdateContext: resourceSystemCertificateUpdate,
 		DeleteContext: resourceSystemCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByPath(resourceSystemCertificateRead, []string{"host_name", "name"}, "id"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -368,8 +364,8 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 }
 
 func resourceSystemCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SystemCertificate create")
-	log.Printf("[DEBUG] Missing SystemCertificate create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning SystemCertificate create")
+	logDebugf(ctx, "Missing SystemCertificate create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -379,15 +375,15 @@ func resourceSystemCertificateCreate(ctx context.Context, d *schema.ResourceData
 	resourceMap := make(map[string]string)
 	vvID := interfaceToString(resourceItem["id"])
 	vvHostName := interfaceToString(resourceItem["host_name"])
-	log.Printf("[DEBUG] ID used for update operation %s", vvID)
+	logDebugf(ctx, "ID used for update operation %s", vvID)
 	request1 := expandRequestSystemCertificateUpdateSystemCert(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.UpdateSystemCert(vvID, vvHostName, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateSystemCert", err, restyResp1.String(),
 				"Failure at UpdateSystemCert, unexpected response", ""))
@@ -406,7 +402,7 @@ func resourceSystemCertificateCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SystemCertificate read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SystemCertificate read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -419,29 +415,29 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	vvHostName := vHostName
 	vvName := vName
 	vvID := vID
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSystemCertificates")
+		logDebugf(ctx, "Selected method: GetSystemCertificates")
 		queryParams1 := isegosdk.GetSystemCertificatesQueryParams{}
 
 		response1, restyResp1, err := client.Certificates.GetSystemCertificates(vvHostName, &queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsCertificatesGetSystemCertificates(m, response1, vvHostName, &queryParams1)
 		item1, err := searchCertificatesGetSystemCertificates(m, items1, vvName, vvID, vvHostName)
@@ -456,7 +452,7 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSystemCertificates search response",
 				err))
@@ -465,19 +461,19 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetSystemCertificateByID")
+		logDebugf(ctx, "Selected method: GetSystemCertificateByID")
 
 		response2, restyResp2, err := client.Certificates.GetSystemCertificateByID(vvHostName, vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenCertificatesGetSystemCertificateByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -486,7 +482,7 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSystemCertificateByID response",
 				err))
@@ -499,7 +495,7 @@ func resourceSystemCertificateRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceSystemCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SystemCertificate update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SystemCertificate update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -512,9 +508,9 @@ func resourceSystemCertificateUpdate(ctx context.Context, d *schema.ResourceData
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -527,15 +523,15 @@ func resourceSystemCertificateUpdate(ctx context.Context, d *schema.ResourceData
 		vvHostName = vHostName
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestSystemCertificateUpdateSystemCert(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Certificates.UpdateSystemCert(vvID, vvHostName, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSystemCert", err, restyResp1.String(),
 					"Failure at UpdateSystemCert, unexpected response", ""))
@@ -553,7 +549,7 @@ func resourceSystemCertificateUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceSystemCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SystemCertificate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SystemCertificate delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -566,9 +562,9 @@ func resourceSystemCertificateDelete(ctx context.Context, d *schema.ResourceData
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okHostName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -584,7 +580,7 @@ func resourceSystemCertificateDelete(ctx context.Context, d *schema.ResourceData
 	response1, restyResp1, err := client.Certificates.DeleteSystemCertificateByID(vvHostName, vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSystemCertificateByID", err, restyResp1.String(),
 				"Failure at DeleteSystemCertificateByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -218,8 +216,8 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 }
 
 func resourceSystemCertificateImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ImportSystemCert create")
-	log.Printf("[DEBUG] Missing ImportSystemCert create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ImportSystemCert create")
+	logDebugf(ctx, "Missing ImportSystemCert create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -227,12 +225,12 @@ func resourceSystemCertificateImportCreate(ctx context.Context, d *schema.Resour
 
 	request1 := expandRequestSystemCertificateImportImportSystemCert(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.ImportSystemCert(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ImportSystemCert", err, restyResp1.String(),
 				"Failure at ImportSystemCert, unexpected response", ""))
@@ -244,7 +242,7 @@ func resourceSystemCertificateImportCreate(ctx context.Context, d *schema.Resour
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesImportSystemCertItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -265,9 +263,9 @@ func resourceSystemCertificateImportRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceSystemCertificateImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning SystemCertificateImport delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning SystemCertificateImport delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing SystemCertificateImport delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing SystemCertificateImport delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestSystemCertificateImportImportSystemCert(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestCertificatesImportSystemCert {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceTacacsCommandSets() *schema.Resource {
 		ReadContext:   resourceTacacsCommandSetsRead,
 		UpdateContext: resourceTacacsCommandSetsUpdate,
 		DeleteContext: resourceTacacsCommandSetsDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTacacsCommandSetsRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -218,45 +214,22 @@ func resourceTacacsCommandSets() *schema.Resource {
 }
 
 func resourceTacacsCommandSetsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsCommandSets create")
+	logDebugf(ctx, "Beginning TacacsCommandSets create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTacacsCommandSetsCreateTacacsCommandSets(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.TacacsCommandSets.GetTacacsCommandSetsByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsCommandSetsRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.TacacsCommandSets.GetTacacsCommandSetsByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.TacacsCommandSets.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsCommandSetsRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.TacacsCommandSets.CreateTacacsCommandSets(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -280,7 +253,7 @@ func resourceTacacsCommandSetsCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsCommandSets read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsCommandSets read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -292,26 +265,26 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsCommandSetsByName")
+		logDebugf(ctx, "Selected method: GetTacacsCommandSetsByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.TacacsCommandSets.GetTacacsCommandSetsByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenTacacsCommandSetsGetTacacsCommandSetsByNameItemName(response1.TacacsCommandSets)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -320,13 +293,13 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsCommandSetsByName response",
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsCommandSetsByName response",
 				err))
@@ -336,20 +309,20 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsCommandSetsByID")
+		logDebugf(ctx, "Selected method: GetTacacsCommandSetsByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.TacacsCommandSets.GetTacacsCommandSetsByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenTacacsCommandSetsGetTacacsCommandSetsByIDItemID(response2.TacacsCommandSets)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -358,13 +331,13 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsCommandSetsByID response",
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsCommandSetsByID response",
 				err))
@@ -377,7 +350,7 @@ func resourceTacacsCommandSetsRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceTacacsCommandSetsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsCommandSets update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsCommandSets update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -389,9 +362,9 @@ func resourceTacacsCommandSetsUpdate(ctx context.Context, d *schema.ResourceData
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -414,15 +387,15 @@ func resourceTacacsCommandSetsUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTacacsCommandSetsUpdateTacacsCommandSetsByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.TacacsCommandSets.UpdateTacacsCommandSetsByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsCommandSetsByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsCommandSetsByID, unexpected response", ""))
@@ -440,7 +413,7 @@ func resourceTacacsCommandSetsUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceTacacsCommandSetsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsCommandSets delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsCommandSets delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -452,9 +425,9 @@ func resourceTacacsCommandSetsDelete(ctx context.Context, d *schema.ResourceData
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -482,7 +455,7 @@ func resourceTacacsCommandSetsDelete(ctx context.Context, d *schema.ResourceData
 	restyResp1, err := client.TacacsCommandSets.DeleteTacacsCommandSetsByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsCommandSetsByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsCommandSetsByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceTacacsExternalServers() *schema.Resource {
 		ReadContext:   resourceTacacsExternalServersRead,
 		UpdateContext: resourceTacacsExternalServersUpdate,
 		DeleteContext: resourceTacacsExternalServersDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTacacsExternalServersRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -195,45 +191,22 @@ func resourceTacacsExternalServers() *schema.Resource {
 }
 
 func resourceTacacsExternalServersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsExternalServers create")
+	logDebugf(ctx, "Beginning TacacsExternalServers create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTacacsExternalServersCreateTacacsExternalServers(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.TacacsExternalServers.GetTacacsExternalServersByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsExternalServersRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.TacacsExternalServers.GetTacacsExternalServersByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.TacacsExternalServer.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsExternalServersRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.TacacsExternalServers.CreateTacacsExternalServers(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -257,7 +230,7 @@ func resourceTacacsExternalServersCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsExternalServers read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsExternalServers read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -269,26 +242,26 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsExternalServersByName")
+		logDebugf(ctx, "Selected method: GetTacacsExternalServersByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.TacacsExternalServers.GetTacacsExternalServersByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenTacacsExternalServersGetTacacsExternalServersByNameItemName(response1.TacacsExternalServer)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -297,7 +270,7 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsExternalServersByName response",
 				err))
@@ -307,20 +280,20 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsExternalServersByID")
+		logDebugf(ctx, "Selected method: GetTacacsExternalServersByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.TacacsExternalServers.GetTacacsExternalServersByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenTacacsExternalServersGetTacacsExternalServersByIDItemID(response2.TacacsExternalServer)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -329,7 +302,7 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsExternalServersByID response",
 				err))
@@ -342,7 +315,7 @@ func resourceTacacsExternalServersRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTacacsExternalServersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsExternalServers update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsExternalServers update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -354,9 +327,9 @@ func resourceTacacsExternalServersUpdate(ctx context.Context, d *schema.Resource
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -379,15 +352,15 @@ func resourceTacacsExternalServersUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTacacsExternalServersUpdateTacacsExternalServersByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.TacacsExternalServers.UpdateTacacsExternalServersByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsExternalServersByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsExternalServersByID, unexpected response", ""))
@@ -405,7 +378,7 @@ func resourceTacacsExternalServersUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTacacsExternalServersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsExternalServers delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsExternalServers delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -417,9 +390,9 @@ func resourceTacacsExternalServersDelete(ctx context.Context, d *schema.Resource
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -447,7 +420,7 @@ func resourceTacacsExternalServersDelete(ctx context.Context, d *schema.Resource
 	restyResp1, err := client.TacacsExternalServers.DeleteTacacsExternalServersByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsExternalServersByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsExternalServersByID, unexpected response", ""))
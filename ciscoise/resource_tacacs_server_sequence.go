@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceTacacsServerSequence() *schema.Resource {
 		ReadContext:   resourceTacacsServerSequenceRead,
 		UpdateContext: resourceTacacsServerSequenceUpdate,
 		DeleteContext: resourceTacacsServerSequenceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTacacsServerSequenceRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -220,45 +216,22 @@ The order of the names in the string is the order of servers that will be used d
 }
 
 func resourceTacacsServerSequenceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsServerSequence create")
+	logDebugf(ctx, "Beginning TacacsServerSequence create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTacacsServerSequenceCreateTacacsServerSequence(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse1, _, err := client.TacacsServerSequence.GetTacacsServerSequenceByID(vvID)
-			if err == nil && getResponse1 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsServerSequenceRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			getResponse2, _, err := client.TacacsServerSequence.GetTacacsServerSequenceByName(vvName)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = getResponse2.TacacsServerSequence.ID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTacacsServerSequenceRead(ctx, d, m)
-			}
-		}
-	}
 	restyResp1, err := client.TacacsServerSequence.CreateTacacsServerSequence(request1)
 	if err != nil {
 		if restyResp1 != nil {
@@ -282,7 +255,7 @@ func resourceTacacsServerSequenceCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsServerSequence read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsServerSequence read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -294,26 +267,26 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetTacacsServerSequenceByName")
+		logDebugf(ctx, "Selected method: GetTacacsServerSequenceByName")
 		vvName := vName
 
 		response1, restyResp1, err := client.TacacsServerSequence.GetTacacsServerSequenceByName(vvName)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItemName1 := flattenTacacsServerSequenceGetTacacsServerSequenceByNameItemName(response1.TacacsServerSequence)
 		if err := d.Set("item", vItemName1); err != nil {
@@ -322,7 +295,7 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemName1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemName1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsServerSequenceByName response",
 				err))
@@ -332,20 +305,20 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTacacsServerSequenceByID")
+		logDebugf(ctx, "Selected method: GetTacacsServerSequenceByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.TacacsServerSequence.GetTacacsServerSequenceByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItemID2 := flattenTacacsServerSequenceGetTacacsServerSequenceByIDItemID(response2.TacacsServerSequence)
 		if err := d.Set("item", vItemID2); err != nil {
@@ -354,7 +327,7 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItemID2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItemID2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTacacsServerSequenceByID response",
 				err))
@@ -367,7 +340,7 @@ func resourceTacacsServerSequenceRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTacacsServerSequenceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsServerSequence update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsServerSequence update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -379,9 +352,9 @@ func resourceTacacsServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -404,15 +377,15 @@ func resourceTacacsServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTacacsServerSequenceUpdateTacacsServerSequenceByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.TacacsServerSequence.UpdateTacacsServerSequenceByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTacacsServerSequenceByID", err, restyResp1.String(),
 					"Failure at UpdateTacacsServerSequenceByID, unexpected response", ""))
@@ -430,7 +403,7 @@ func resourceTacacsServerSequenceUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceTacacsServerSequenceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TacacsServerSequence delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TacacsServerSequence delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -442,9 +415,9 @@ func resourceTacacsServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	vID, okID := resourceMap["id"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -472,7 +445,7 @@ func resourceTacacsServerSequenceDelete(ctx context.Context, d *schema.ResourceD
 	restyResp1, err := client.TacacsServerSequence.DeleteTacacsServerSequenceByID(vvID)
 	if err != nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTacacsServerSequenceByID", err, restyResp1.String(),
 				"Failure at DeleteTacacsServerSequenceByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,8 +52,8 @@ given MAC Address.
 }
 
 func resourceThreatVulnerabilitiesClearCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ClearThreatsAndVulnerabilities create")
-	log.Printf("[DEBUG] Missing ClearThreatsAndVulnerabilities create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ClearThreatsAndVulnerabilities create")
+	logDebugf(ctx, "Missing ClearThreatsAndVulnerabilities create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -64,7 +62,7 @@ func resourceThreatVulnerabilitiesClearCreate(ctx context.Context, d *schema.Res
 	response1, err := client.ClearThreatsAndVulnerabilities.ClearThreatsAndVulnerabilities(request1)
 	if err != nil || response1 == nil {
 		if response1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", response1.String())
+			logDebugf(ctx, "Retrieved error response %s", response1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing ClearThreatsAndVulnerabilities", err, response1.String(),
 				"Failure at ClearThreatsAndVulnerabilities, unexpected response", ""))
@@ -75,7 +73,7 @@ func resourceThreatVulnerabilitiesClearCreate(ctx context.Context, d *schema.Res
 			"Failure at ClearThreatsAndVulnerabilities, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %s", response1.String())
+	logDebugf(ctx, "Retrieved response %s", response1.String())
 	if err := d.Set("item", response1.String()); err != nil {
 		diags = append(diags, diagError(
 			"Failure when setting ClearThreatsAndVulnerabilities response",
@@ -94,9 +92,9 @@ func resourceThreatVulnerabilitiesClearRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceThreatVulnerabilitiesClearDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ThreatVulnerabilitiesClear delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning ThreatVulnerabilitiesClear delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing ThreatVulnerabilitiesClear delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing ThreatVulnerabilitiesClear delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -81,8 +79,8 @@ in case of air-gapped network.
 }
 
 func resourceTransportGatewaySettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TransportGatewaySettings create")
-	log.Printf("[DEBUG] Missing TransportGatewaySettings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning TransportGatewaySettings create")
+	logDebugf(ctx, "Missing TransportGatewaySettings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -90,15 +88,15 @@ func resourceTransportGatewaySettingsCreate(ctx context.Context, d *schema.Resou
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
 
-	log.Printf("[DEBUG] Name used for update operation")
+	logDebugf(ctx, "Name used for update operation")
 	request1 := expandRequestTransportGatewaySettingsUpdateTransportGateway(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Telemetry.UpdateTransportGateway(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateTransportGateway", err, restyResp1.String(),
 				"Failure at UpdateTransportGateway, unexpected response", ""))
@@ -115,7 +113,7 @@ func resourceTransportGatewaySettingsCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceTransportGatewaySettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TransportGatewaySettings read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TransportGatewaySettings read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -123,19 +121,19 @@ func resourceTransportGatewaySettingsRead(ctx context.Context, d *schema.Resourc
 
 	selectedMethod := 1
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetTransportGateway")
+		logDebugf(ctx, "Selected method: GetTransportGateway")
 
 		response1, restyResp1, err := client.Telemetry.GetTransportGateway()
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		vItem1 := flattenTelemetryGetTransportGatewayItem(response1.Response)
 		if err := d.Set("item", vItem1); err != nil {
@@ -144,7 +142,7 @@ func resourceTransportGatewaySettingsRead(ctx context.Context, d *schema.Resourc
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetTransportGateway response",
 				err))
@@ -157,21 +155,21 @@ func resourceTransportGatewaySettingsRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceTransportGatewaySettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TransportGatewaySettings update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TransportGatewaySettings update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] Name used for update operation")
+		logDebugf(ctx, "Name used for update operation")
 		request1 := expandRequestTransportGatewaySettingsUpdateTransportGateway(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Telemetry.UpdateTransportGateway(request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTransportGateway", err, restyResp1.String(),
 					"Failure at UpdateTransportGateway, unexpected response", ""))
@@ -189,9 +187,9 @@ func resourceTransportGatewaySettingsUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceTransportGatewaySettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TransportGatewaySettings delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TransportGatewaySettings delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TransportGatewaySettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TransportGatewaySettings delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestTransportGatewaySettingsUpdateTransportGateway(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestTelemetryUpdateTransportGateway {
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -27,9 +25,7 @@ func resourceTrustedCertificate() *schema.Resource {
 		ReadContext:   resourceTrustedCertificateRead,
 		UpdateContext: resourceTrustedCertificateUpdate,
 		DeleteContext: resourceTrustedCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTrustedCertificateRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -497,8 +493,8 @@ func resourceTrustedCertificate() *schema.Resource {
 }
 
 func resourceTrustedCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustedCertificate create")
-	log.Printf("[DEBUG] Missing TrustedCertificate create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning TrustedCertificate create")
+	logDebugf(ctx, "Missing TrustedCertificate create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -506,15 +502,15 @@ func resourceTrustedCertificateCreate(ctx context.Context, d *schema.ResourceDat
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	resourceMap := make(map[string]string)
 	vvID := interfaceToString(resourceItem["id"])
-	log.Printf("[DEBUG] ID used for update operation %s", vvID)
+	logDebugf(ctx, "ID used for update operation %s", vvID)
 	request1 := expandRequestTrustedCertificateUpdateTrustedCertificate(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.UpdateTrustedCertificate(vvID, request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing UpdateTrustedCertificate", err, restyResp1.String(),
 				"Failure at UpdateTrustedCertificate, unexpected response", ""))
@@ -531,7 +527,7 @@ func resourceTrustedCertificateCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustedCertificate read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustedCertificate read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -543,21 +539,21 @@ func resourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceData,
 	vvID := vID
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 
-	log.Printf("[DEBUG] Selected method: GetTrustedCertificateByID")
+	logDebugf(ctx, "Selected method: GetTrustedCertificateByID")
 
 	response2, restyResp2, err := client.Certificates.GetTrustedCertificateByID(vvID)
 
 	if err != nil || response2 == nil {
 		if restyResp2 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 	vItem2 := flattenCertificatesGetTrustedCertificateByIDItem(response2.Response)
 	if err := d.Set("item", vItem2); err != nil {
@@ -576,7 +572,7 @@ func resourceTrustedCertificateRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceTrustedCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustedCertificate update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustedCertificate update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -587,15 +583,15 @@ func resourceTrustedCertificateUpdate(ctx context.Context, d *schema.ResourceDat
 	vvID := resourceMap["id"]
 
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTrustedCertificateUpdateTrustedCertificate(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.Certificates.UpdateTrustedCertificate(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateTrustedCertificate", err, restyResp1.String(),
 					"Failure at UpdateTrustedCertificate, unexpected response", ""))
@@ -613,7 +609,7 @@ func resourceTrustedCertificateUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTrustedCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustedCertificate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustedCertificate delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -634,7 +630,7 @@ func resourceTrustedCertificateDelete(ctx context.Context, d *schema.ResourceDat
 	response1, restyResp1, err := client.Certificates.DeleteTrustedCertificateByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteTrustedCertificateByID", err, restyResp1.String(),
 				"Failure at DeleteTrustedCertificateByID, unexpected response", ""))
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -144,20 +142,20 @@ Request parameters accepting True and False as input can be replaced by 1 and 0
 }
 
 func resourceTrustedCertificateImportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning ImportTrustCert create")
-	log.Printf("[DEBUG] Missing ImportTrustCert create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning ImportTrustCert create")
+	logDebugf(ctx, "Missing ImportTrustCert create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 	request1 := expandRequestTrustedCertificateImportImportTrustCert(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 	response1, restyResp1, err := client.Certificates.ImportTrustCert(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing ImportTrustCert", err,
@@ -165,7 +163,7 @@ func resourceTrustedCertificateImportCreate(ctx context.Context, d *schema.Resou
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenCertificatesImportTrustCertItem(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
@@ -186,9 +184,9 @@ func resourceTrustedCertificateImportRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceTrustedCertificateImportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustedCertificateImport delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustedCertificateImport delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustedCertificateImport delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustedCertificateImport delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,8 @@ func resourceTrustsecNbarApp() *schema.Resource {
 		ReadContext:   resourceTrustsecNbarAppRead,
 		UpdateContext: resourceTrustsecNbarAppUpdate,
 		DeleteContext: resourceTrustsecNbarAppDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		// The parameters have no name, an application is identified by its ID
+		Importer: registerImporter(importStateByNameOrID(resourceTrustsecNbarAppRead, "id", "name"), []importKey{{"id", "parameters.0.id"}}),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -109,51 +106,22 @@ func resourceTrustsecNbarApp() *schema.Resource {
 }
 
 func resourceTrustsecNbarAppCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecNbarApp create")
+	logDebugf(ctx, "Beginning TrustsecNbarApp create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTrustsecNbarAppCreateNbarApp(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
-	vName, okName := resourceItem["name"]
+	vName := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.NbarApp.GetNbarAppByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTrustsecNbarAppRead(ctx, d, m)
-			}
-		}
-		if okName && vvName != "" {
-			queryParams2 := isegosdk.GetNbarAppsQueryParams{}
-
-			response2, _, err := client.NbarApp.GetNbarApps(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsNbarAppGetNbarApps(m, response2, &queryParams2)
-				item2, err := searchNbarAppGetNbarApps(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceTrustsecNbarAppRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.NbarApp.CreateNbarApp(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -173,7 +141,7 @@ func resourceTrustsecNbarAppCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecNbarApp read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecNbarApp read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -186,28 +154,28 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 2 {
 		vvName := vName
 		vvID := vID
-		log.Printf("[DEBUG] Selected method: GetNbarApps")
+		logDebugf(ctx, "Selected method: GetNbarApps")
 		queryParams1 := isegosdk.GetNbarAppsQueryParams{}
 
 		response1, restyResp1, err := client.NbarApp.GetNbarApps(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsNbarAppGetNbarApps(m, response1, nil)
 		item1, err := searchNbarAppGetNbarApps(m, items1, vvName, vvID)
@@ -222,7 +190,7 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNbarApps search response",
 				err))
@@ -231,20 +199,20 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetNbarAppByID")
+		logDebugf(ctx, "Selected method: GetNbarAppByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.NbarApp.GetNbarAppByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenNbarAppGetNbarAppByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -253,7 +221,7 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetNbarAppByID response",
 				err))
@@ -266,7 +234,7 @@ func resourceTrustsecNbarAppRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceTrustsecNbarAppUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecNbarApp update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecNbarApp update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -279,9 +247,9 @@ func resourceTrustsecNbarAppUpdate(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -309,15 +277,15 @@ func resourceTrustsecNbarAppUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 	// NOTE: Added getAllItems and search function to get missing params
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTrustsecNbarAppUpdateNbarAppByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.NbarApp.UpdateNbarAppByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateNbarAppByID", err, restyResp1.String(),
 					"Failure at UpdateNbarAppByID, unexpected response", ""))
@@ -335,7 +303,7 @@ func resourceTrustsecNbarAppUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceTrustsecNbarAppDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecNbarApp delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecNbarApp delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -348,9 +316,9 @@ func resourceTrustsecNbarAppDelete(ctx context.Context, d *schema.ResourceData,
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -390,7 +358,7 @@ func resourceTrustsecNbarAppDelete(ctx context.Context, d *schema.ResourceData,
 	response1, restyResp1, err := client.NbarApp.DeleteNbarAppByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteNbarAppByID", err, restyResp1.String(),
 				"Failure at DeleteNbarAppByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceTrustsecSgVnMapping() *schema.Resource {
 		ReadContext:   resourceTrustsecSgVnMappingRead,
 		UpdateContext: resourceTrustsecSgVnMappingUpdate,
 		DeleteContext: resourceTrustsecSgVnMappingDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTrustsecSgVnMappingRead, "id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -135,20 +131,19 @@ func resourceTrustsecSgVnMapping() *schema.Resource {
 }
 
 func resourceTrustsecSgVnMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMapping create")
+	logDebugf(ctx, "Beginning TrustsecSgVnMapping create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTrustsecSgVnMappingCreateSgVnMapping(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vSgName, _ := resourceItem["sg_name"]
 	vvSgName := interfaceToString(vSgName)
@@ -158,39 +153,6 @@ func resourceTrustsecSgVnMappingCreate(ctx context.Context, d *schema.ResourceDa
 	vvVnID := interfaceToString(vVnID)
 	vVnName, _ := resourceItem["vn_name"]
 	vvVnName := interfaceToString(vVnName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.SgVnMapping.GetSgVnMappingByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["sg_name"] = vvSgName
-				resourceMap["sgt_id"] = vvSgtID
-				resourceMap["vn_id"] = vvVnID
-				resourceMap["vn_name"] = vvVnName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTrustsecSgVnMappingRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetSgVnMappingListQueryParams{}
-
-			response2, _, err := client.SgVnMapping.GetSgVnMappingList(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsSgVnMappingGetSgVnMappingList(m, response2, &queryParams2)
-				item2, err := searchSgVnMappingGetSgVnMappingList(m, items2, vvSgName, vvSgtID, vvVnID, vvVnName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = vvID
-					resourceMap["sg_name"] = vvSgName
-					resourceMap["sgt_id"] = vvSgtID
-					resourceMap["vn_id"] = vvVnID
-					resourceMap["vn_name"] = vvVnName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceTrustsecSgVnMappingRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.SgVnMapping.CreateSgVnMapping(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -213,7 +175,7 @@ func resourceTrustsecSgVnMappingCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMapping read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecSgVnMapping read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -228,9 +190,9 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 	vVnName, _ := resourceMap["vn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
@@ -239,19 +201,19 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 		vvSgtID := vSgtID
 		vvVnID := vVnID
 		vvVnName := vVnName
-		log.Printf("[DEBUG] Selected method: GetSgVnMappingList")
+		logDebugf(ctx, "Selected method: GetSgVnMappingList")
 		queryParams1 := isegosdk.GetSgVnMappingListQueryParams{}
 
 		response1, restyResp1, err := client.SgVnMapping.GetSgVnMappingList(&queryParams1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsSgVnMappingGetSgVnMappingList(m, response1, nil)
 		item1, err := searchSgVnMappingGetSgVnMappingList(m, items1, vvSgName, vvSgtID, vvVnID, vvVnName, vvID)
@@ -266,7 +228,7 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSgVnMappingList search response",
 				err))
@@ -275,20 +237,20 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSgVnMappingByID")
+		logDebugf(ctx, "Selected method: GetSgVnMappingByID")
 		vvID := vID
 
 		response2, restyResp2, err := client.SgVnMapping.GetSgVnMappingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenSgVnMappingGetSgVnMappingByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -297,7 +259,7 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetSgVnMappingByID response",
 				err))
@@ -310,7 +272,7 @@ func resourceTrustsecSgVnMappingRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMapping update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecSgVnMapping update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -324,9 +286,9 @@ func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 	vVnID, _ := resourceMap["vn_id"]
 	vVnName, _ := resourceMap["vn_name"]
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -338,7 +300,7 @@ func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 		vvVnID := vVnID
 		vvVnName := vVnName
 
-		log.Printf("[DEBUG] Selected method: GetSgVnMappingList")
+		logDebugf(ctx, "Selected method: GetSgVnMappingList")
 		queryParams2 := isegosdk.GetSgVnMappingListQueryParams{}
 		response1, _, err := client.SgVnMapping.GetSgVnMappingList(&queryParams2)
 
@@ -357,15 +319,15 @@ func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTrustsecSgVnMappingUpdateSgVnMappingByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.SgVnMapping.UpdateSgVnMapping(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateSgVnMappingByID", err, restyResp1.String(),
 					"Failure at UpdateSgVnMappingByID, unexpected response", ""))
@@ -383,7 +345,7 @@ func resourceTrustsecSgVnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTrustsecSgVnMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMapping delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecSgVnMapping delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -398,9 +360,9 @@ func resourceTrustsecSgVnMappingDelete(ctx context.Context, d *schema.ResourceDa
 	vVnName, _ := resourceMap["vn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	var vvID string
@@ -446,7 +408,7 @@ func resourceTrustsecSgVnMappingDelete(ctx context.Context, d *schema.ResourceDa
 	response1, restyResp1, err := client.SgVnMapping.DeleteSgVnMapping(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteSgVnMappingByID", err, restyResp1.String(),
 				"Failure at DeleteSgVnMappingByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -105,8 +103,8 @@ func resourceTrustsecSgVnMappingBulkCreate() *schema.Resource {
 }
 
 func resourceTrustsecSgVnMappingBulkCreateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkCreateSgVnMappings create")
-	log.Printf("[DEBUG] Missing BulkCreateSgVnMappings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkCreateSgVnMappings create")
+	logDebugf(ctx, "Missing BulkCreateSgVnMappings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -116,7 +114,7 @@ func resourceTrustsecSgVnMappingBulkCreateCreate(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.SgVnMapping.BulkCreateSgVnMappings(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkCreateSgVnMappings", err,
@@ -124,7 +122,7 @@ func resourceTrustsecSgVnMappingBulkCreateCreate(ctx context.Context, d *schema.
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenSgVnMappingBulkCreateSgVnMappingsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -133,7 +131,7 @@ func resourceTrustsecSgVnMappingBulkCreateCreate(ctx context.Context, d *schema.
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecSgVnMappingBulkCreateRead(ctx, d, m)
 }
@@ -144,9 +142,9 @@ func resourceTrustsecSgVnMappingBulkCreateRead(ctx context.Context, d *schema.Re
 }
 
 func resourceTrustsecSgVnMappingBulkCreateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMappingBulkCreate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecSgVnMappingBulkCreate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecSgVnMappingBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecSgVnMappingBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,8 +64,8 @@ func resourceTrustsecSgVnMappingBulkDelete() *schema.Resource {
 }
 
 func resourceTrustsecSgVnMappingBulkDeleteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkDeleteSgVnMappings create")
-	log.Printf("[DEBUG] Missing BulkDeleteSgVnMappings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkDeleteSgVnMappings create")
+	logDebugf(ctx, "Missing BulkDeleteSgVnMappings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -77,7 +75,7 @@ func resourceTrustsecSgVnMappingBulkDeleteCreate(ctx context.Context, d *schema.
 	response1, restyResp1, err := client.SgVnMapping.BulkDeleteSgVnMappings(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkDeleteSgVnMappings", err,
@@ -85,7 +83,7 @@ func resourceTrustsecSgVnMappingBulkDeleteCreate(ctx context.Context, d *schema.
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenSgVnMappingBulkDeleteSgVnMappingsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -94,8 +92,8 @@ func resourceTrustsecSgVnMappingBulkDeleteCreate(ctx context.Context, d *schema.
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[INFO] CREATED")
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logInfof(ctx, "CREATED")
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecSgVnMappingBulkDeleteRead(ctx, d, m)
 }
@@ -106,9 +104,9 @@ func resourceTrustsecSgVnMappingBulkDeleteRead(ctx context.Context, d *schema.Re
 }
 
 func resourceTrustsecSgVnMappingBulkDeleteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecSgVnMappingBulkDelete delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecSgVnMappingBulkDelete delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecSgVnMappingBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecSgVnMappingBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
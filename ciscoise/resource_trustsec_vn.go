@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceTrustsecVn() *schema.Resource {
 		ReadContext:   resourceTrustsecVnRead,
 		UpdateContext: resourceTrustsecVnUpdate,
 		DeleteContext: resourceTrustsecVnDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTrustsecVnRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -107,50 +103,22 @@ func resourceTrustsecVn() *schema.Resource {
 }
 
 func resourceTrustsecVnCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVn create")
+	logDebugf(ctx, "Beginning TrustsecVn create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTrustsecVnCreateVirtualNetwork(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.VirtualNetwork.GetVirtualNetworkByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTrustsecVnRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetVirtualNetworksQueryParams{}
-
-			response2, _, err := client.VirtualNetwork.GetVirtualNetworks(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsVirtualNetworkGetVirtualNetworks(m, response2, &queryParams2)
-				item2, err := searchVirtualNetworkGetVirtualNetworks(m, items2, vvName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceTrustsecVnRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.VirtualNetwork.CreateVirtualNetwork(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -170,7 +138,7 @@ func resourceTrustsecVnCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVn read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVn read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -181,28 +149,28 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 	vID, okID := resourceMap["id"]
 	vName, okName := resourceMap["name"]
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	vvID := vID
 	vvName := vName
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetVirtualNetworks")
+		logDebugf(ctx, "Selected method: GetVirtualNetworks")
 		queryParams1 := isegosdk.GetVirtualNetworksQueryParams{}
 
 		response1, restyResp1, err := client.VirtualNetwork.GetVirtualNetworks(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsVirtualNetworkGetVirtualNetworks(m, response1, nil)
 		item1, err := searchVirtualNetworkGetVirtualNetworks(m, items1, vvName, vvID)
@@ -217,7 +185,7 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetVirtualNetworks search response",
 				err))
@@ -226,18 +194,18 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	}
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetVirtualNetworkByID")
+		logDebugf(ctx, "Selected method: GetVirtualNetworkByID")
 		response2, restyResp2, err := client.VirtualNetwork.GetVirtualNetworkByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenVirtualNetworkGetVirtualNetworkByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -246,7 +214,7 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetVirtualNetworkByID response",
 				err))
@@ -259,7 +227,7 @@ func resourceTrustsecVnRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceTrustsecVnUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVn update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVn update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -271,15 +239,15 @@ func resourceTrustsecVnUpdate(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	vvID := vID
 	// NOTE: Added getAllItems and search function to get missing params
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetSgVnMappings")
+		logDebugf(ctx, "Selected method: GetSgVnMappings")
 		queryParams1 := isegosdk.GetVirtualNetworksQueryParams{}
 		getResp1, _, err := client.VirtualNetwork.GetVirtualNetworks(&queryParams1)
 
@@ -297,15 +265,15 @@ func resourceTrustsecVnUpdate(ctx context.Context, d *schema.ResourceData, m int
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTrustsecVnUpdateVirtualNetworkByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.VirtualNetwork.UpdateVirtualNetworkByID(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateVirtualNetworkByID", err, restyResp1.String(),
 					"Failure at UpdateVirtualNetworkByID, unexpected response", ""))
@@ -323,7 +291,7 @@ func resourceTrustsecVnUpdate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceTrustsecVnDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVn delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVn delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -335,9 +303,9 @@ func resourceTrustsecVnDelete(ctx context.Context, d *schema.ResourceData, m int
 	vName, okName := resourceMap["name"]
 
 	method1 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okName}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	vvID := vID
@@ -377,7 +345,7 @@ func resourceTrustsecVnDelete(ctx context.Context, d *schema.ResourceData, m int
 	response1, restyResp1, err := client.VirtualNetwork.DeleteVirtualNetworkByID(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteVirtualNetworkByID", err, restyResp1.String(),
 				"Failure at DeleteVirtualNetworkByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -93,8 +91,8 @@ func resourceTrustsecVnBulkCreate() *schema.Resource {
 }
 
 func resourceTrustsecVnBulkCreateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkCreateVirtualNetworks create")
-	log.Printf("[DEBUG] Missing BulkCreateVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkCreateVirtualNetworks create")
+	logDebugf(ctx, "Missing BulkCreateVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -104,7 +102,7 @@ func resourceTrustsecVnBulkCreateCreate(ctx context.Context, d *schema.ResourceD
 	response1, restyResp1, err := client.VirtualNetwork.BulkCreateVirtualNetworks(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkCreateVirtualNetworks", err,
@@ -112,7 +110,7 @@ func resourceTrustsecVnBulkCreateCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVirtualNetworkBulkCreateVirtualNetworksItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -121,7 +119,7 @@ func resourceTrustsecVnBulkCreateCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecVnBulkCreateRead(ctx, d, m)
 }
@@ -132,9 +130,9 @@ func resourceTrustsecVnBulkCreateRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTrustsecVnBulkCreateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnBulkCreate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnBulkCreate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
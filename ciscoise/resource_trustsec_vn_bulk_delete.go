@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,8 +64,8 @@ func resourceTrustsecVnBulkDelete() *schema.Resource {
 }
 
 func resourceTrustsecVnBulkDeleteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkDeleteVirtualNetworks create")
-	log.Printf("[DEBUG] Missing BulkDeleteVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkDeleteVirtualNetworks create")
+	logDebugf(ctx, "Missing BulkDeleteVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -77,7 +75,7 @@ func resourceTrustsecVnBulkDeleteCreate(ctx context.Context, d *schema.ResourceD
 	response1, restyResp1, err := client.VirtualNetwork.BulkDeleteVirtualNetworks(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkDeleteVirtualNetworks", err,
@@ -85,7 +83,7 @@ func resourceTrustsecVnBulkDeleteCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVirtualNetworkBulkDeleteVirtualNetworksItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -94,7 +92,7 @@ func resourceTrustsecVnBulkDeleteCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecVnBulkDeleteRead(ctx, d, m)
 }
@@ -105,9 +103,9 @@ func resourceTrustsecVnBulkDeleteRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTrustsecVnBulkDeleteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnBulkDelete delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnBulkDelete delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -88,8 +86,8 @@ func resourceTrustsecVnBulkUpdate() *schema.Resource {
 }
 
 func resourceTrustsecVnBulkUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkUpdateVirtualNetworks create")
-	log.Printf("[DEBUG] Missing BulkUpdateVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkUpdateVirtualNetworks create")
+	logDebugf(ctx, "Missing BulkUpdateVirtualNetworks create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -99,7 +97,7 @@ func resourceTrustsecVnBulkUpdateCreate(ctx context.Context, d *schema.ResourceD
 	response1, restyResp1, err := client.VirtualNetwork.BulkUpdateVirtualNetworks(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkUpdateVirtualNetworks", err,
@@ -107,7 +105,7 @@ func resourceTrustsecVnBulkUpdateCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVirtualNetworkBulkUpdateVirtualNetworksItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -116,7 +114,7 @@ func resourceTrustsecVnBulkUpdateCreate(ctx context.Context, d *schema.ResourceD
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecVnBulkUpdateRead(ctx, d, m)
 }
@@ -127,9 +125,9 @@ func resourceTrustsecVnBulkUpdateRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceTrustsecVnBulkUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnBulkUpdate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnBulkUpdate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnBulkUpdate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnBulkUpdate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceTrustsecVnVLANMapping() *schema.Resource {
 		ReadContext:   resourceTrustsecVnVLANMappingRead,
 		UpdateContext: resourceTrustsecVnVLANMappingUpdate,
 		DeleteContext: resourceTrustsecVnVLANMappingDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceTrustsecVnVLANMappingRead, "id", "name"),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -163,20 +159,19 @@ func resourceTrustsecVnVLANMapping() *schema.Resource {
 }
 
 func resourceTrustsecVnVLANMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMapping create")
+	logDebugf(ctx, "Beginning TrustsecVnVLANMapping create")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
-	isEnableAutoImport := clientConfig.EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestTrustsecVnVLANMappingCreateVnVLANMapping(ctx, "parameters.0", d)
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vID, okID := resourceItem["id"]
+	vID := resourceItem["id"]
 	vvID := interfaceToString(vID)
 	vName, _ := resourceItem["name"]
 	vvName := interfaceToString(vName)
@@ -184,37 +179,6 @@ func resourceTrustsecVnVLANMappingCreate(ctx context.Context, d *schema.Resource
 	vvVnID := interfaceToString(vVnID)
 	vVnName, _ := resourceItem["vn_name"]
 	vvVnName := interfaceToString(vVnName)
-	if isEnableAutoImport {
-		if okID && vvID != "" {
-			getResponse2, _, err := client.VnVLANMapping.GetVnVLANMappingByID(vvID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["id"] = vvID
-				resourceMap["name"] = vvName
-				resourceMap["vn_id"] = vvVnID
-				resourceMap["vn_name"] = vvVnName
-				d.SetId(joinResourceID(resourceMap))
-				return resourceTrustsecVnVLANMappingRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetVnVLANMappingListQueryParams{}
-
-			response2, _, err := client.VnVLANMapping.GetVnVLANMappingList(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsVnVLANMappingGetVnVLANMappings(m, response2, &queryParams2)
-				item2, err := searchVnVLANMappingGetVnVLANMappings(m, items2, vvName, vvVnID, vvVnName, vvID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["id"] = vvID
-					resourceMap["name"] = vvName
-					resourceMap["vn_id"] = vvVnID
-					resourceMap["vn_name"] = vvVnName
-					d.SetId(joinResourceID(resourceMap))
-					return resourceTrustsecVnVLANMappingRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.VnVLANMapping.CreateVnVLANMapping(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -236,7 +200,7 @@ func resourceTrustsecVnVLANMappingCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMapping read for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMapping read for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -251,26 +215,26 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 	vvVnName, _ := resourceMap["vn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetVnVLANMappings")
+		logDebugf(ctx, "Selected method: GetVnVLANMappings")
 		queryParams1 := isegosdk.GetVnVLANMappingListQueryParams{}
 
 		response1, restyResp1, err := client.VnVLANMapping.GetVnVLANMappingList(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsVnVLANMappingGetVnVLANMappings(m, response1, nil)
 		item1, err := searchVnVLANMappingGetVnVLANMappings(m, items1, vvName, vvVnID, vvVnName, vvID)
@@ -285,7 +249,7 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetVnVLANMappings search response",
 				err))
@@ -294,20 +258,20 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetVnVLANMappingByID")
+		logDebugf(ctx, "Selected method: GetVnVLANMappingByID")
 		vvID = vID
 
 		response2, restyResp2, err := client.VnVLANMapping.GetVnVLANMappingByID(vvID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenVnVLANMappingGetVnVLANMappingByIDItem(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -316,7 +280,7 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetVnVLANMappingByID response",
 				err))
@@ -329,7 +293,7 @@ func resourceTrustsecVnVLANMappingRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceTrustsecVnVLANMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMapping update for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMapping update for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -344,9 +308,9 @@ func resourceTrustsecVnVLANMappingUpdate(ctx context.Context, d *schema.Resource
 	vvVnName, _ := resourceMap["vn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// NOTE: Added getAllItems and search function to get missing params
@@ -370,15 +334,15 @@ func resourceTrustsecVnVLANMappingUpdate(ctx context.Context, d *schema.Resource
 		vvID = vID
 	}
 	if d.HasChange("parameters") {
-		log.Printf("[DEBUG] ID used for update operation %s", vvID)
+		logDebugf(ctx, "ID used for update operation %s", vvID)
 		request1 := expandRequestTrustsecVnVLANMappingUpdateVnVLANMappingByID(ctx, "parameters.0", d)
 		if request1 != nil {
-			log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+			logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 		}
 		response1, restyResp1, err := client.VnVLANMapping.UpdateVnVLANMapping(vvID, request1)
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 				diags = append(diags, diagErrorWithAltAndResponse(
 					"Failure when executing UpdateVnVLANMappingByID", err, restyResp1.String(),
 					"Failure at UpdateVnVLANMappingByID, unexpected response", ""))
@@ -396,7 +360,7 @@ func resourceTrustsecVnVLANMappingUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceTrustsecVnVLANMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMapping delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMapping delete for id=[%s]", d.Id())
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -411,9 +375,9 @@ func resourceTrustsecVnVLANMappingDelete(ctx context.Context, d *schema.Resource
 	vvVnName, _ := resourceMap["vn_name"]
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -449,7 +413,7 @@ func resourceTrustsecVnVLANMappingDelete(ctx context.Context, d *schema.Resource
 	response1, restyResp1, err := client.VnVLANMapping.DeleteVnVLANMapping(vvID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteVnVLANMappingByID", err, restyResp1.String(),
 				"Failure at DeleteVnVLANMappingByID, unexpected response", ""))
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -119,8 +117,8 @@ func resourceTrustsecVnVLANMappingBulkCreate() *schema.Resource {
 }
 
 func resourceTrustsecVnVLANMappingBulkCreateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkCreateVnVLANMappings create")
-	log.Printf("[DEBUG] Missing BulkCreateVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkCreateVnVLANMappings create")
+	logDebugf(ctx, "Missing BulkCreateVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -129,7 +127,7 @@ func resourceTrustsecVnVLANMappingBulkCreateCreate(ctx context.Context, d *schem
 	response1, restyResp1, err := client.VnVLANMapping.BulkCreateVnVLANMappings(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkCreateVnVLANMappings", err,
@@ -137,7 +135,7 @@ func resourceTrustsecVnVLANMappingBulkCreateCreate(ctx context.Context, d *schem
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVnVLANMappingBulkCreateVnVLANMappingsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -146,7 +144,7 @@ func resourceTrustsecVnVLANMappingBulkCreateCreate(ctx context.Context, d *schem
 		return diags
 	}
 	_ = d.Set("last_updated", getUnixTimeString())
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	d.SetId(getUnixTimeString())
 	return resourceTrustsecVnVLANMappingBulkCreateRead(ctx, d, m)
 }
@@ -157,9 +155,9 @@ func resourceTrustsecVnVLANMappingBulkCreateRead(ctx context.Context, d *schema.
 }
 
 func resourceTrustsecVnVLANMappingBulkCreateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMappingBulkCreate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMappingBulkCreate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnVLANMappingBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnVLANMappingBulkCreate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 func expandRequestTrustsecVnVLANMappingBulkCreateBulkCreateVnVLANMappings(ctx context.Context, key string, d *schema.ResourceData) *isegosdk.RequestVnVLANMappingBulkCreateVnVLANMappings {
@@ -4,8 +4,6 @@ import (
 	"context"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -66,8 +64,8 @@ func resourceTrustsecVnVLANMappingBulkDelete() *schema.Resource {
 }
 
 func resourceTrustsecVnVLANMappingBulkDeleteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkDeleteVnVLANMappings create")
-	log.Printf("[DEBUG] Missing BulkDeleteVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkDeleteVnVLANMappings create")
+	logDebugf(ctx, "Missing BulkDeleteVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -76,14 +74,14 @@ func resourceTrustsecVnVLANMappingBulkDeleteCreate(ctx context.Context, d *schem
 	response1, restyResp1, err := client.VnVLANMapping.BulkDeleteVnVLANMappings(request1)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkDeleteVnVLANMappings", err,
 			"Failure at BulkDeleteVnVLANMappings, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVnVLANMappingBulkDeleteVnVLANMappingsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -103,9 +101,9 @@ func resourceTrustsecVnVLANMappingBulkDeleteRead(ctx context.Context, d *schema.
 }
 
 func resourceTrustsecVnVLANMappingBulkDeleteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMappingBulkDelete delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMappingBulkDelete delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnVLANMappingBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnVLANMappingBulkDelete delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -118,8 +116,8 @@ func resourceTrustsecVnVLANMappingBulkUpdate() *schema.Resource {
 }
 
 func resourceTrustsecVnVLANMappingBulkUpdateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning BulkUpdateVnVLANMappings create")
-	log.Printf("[DEBUG] Missing BulkUpdateVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
+	logDebugf(ctx, "Beginning BulkUpdateVnVLANMappings create")
+	logDebugf(ctx, "Missing BulkUpdateVnVLANMappings create on Cisco ISE. It will only be create it on Terraform")
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
 
@@ -129,14 +127,14 @@ func resourceTrustsecVnVLANMappingBulkUpdateCreate(ctx context.Context, d *schem
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		diags = append(diags, diagErrorWithAlt(
 			"Failure when executing BulkUpdateVnVLANMappings", err,
 			"Failure at BulkUpdateVnVLANMappings, unexpected response", ""))
 		return diags
 	}
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 	vItem1 := flattenVnVLANMappingBulkUpdateVnVLANMappingsItem(response1)
 	if err := d.Set("item", vItem1); err != nil {
 		diags = append(diags, diagError(
@@ -156,9 +154,9 @@ func resourceTrustsecVnVLANMappingBulkUpdateRead(ctx context.Context, d *schema.
 }
 
 func resourceTrustsecVnVLANMappingBulkUpdateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Beginning TrustsecVnVLANMappingBulkUpdate delete for id=[%s]", d.Id())
+	logDebugf(ctx, "Beginning TrustsecVnVLANMappingBulkUpdate delete for id=[%s]", d.Id())
 	var diags diag.Diagnostics
-	log.Printf("[DEBUG] Missing TrustsecVnVLANMappingBulkUpdate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
+	logDebugf(ctx, "Missing TrustsecVnVLANMappingBulkUpdate delete on Cisco ISE. It will only be delete it on Terraform id=[%s]", d.Id())
 	return diags
 }
 
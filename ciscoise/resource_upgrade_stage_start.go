@@ -5,9 +5,8 @@ import (
 
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+	"github.com/go-resty/resty/v2"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +23,9 @@ func resourceUpgradestageStart() *schema.Resource {
 		CreateContext: resourceUpgradestageStartCreate,
 		ReadContext:   resourceUpgradestageStartRead,
 		DeleteContext: resourceUpgradestageStartDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(UPGRADE_TIMEOUT),
+		},
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
@@ -96,28 +98,37 @@ func resourceUpgradestageStart() *schema.Resource {
 }
 
 func resourceUpgradestageStartCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	clientConfig := m.(ClientConfig)
-	client := clientConfig.Client
 
 	var diags diag.Diagnostics
 
 	request1 := expandRequestUpgradestageStartInitiateStagingOnPPAN(ctx, "parameters.0", d)
 
-	response1, restyResp1, err := client.FullUpgrade.InitiateStagingOnPPAN(request1)
+	waitCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	var response1 *isegosdk.ResponseFullUpgradeInitiateStagingOnPPAN
+	var restyResp1 *resty.Response
+	var err error
+	if errWait := callContext(waitCtx, m, func(client *isegosdk.Client) {
+		response1, restyResp1, err = client.FullUpgrade.InitiateStagingOnPPAN(request1)
+	}); errWait != nil {
+		diags = append(diags, diagError(
+			"Failure when waiting for InitiateStagingOnPPAN", errWait))
+		return diags
+	}
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+			logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 		}
 		d.SetId("")
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 	vItem1 := flattenFullUpgradeInitiateStagingOnPPANItemResponse(response1.Response)
 	if err := d.Set("item", vItem1); err != nil {
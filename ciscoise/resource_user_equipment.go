@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"log"
-
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,9 +26,7 @@ func resourceUserEquipment() *schema.Resource {
 		ReadContext:   resourceUserEquipmentRead,
 		UpdateContext: resourceUserEquipmentUpdate,
 		DeleteContext: resourceUserEquipmentDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      importerByNameOrID(resourceUserEquipmentRead, "user_equipment_id", ""),
 
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
@@ -145,43 +141,17 @@ func resourceUserEquipment() *schema.Resource {
 func resourceUserEquipmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clientConfig := m.(ClientConfig)
 	client := clientConfig.Client
-	isEnableAutoImport := m.(ClientConfig).EnableAutoImport
 	var diags diag.Diagnostics
 
 	resourceItem := *getResourceItem(d.Get("parameters"))
 	request1 := expandRequestUserEquipmentCreateUserEquipment(ctx, "parameters.0", d)
 
 	if request1 != nil {
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 	}
 
-	vUserEquipmentID, okUserEquipmentID := resourceItem["user_equipment_id"]
+	vUserEquipmentID := resourceItem["user_equipment_id"]
 	vvUserEquipmentID := interfaceToString(vUserEquipmentID)
-	if isEnableAutoImport {
-		if okUserEquipmentID && vvUserEquipmentID != "" {
-			getResponse2, _, err := client.UserEquipment.GetUserEquipmentByID(vvUserEquipmentID)
-			if err == nil && getResponse2 != nil {
-				resourceMap := make(map[string]string)
-				resourceMap["user_equipment_id"] = vvUserEquipmentID
-				d.SetId(joinResourceID(resourceMap))
-				return resourceUserEquipmentRead(ctx, d, m)
-			}
-		} else {
-			queryParams2 := isegosdk.GetUserEquipmentsQueryParams{}
-
-			response2, _, err := client.UserEquipment.GetUserEquipments(&queryParams2)
-			if response2 != nil && err == nil {
-				items2 := getAllItemsUserEquipmentGetUserEquipments(m, response2, &queryParams2)
-				item2, err := searchUserEquipmentGetUserEquipments(m, items2, vvUserEquipmentID)
-				if err == nil && item2 != nil {
-					resourceMap := make(map[string]string)
-					resourceMap["user_equipment_id"] = vvUserEquipmentID
-					d.SetId(joinResourceID(resourceMap))
-					return resourceUserEquipmentRead(ctx, d, m)
-				}
-			}
-		}
-	}
 	resp1, restyResp1, err := client.UserEquipment.CreateUserEquipment(request1)
 	if err != nil || resp1 == nil {
 		if restyResp1 != nil {
@@ -214,26 +184,26 @@ func resourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m in
 	vvUserEquipmentID := vUserEquipmentID
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okUserEquipmentID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	if selectedMethod == 1 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipments")
+		logDebugf(ctx, "Selected method: GetUserEquipments")
 		queryParams1 := isegosdk.GetUserEquipmentsQueryParams{}
 
 		response1, restyResp1, err := client.UserEquipment.GetUserEquipments(&queryParams1)
 
 		if err != nil || response1 == nil {
 			if restyResp1 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
 
 		items1 := getAllItemsUserEquipmentGetUserEquipments(m, response1, &queryParams1)
 		item1, err := searchUserEquipmentGetUserEquipments(m, items1, vvUserEquipmentID)
@@ -249,7 +219,7 @@ func resourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem1); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem1)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetUserEquipments search response",
 				err))
@@ -258,19 +228,19 @@ func resourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m in
 
 	}
 	if selectedMethod == 2 {
-		log.Printf("[DEBUG] Selected method: GetUserEquipmentByID")
+		logDebugf(ctx, "Selected method: GetUserEquipmentByID")
 
 		response2, restyResp2, err := client.UserEquipment.GetUserEquipmentByID(vvUserEquipmentID)
 
 		if err != nil || response2 == nil {
 			if restyResp2 != nil {
-				log.Printf("[DEBUG] Retrieved error response %s", restyResp2.String())
+				logDebugf(ctx, "Retrieved error response %s", restyResp2.String())
 			}
 			d.SetId("")
 			return diags
 		}
 
-		log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response2))
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response2))
 
 		vItem2 := flattenUserEquipmentGetUserEquipmentByIDItemResponse(response2.Response)
 		if err := d.Set("item", vItem2); err != nil {
@@ -279,7 +249,7 @@ func resourceUserEquipmentRead(ctx context.Context, d *schema.ResourceData, m in
 				err))
 			return diags
 		}
-		if err := d.Set("parameters", vItem2); err != nil {
+		if err := d.Set("parameters", configuredParameters(d, vItem2)); err != nil {
 			diags = append(diags, diagError(
 				"Failure when setting GetUserEquipmentByID response",
 				err))
@@ -304,11 +274,11 @@ func resourceUserEquipmentUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if d.HasChange("parameters") {
 
-		log.Printf("[DEBUG] ID used for update operation %s", vvUserEquipmentID)
+		logDebugf(ctx, "ID used for update operation %s", vvUserEquipmentID)
 
 		request1 := expandRequestUserEquipmentUpdateUserEquipment(ctx, "parameters.0", d)
 
-		log.Printf("[DEBUG] request sent => %v", responseInterfaceToString(*request1))
+		logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
 
 		response1, restyResp1, err := client.UserEquipment.UpdateUserEquipment(vvUserEquipmentID, request1)
 
@@ -316,7 +286,7 @@ func resourceUserEquipmentUpdate(ctx context.Context, d *schema.ResourceData, m
 
 			if restyResp1 != nil {
 
-				log.Printf("[DEBUG] resty response for update operation => %v", restyResp1.String())
+				logDebugf(ctx, "resty response for update operation => %v", restyResp1.String())
 
 				diags = append(diags, diagErrorWithAltAndResponse(
 
@@ -355,9 +325,9 @@ func resourceUserEquipmentDelete(ctx context.Context, d *schema.ResourceData, m
 	vvUserEquipmentID := vUserEquipmentID
 
 	method1 := []bool{}
-	log.Printf("[DEBUG] Selecting method. Method 1 %v", method1)
+	logDebugf(ctx, "Selecting method. Method 1 %v", method1)
 	method2 := []bool{okUserEquipmentID}
-	log.Printf("[DEBUG] Selecting method. Method 2 %v", method2)
+	logDebugf(ctx, "Selecting method. Method 2 %v", method2)
 
 	selectedMethod := pickMethod([][]bool{method1, method2})
 	// REVIEW: Add getAllItems and search function to get missing params
@@ -391,7 +361,7 @@ func resourceUserEquipmentDelete(ctx context.Context, d *schema.ResourceData, m
 	response1, restyResp1, err := client.UserEquipment.DeleteUserEquipment(vvUserEquipmentID)
 	if err != nil || response1 == nil {
 		if restyResp1 != nil {
-			log.Printf("[DEBUG] resty response for delete operation => %v", restyResp1.String())
+			logDebugf(ctx, "resty response for delete operation => %v", restyResp1.String())
 			diags = append(diags, diagErrorWithAltAndResponse(
 				"Failure when executing DeleteUserEquipment", err, restyResp1.String(),
 				"Failure at DeleteUserEquipment, unexpected response", ""))
@@ -2,8 +2,12 @@ package ciscoise
 
 import (
 	"context"
-
-	"log"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
 
 	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
 
@@ -11,17 +15,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// resourceAction
+// Number of user equipments listed per page on read.
+const USER_EQUIPMENTS_PAGE_SIZE = 100
+
 func resourceUserEquipmentCsv() *schema.Resource {
 	return &schema.Resource{
-		Description: `It performs create operation on User Equipment.
+		Description: `It manages create, read, update and delete operations on User Equipment.
 
 - Create user equipments from a CSV file
+
+- With csv_content, the user equipments of the CSV are tracked by IMEI. Rows added, changed or removed are
+created, updated or deleted, and destroy deletes the user equipments created by the resource. User equipments
+which already exist are not created, and are neither updated nor deleted by the resource.
 `,
 
 		CreateContext: resourceUserEquipmentCsvCreate,
 		ReadContext:   resourceUserEquipmentCsvRead,
+		UpdateContext: resourceUserEquipmentCsvUpdate,
 		DeleteContext: resourceUserEquipmentCsvDelete,
+		CustomizeDiff: resourceUserEquipmentCsvCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(TASK_TIMEOUT),
+			Update: schema.DefaultTimeout(TASK_TIMEOUT),
+			Delete: schema.DefaultTimeout(TASK_TIMEOUT),
+		},
 		Schema: map[string]*schema.Schema{
 			"last_updated": &schema.Schema{
 				Type:     schema.TypeString,
@@ -40,12 +57,37 @@ func resourceUserEquipmentCsv() *schema.Resource {
 					},
 				},
 			},
+			"user_equipments": &schema.Schema{
+				Description: `User equipments of csv_content managed by the resource, as read from Cisco ISE.`,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imei": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_group": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"parameters": &schema.Schema{
 				Type:     schema.TypeList,
 				Required: true,
 				MaxItems: 1,
 				MinItems: 1,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"x_request_id": &schema.Schema{
@@ -54,6 +96,12 @@ func resourceUserEquipmentCsv() *schema.Resource {
 							Required:    true,
 							ForceNew:    true,
 						},
+						"csv_content": &schema.Schema{
+							Description: `Content of the CSV file. The first row names the columns, IMEI is required, Description and
+Device Group are optional.`,
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -67,28 +115,55 @@ func resourceUserEquipmentCsvCreate(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	response1, restyResp1, err := client.UserEquipment.CreateUserEquipmentsFromCSV()
+	vCsvContent, okCsvContent := d.GetOk("parameters.0.csv_content")
+	if !okCsvContent {
+		response1, restyResp1, err := client.UserEquipment.CreateUserEquipmentsFromCSV()
 
-	if err != nil || response1 == nil {
-		if restyResp1 != nil {
-			log.Printf("[DEBUG] Retrieved error response %s", restyResp1.String())
+		if err != nil || response1 == nil {
+			if restyResp1 != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp1.String())
+			}
+			d.SetId("")
+			return diags
 		}
-		d.SetId("")
+
+		logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
+
+		vItem1 := flattenUserEquipmentCreateUserEquipmentsFromCSVItem(response1)
+		if err := d.Set("item", vItem1); err != nil {
+			diags = append(diags, diagError(
+				"Failure when setting CreateUserEquipmentsFromCSV response",
+				err))
+			return diags
+		}
+
+		d.SetId(getUnixTimeString())
 		return diags
 	}
 
-	log.Printf("[DEBUG] Retrieved response %+v", responseInterfaceToString(*response1))
-
-	vItem1 := flattenUserEquipmentCreateUserEquipmentsFromCSVItem(response1)
-	if err := d.Set("item", vItem1); err != nil {
+	rows, err := parseUserEquipmentCsv(vCsvContent.(string))
+	if err != nil {
 		diags = append(diags, diagError(
-			"Failure when setting CreateUserEquipmentsFromCSV response",
-			err))
+			"Failure when parsing csv_content", err))
+		return diags
+	}
+	create, _, _ := diffUserEquipments(nil, rows)
+	taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+	created, createDiags := createUserEquipments(ctx, taskCtx, client, create)
+	diags = append(diags, createDiags...)
+	if diags.HasError() && len(created) == 0 {
 		return diags
 	}
 
 	d.SetId(getUnixTimeString())
-	return diags
+	_ = d.Set("last_updated", getUnixTimeString())
+	owned := make([]interface{}, 0, len(created))
+	for _, imei := range created {
+		owned = append(owned, map[string]interface{}{"imei": imei})
+	}
+	_ = d.Set("user_equipments", owned)
+	return append(diags, resourceUserEquipmentCsvRead(ctx, d, m)...)
 }
 
 func flattenUserEquipmentCreateUserEquipmentsFromCSVItem(item *isegosdk.ResponseUserEquipmentCreateUserEquipmentsFromCSV) []map[string]interface{} {
@@ -103,18 +178,375 @@ func flattenUserEquipmentCreateUserEquipmentsFromCSVItem(item *isegosdk.Response
 }
 
 func resourceUserEquipmentCsvRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	//client := m.(*isegosdk.Client)
+	logDebugf(ctx, "Beginning UserEquipmentCsv read for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
 	var diags diag.Diagnostics
+
+	owned := d.Get("user_equipments").([]interface{})
+	if len(owned) == 0 {
+		return diags
+	}
+	existing, err := listUserEquipments(ctx, client)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing GetUserEquipments", err))
+		return diags
+	}
+	byIMEI := make(map[string]isegosdk.ResponseUserEquipmentGetUserEquipmentsResponse)
+	for _, item := range existing {
+		byIMEI[item.Imei] = item
+	}
+
+	var items []map[string]interface{}
+	for _, item := range owned {
+		imei := interfaceToString(item.(map[string]interface{})["imei"])
+		found, ok := byIMEI[imei]
+		if !ok {
+			logDebugf(ctx, "User equipment %s not found, removing it from the state", imei)
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"id":           found.ID,
+			"imei":         found.Imei,
+			"description":  found.Description,
+			"device_group": found.DeviceGroup,
+		})
+	}
+	if err := d.Set("user_equipments", items); err != nil {
+		diags = append(diags, diagError(
+			"Failure when setting GetUserEquipments response",
+			err))
+		return diags
+	}
 	return diags
 }
 
 func resourceUserEquipmentCsvUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return resourceUserEquipmentCsvRead(ctx, d, m)
+	logDebugf(ctx, "Beginning UserEquipmentCsv update for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	var diags diag.Diagnostics
+
+	rows := make(map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList)
+	if content := d.Get("parameters.0.csv_content").(string); content != "" {
+		var err error
+		rows, err = parseUserEquipmentCsv(content)
+		if err != nil {
+			diags = append(diags, diagError(
+				"Failure when parsing csv_content", err))
+			return diags
+		}
+	}
+	// user_equipments is planned as unknown when it differs from the CSV.
+	oldUserEquipments, _ := d.GetChange("user_equipments")
+	current := userEquipmentsByIMEI(oldUserEquipments.([]interface{}))
+	create, update, remove := diffUserEquipments(current, rows)
+	logDebugf(ctx, "User equipments to create %d, update %d, delete %d", len(create), len(update), len(remove))
+
+	taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+	removeDiags := bulkUserEquipmentOperation(ctx, taskCtx, client, "delete", remove)
+	diags = append(diags, removeDiags...)
+	diags = append(diags, bulkUserEquipmentOperation(ctx, taskCtx, client, "update", update)...)
+	created, createDiags := createUserEquipments(ctx, taskCtx, client, create)
+	diags = append(diags, createDiags...)
+
+	// The user equipments created by the resource stay owned, the others of
+	// the CSV are only owned once confirmed created
+	owned := make([]interface{}, 0, len(rows))
+	for imei := range rows {
+		if existing, ok := current[imei]; ok && existing.ID != "" {
+			owned = append(owned, map[string]interface{}{"imei": imei})
+		}
+	}
+	for _, imei := range created {
+		owned = append(owned, map[string]interface{}{"imei": imei})
+	}
+	if removeDiags.HasError() {
+		for _, item := range remove {
+			owned = append(owned, map[string]interface{}{"imei": item.Imei})
+		}
+	}
+	_ = d.Set("user_equipments", owned)
+	_ = d.Set("last_updated", getUnixTimeString())
+	return append(diags, resourceUserEquipmentCsvRead(ctx, d, m)...)
 }
 
 func resourceUserEquipmentCsvDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	//client := m.(*isegosdk.Client)
+	logDebugf(ctx, "Beginning UserEquipmentCsv delete for id=[%s]", d.Id())
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+
+	current := userEquipmentsByIMEI(d.Get("user_equipments").([]interface{}))
+	_, _, remove := diffUserEquipments(current, nil)
+	taskCtx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+	diags := bulkUserEquipmentOperation(ctx, taskCtx, client, "delete", remove)
+	if diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
 
-	var diags diag.Diagnostics
 	return diags
 }
+
+// resourceUserEquipmentCsvCustomizeDiff plans an update when the rows of
+// csv_content differ from the user equipments read from Cisco ISE.
+func resourceUserEquipmentCsvCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown("parameters.0.csv_content") {
+		return nil
+	}
+	content := diff.Get("parameters.0.csv_content").(string)
+	if content == "" {
+		return nil
+	}
+	rows, err := parseUserEquipmentCsv(content)
+	if err != nil {
+		return err
+	}
+	current := userEquipmentsByIMEI(diff.Get("user_equipments").([]interface{}))
+	create, update, remove := diffUserEquipments(current, rows)
+	if len(create) > 0 || len(update) > 0 || len(remove) > 0 {
+		return diff.SetNewComputed("user_equipments")
+	}
+	return nil
+}
+
+// parseUserEquipmentCsv returns the rows of content keyed by IMEI. Column
+// names are matched ignoring case, spaces and underscores.
+func parseUserEquipmentCsv(content string) (map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("csv_content has no header row")
+		}
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(strings.TrimSpace(name)))
+		columns[name] = i
+	}
+	if _, ok := columns["imei"]; !ok {
+		return nil, fmt.Errorf("csv_content has no IMEI column")
+	}
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make(map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		imei := value(record, "imei")
+		if imei == "" {
+			continue
+		}
+		if _, ok := rows[imei]; ok {
+			return nil, fmt.Errorf("IMEI %s is declared more than once in csv_content", imei)
+		}
+		rows[imei] = isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{
+			Imei:        imei,
+			Description: value(record, "description"),
+			DeviceGroup: value(record, "devicegroup"),
+		}
+	}
+}
+
+func userEquipmentsByIMEI(items []interface{}) map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList {
+	byIMEI := make(map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList)
+	for _, item := range items {
+		userEquipment, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		imei := interfaceToString(userEquipment["imei"])
+		byIMEI[imei] = isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{
+			ID:          interfaceToString(userEquipment["id"]),
+			Imei:        imei,
+			Description: interfaceToString(userEquipment["description"]),
+			DeviceGroup: interfaceToString(userEquipment["device_group"]),
+		}
+	}
+	return byIMEI
+}
+
+// diffUserEquipments returns the user equipments to create, update and
+// delete, sorted by IMEI. Only the current ones, read from Cisco ISE, have an
+// ID.
+func diffUserEquipments(current map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList, rows map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList) ([]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList, []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList, []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList) {
+	var create, update, remove []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList
+	for imei, row := range rows {
+		existing, ok := current[imei]
+		if !ok || existing.ID == "" {
+			create = append(create, row)
+			continue
+		}
+		row.ID = existing.ID
+		if !reflect.DeepEqual(existing, row) {
+			update = append(update, row)
+		}
+	}
+	for imei, existing := range current {
+		if _, ok := rows[imei]; !ok && existing.ID != "" {
+			remove = append(remove, isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{ID: existing.ID, Imei: imei})
+		}
+	}
+	for _, items := range [][]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{create, update, remove} {
+		sort.Slice(items, func(i, j int) bool { return items[i].Imei < items[j].Imei })
+	}
+	return create, update, remove
+}
+
+// bulkUserEquipmentOperation sends operation for items and waits, with
+// taskCtx, for the task of the bulk.
+func bulkUserEquipmentOperation(ctx context.Context, taskCtx context.Context, client *isegosdk.Client, operation string, items []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(items) == 0 {
+		return diags
+	}
+	request1 := &isegosdk.RequestUserEquipmentBulkUserEquipmentOperation{
+		Operation: operation,
+		ItemList:  &items,
+	}
+	logDebugf(ctx, "request sent => %v", responseInterfaceToString(*request1))
+	response1, restyResp1, err := client.UserEquipment.BulkUserEquipmentOperation(request1)
+	if err != nil || response1 == nil {
+		if restyResp1 != nil {
+			diags = append(diags, diagErrorWithResponse(
+				fmt.Sprintf("Failure when executing BulkUserEquipmentOperation %s", operation), err, restyResp1.String()))
+			return diags
+		}
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when executing BulkUserEquipmentOperation %s", operation), err))
+		return diags
+	}
+	logDebugf(ctx, "Retrieved response %+v", responseInterfaceToString(*response1))
+	return append(diags, waitForTask(taskCtx, client, response1.ID, fmt.Sprintf("BulkUserEquipmentOperation %s", operation))...)
+}
+
+// createUserEquipments creates the user equipments of items which do not
+// exist yet, and returns the IMEIs of the ones found once the bulk task
+// completed. The task only reports counts, so these are the user equipments
+// created by the resource.
+func createUserEquipments(ctx context.Context, taskCtx context.Context, client *isegosdk.Client, items []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(items) == 0 {
+		return nil, diags
+	}
+	var imeis []string
+	for _, item := range items {
+		imeis = append(imeis, item.Imei)
+	}
+	existing, err := getUserEquipments(ctx, client, imeis)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing GetUserEquipmentByIMEI", err))
+		return nil, diags
+	}
+	var create []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList
+	imeis = nil
+	for _, item := range items {
+		if existing[item.Imei] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("User equipment %s already exists", item.Imei),
+				Detail:   "It is not created nor deleted by the resource.",
+			})
+			continue
+		}
+		create = append(create, item)
+		imeis = append(imeis, item.Imei)
+	}
+
+	if len(create) == 0 {
+		return nil, diags
+	}
+
+	// A failed bulk may still have created some of them
+	diags = append(diags, bulkUserEquipmentOperation(ctx, taskCtx, client, "create", create)...)
+	found, err := getUserEquipments(ctx, client, imeis)
+	if err != nil {
+		diags = append(diags, diagError(
+			"Failure when executing GetUserEquipmentByIMEI", err))
+		return nil, diags
+	}
+	var created []string
+	for _, imei := range imeis {
+		if found[imei] {
+			created = append(created, imei)
+		}
+	}
+	return created, diags
+}
+
+// getUserEquipments reports which user equipments of imeis exist, each one
+// read by its IMEI.
+func getUserEquipments(ctx context.Context, client *isegosdk.Client, imeis []string) (map[string]bool, error) {
+	found := make(map[string]bool)
+	for _, imei := range imeis {
+		response, restyResp, err := client.UserEquipment.GetUserEquipmentByIMEI(imei)
+		if err != nil || response == nil {
+			if restyResp != nil && restyResp.StatusCode() == 404 {
+				continue
+			}
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			return nil, fmt.Errorf("%s: %v", imei, err)
+		}
+		found[imei] = response.Response != nil
+	}
+	return found, nil
+}
+
+// listUserEquipments returns every user equipment, following the pages of
+// GetUserEquipments.
+func listUserEquipments(ctx context.Context, client *isegosdk.Client) ([]isegosdk.ResponseUserEquipmentGetUserEquipmentsResponse, error) {
+	queryParams := isegosdk.GetUserEquipmentsQueryParams{
+		Page: 1,
+		Size: USER_EQUIPMENTS_PAGE_SIZE,
+	}
+	var items []isegosdk.ResponseUserEquipmentGetUserEquipmentsResponse
+	for {
+		response, restyResp, err := client.UserEquipment.GetUserEquipments(&queryParams)
+		if err != nil || response == nil {
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			if err == nil {
+				err = fmt.Errorf("unexpected response")
+			}
+			return nil, err
+		}
+		if response.Response == nil || len(*response.Response) == 0 {
+			return items, nil
+		}
+		items = append(items, *response.Response...)
+		if response.NextPage == nil || response.NextPage.Rel != "next" {
+			return items, nil
+		}
+		queryParams.Page++
+	}
+}
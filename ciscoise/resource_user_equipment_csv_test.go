@@ -0,0 +1,111 @@
+package ciscoise
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+)
+
+func TestParseUserEquipmentCsv(t *testing.T) {
+	rows, err := parseUserEquipmentCsv("IMEI, Description, Device Group\n111, phone, Group1\n222,,\n,ignored,\n")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("bad: expect 2 rows, got %v", rows)
+	}
+	if row := rows["111"]; row.Description != "phone" || row.DeviceGroup != "Group1" {
+		t.Errorf("bad: unexpected row %+v", row)
+	}
+	if _, err := parseUserEquipmentCsv("description\nphone\n"); err == nil {
+		t.Errorf("bad: expect an error without IMEI column")
+	}
+	if _, err := parseUserEquipmentCsv("imei\n111\n111\n"); err == nil {
+		t.Errorf("bad: expect an error for a duplicated IMEI")
+	}
+}
+
+func TestDiffUserEquipments(t *testing.T) {
+	current := map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{
+		"111": {ID: "1", Imei: "111", Description: "phone"},
+		"222": {ID: "2", Imei: "222", Description: "phone"},
+		"333": {ID: "3", Imei: "333"},
+	}
+	rows := map[string]isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{
+		"111": {Imei: "111", Description: "phone"},
+		"222": {Imei: "222", Description: "modem"},
+		"444": {Imei: "444"},
+	}
+	create, update, remove := diffUserEquipments(current, rows)
+	if len(create) != 1 || create[0].Imei != "444" {
+		t.Errorf("bad: unexpected creates %v", create)
+	}
+	if len(update) != 1 || update[0].ID != "2" || update[0].Description != "modem" {
+		t.Errorf("bad: unexpected updates %v", update)
+	}
+	if len(remove) != 1 || remove[0].ID != "3" {
+		t.Errorf("bad: unexpected deletes %v", remove)
+	}
+}
+
+func TestCreateUserEquipments(t *testing.T) {
+	var mu sync.Mutex
+	userEquipments := map[string]bool{"111": true}
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/fiveg/user-equipment/bulk":
+			var request isegosdk.RequestUserEquipmentBulkUserEquipmentOperation
+			_ = json.NewDecoder(r.Body).Decode(&request)
+			for _, item := range *request.ItemList {
+				requested = append(requested, item.Imei)
+				// The bulk task fails for this user equipment
+				if item.Imei != "333" {
+					userEquipments[item.Imei] = true
+				}
+			}
+			w.Write([]byte(`{"id": "task1"}`))
+		case r.URL.Path == "/api/v1/task/task1":
+			w.Write([]byte(`{"id": "task1", "executionStatus": "COMPLETED", "failCount": 1}`))
+		case strings.HasPrefix(r.URL.Path, "/api/v1/fiveg/user-equipment/imei/"):
+			imei := strings.TrimPrefix(r.URL.Path, "/api/v1/fiveg/user-equipment/imei/")
+			if !userEquipments[imei] {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{}`))
+				return
+			}
+			w.Write([]byte(`{"response": {"id": "id-` + imei + `", "imei": "` + imei + `"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	clientConfig := testClientConfig(t, ctx, server.URL)
+	isegosdk.UseAPIGateway = true
+	defer func() { isegosdk.UseAPIGateway = false }()
+
+	created, diags := createUserEquipments(ctx, ctx, clientConfig.Client, []isegosdk.RequestUserEquipmentBulkUserEquipmentOperationItemList{
+		{Imei: "111"}, {Imei: "222"}, {Imei: "333"},
+	})
+	if !reflect.DeepEqual(requested, []string{"222", "333"}) {
+		t.Errorf("bad: expect the existing user equipment not to be sent, got %v", requested)
+	}
+	if !reflect.DeepEqual(created, []string{"222"}) {
+		t.Errorf("bad: expect only the user equipment confirmed created, got %v", created)
+	}
+	if !diags.HasError() || len(diags) != 2 {
+		t.Errorf("bad: expect a warning for the existing user equipment and an error for the task, got %v", diags)
+	}
+}
@@ -0,0 +1,88 @@
+package ciscoise
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// retryableStatusCodes are the status codes ISE returns while ERS is
+// overloaded or the application server is restarting.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryCondition retries idempotent requests that failed at the transport
+// level (connection reset, timeout) or returned a transient status code.
+func retryCondition(response *resty.Response, err error) bool {
+	if response == nil || response.Request == nil {
+		return false
+	}
+	if !isIdempotentMethod(response.Request.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return retryableStatusCodes[response.StatusCode()]
+}
+
+// retryAfter honors the Retry-After header, resty bounds the result
+// between the configured minimum and maximum wait.
+func retryAfter(client *resty.Client, response *resty.Response) (time.Duration, error) {
+	if response == nil {
+		return 0, nil
+	}
+	return parseRetryAfter(response.Header().Get("Retry-After"), time.Now()), nil
+}
+
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+func retryHook(l *logger, maxRetries int) resty.OnRetryFunc {
+	return func(response *resty.Response, err error) {
+		if response == nil || response.Request == nil {
+			return
+		}
+		request := response.Request
+		if request.Attempt > maxRetries {
+			l.Errorf("%s %s failed after %d attempts", request.Method, request.URL, request.Attempt)
+			return
+		}
+		if err != nil {
+			l.Warnf("%s %s failed on attempt %d of %d, retrying: %v", request.Method, request.URL, request.Attempt, maxRetries+1, err)
+			return
+		}
+		l.Warnf("%s %s returned %s on attempt %d of %d, retrying", request.Method, request.URL, response.Status(), request.Attempt, maxRetries+1)
+	}
+}
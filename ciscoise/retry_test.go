@@ -0,0 +1,87 @@
+package ciscoise
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestRetryCondition(t *testing.T) {
+	cases := map[string]struct {
+		Method       string
+		StatusCode   int
+		Err          error
+		ExpectResult bool
+	}{
+		"GET service unavailable": {
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusServiceUnavailable,
+			ExpectResult: true,
+		},
+		"PUT too many requests": {
+			Method:       http.MethodPut,
+			StatusCode:   http.StatusTooManyRequests,
+			ExpectResult: true,
+		},
+		"DELETE connection reset": {
+			Method:       http.MethodDelete,
+			Err:          errors.New("read: connection reset by peer"),
+			ExpectResult: true,
+		},
+		"POST bad gateway": {
+			Method:       http.MethodPost,
+			StatusCode:   http.StatusBadGateway,
+			ExpectResult: false,
+		},
+		"POST connection reset": {
+			Method:       http.MethodPost,
+			Err:          errors.New("read: connection reset by peer"),
+			ExpectResult: false,
+		},
+		"GET not found": {
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusNotFound,
+			ExpectResult: false,
+		},
+		"GET ok": {
+			Method:       http.MethodGet,
+			StatusCode:   http.StatusOK,
+			ExpectResult: false,
+		},
+	}
+	for tn, tc := range cases {
+		response := &resty.Response{Request: &resty.Request{Method: tc.Method}}
+		if tc.StatusCode != 0 {
+			response.RawResponse = &http.Response{StatusCode: tc.StatusCode}
+		}
+		if retryCondition(response, tc.Err) != tc.ExpectResult {
+			t.Errorf("bad: %s, expect retryCondition to return %t", tn, tc.ExpectResult)
+		}
+	}
+	if retryCondition(nil, errors.New("middleware error")) {
+		t.Errorf("bad: expect retryCondition to return false without a response")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		Value        string
+		ExpectResult time.Duration
+	}{
+		"empty":         {Value: "", ExpectResult: 0},
+		"seconds":       {Value: "120", ExpectResult: 2 * time.Minute},
+		"negative":      {Value: "-5", ExpectResult: 0},
+		"http date":     {Value: "Wed, 01 Mar 2023 10:00:30 GMT", ExpectResult: 30 * time.Second},
+		"past date":     {Value: "Wed, 01 Mar 2023 09:00:00 GMT", ExpectResult: 0},
+		"invalid value": {Value: "soon", ExpectResult: 0},
+	}
+	for tn, tc := range cases {
+		if result := parseRetryAfter(tc.Value, now); result != tc.ExpectResult {
+			t.Errorf("bad: %s, '%s' expect parseRetryAfter to return %s, got %s", tn, tc.Value, tc.ExpectResult, result)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package ciscoise
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Separator of the salt and the hash of a secret in secret_hashes.
+const SECRET_HASH_SEPARATOR = "$"
+
+// writeOnlySecrets lists, by resource, the paths of the secrets ISE never
+// returns, or returns masked. Their state only keeps a salted hash, in
+// secret_hashes, and they are sent to ISE only when they change.
+var writeOnlySecrets = map[string][]string{
+	"ciscoise_internal_user": {
+		"parameters.0.password",
+		"parameters.0.enable_password",
+	},
+	"ciscoise_guest_user": {
+		"parameters.0.guest_info.0.password",
+	},
+	"ciscoise_network_device": {
+		"parameters.0.authentication_settings.0.radius_shared_secret",
+		"parameters.0.authentication_settings.0.second_radius_shared_secret",
+		"parameters.0.authentication_settings.0.key_encryption_key",
+		"parameters.0.authentication_settings.0.message_authenticator_code_key",
+		"parameters.0.tacacs_settings.0.shared_secret",
+		"parameters.0.trustsecsettings.0.device_authentication_settings.0.sga_device_password",
+		"parameters.0.trustsecsettings.0.device_configuration_deployment.0.enable_mode_password",
+		"parameters.0.trustsecsettings.0.device_configuration_deployment.0.exec_mode_password",
+	},
+	"ciscoise_external_radius_server": {
+		"parameters.0.shared_secret",
+		"parameters.0.encryption_key",
+		"parameters.0.authenticator_key",
+	},
+	"ciscoise_tacacs_external_servers": {
+		"parameters.0.shared_secret",
+	},
+	"ciscoise_ldap": {
+		"parameters.0.connection_settings.0.primary_server.0.admin_password",
+		"parameters.0.connection_settings.0.secondary_server.0.admin_password",
+	},
+}
+
+// writeOnlySecretResources adds secret_hashes to the resources listed in
+// writeOnlySecrets and wraps their operations, so that the secrets are
+// compared by hash on plan and never kept in state. The hashes are a computed
+// attribute rather than private state: SDK v2 keeps the private state of a
+// resource to itself, CRUD and CustomizeDiff functions cannot read or write
+// it. The attribute is sensitive, so the hashes never show in plans.
+func writeOnlySecretResources(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		paths, ok := writeOnlySecrets[name]
+		if !ok {
+			continue
+		}
+		for _, path := range paths {
+			s := schemaAtPath(r.Schema, path)
+			if s == nil {
+				panic(fmt.Sprintf("%s has no secret %s", name, path))
+			}
+			s.Sensitive = true
+		}
+		r.Schema["secret_hashes"] = &schema.Schema{
+			Description: `Salted hashes of the secrets applied, by attribute path, to detect their changes without keeping the secrets themselves in state. It is an attribute, not private state, which the plugin SDK does not expose to resources.`,
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Sensitive:   true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+		r.CustomizeDiff = secretsCustomizeDiff(paths, r.CustomizeDiff)
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(secretsApply(paths, r.CreateContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(secretsApply(paths, r.UpdateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(secretsRead(paths, r.ReadContext))
+		}
+	}
+}
+
+// secretsCustomizeDiff removes from the plan the secrets whose hash matches
+// the one applied, so that they are not sent again, and plans new hashes
+// when any secret changes.
+func secretsCustomizeDiff(paths []string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		hashes, _ := d.GetChange("secret_hashes")
+		hashesMap, _ := hashes.(map[string]interface{})
+		changed := false
+		for _, path := range paths {
+			secret := interfaceToString(d.Get(path))
+			if secret == "" {
+				continue
+			}
+			if secretHashMatches(interfaceToString(hashesMap[path]), secret) {
+				if err := d.Clear(path); err != nil {
+					return err
+				}
+				continue
+			}
+			logDebugf(ctx, "Secret %s changed", path)
+			changed = true
+		}
+		if changed {
+			return d.SetNewComputed("secret_hashes")
+		}
+		return nil
+	}
+}
+
+// secretsApply records the hashes of the secrets sent by a create or an
+// update, then removes the secrets from state.
+func secretsApply(paths []string, apply func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		prior, _ := d.GetChange("secret_hashes")
+		hashes := make(map[string]interface{})
+		if priorMap, ok := prior.(map[string]interface{}); ok {
+			for path, hash := range priorMap {
+				hashes[path] = hash
+			}
+		}
+		for _, path := range paths {
+			if secret := interfaceToString(d.Get(path)); secret != "" {
+				hashes[path] = hashSecret(ctx, secret)
+			}
+		}
+		diags := apply(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := d.Set("secret_hashes", hashes); err != nil {
+			return append(diags, diagError(
+				"Failure when setting secret_hashes", err))
+		}
+		return append(diags, clearSecrets(d, paths)...)
+	}
+}
+
+func secretsRead(paths []string, read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		// The state written before secret_hashes holds the secrets applied
+		hashes, _ := d.Get("secret_hashes").(map[string]interface{})
+		recorded := false
+		for _, path := range paths {
+			if secret := interfaceToString(d.Get(path)); secret != "" && hashes[path] == nil {
+				if hashes == nil {
+					hashes = make(map[string]interface{})
+				}
+				hashes[path] = hashSecret(ctx, secret)
+				recorded = true
+			}
+		}
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if recorded {
+			if err := d.Set("secret_hashes", hashes); err != nil {
+				return append(diags, diagError(
+					"Failure when setting secret_hashes", err))
+			}
+		}
+		return append(diags, clearSecrets(d, paths)...)
+	}
+}
+
+// clearSecrets blanks the secrets in parameters and item, whatever was
+// applied or read.
+func clearSecrets(d *schema.ResourceData, paths []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, key := range []string{"parameters", "item"} {
+		value := d.Get(key)
+		cleared := false
+		for _, path := range paths {
+			parts := strings.Split(path, ".")
+			if setAtPath(value, parts[1:], "") {
+				cleared = true
+			}
+		}
+		if !cleared {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diagError(
+				fmt.Sprintf("Failure when clearing the secrets of %s", key), err))
+		}
+	}
+	return diags
+}
+
+// setAtPath sets the non-empty string at parts, a path of list indexes and
+// map keys, within value as returned by d.Get. It reports whether it was set.
+func setAtPath(value interface{}, parts []string, newValue string) bool {
+	if len(parts) == 0 {
+		return false
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		i, err := strconv.Atoi(parts[0])
+		if err != nil || i >= len(v) {
+			return false
+		}
+		if len(parts) == 1 {
+			return false
+		}
+		return setAtPath(v[i], parts[1:], newValue)
+	case map[string]interface{}:
+		if len(parts) > 1 {
+			return setAtPath(v[parts[0]], parts[1:], newValue)
+		}
+		if current, ok := v[parts[0]].(string); !ok || current == "" {
+			return false
+		}
+		v[parts[0]] = newValue
+		return true
+	}
+	return false
+}
+
+// schemaAtPath returns the schema of the attribute at path, or nil.
+func schemaAtPath(schemaMap map[string]*schema.Schema, path string) *schema.Schema {
+	var s *schema.Schema
+	for _, part := range strings.Split(path, ".") {
+		if _, err := strconv.Atoi(part); err == nil && s != nil {
+			continue
+		}
+		if schemaMap == nil {
+			return nil
+		}
+		s = schemaMap[part]
+		if s == nil {
+			return nil
+		}
+		schemaMap = nil
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			schemaMap = elem.Schema
+		}
+	}
+	return s
+}
+
+// hashSecret returns a random salt and the SHA-256 hash of the salt and the
+// secret.
+func hashSecret(ctx context.Context, secret string) string {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		logDebugf(ctx, "Failure when generating a salt: %s", err)
+	}
+	return hex.EncodeToString(salt) + SECRET_HASH_SEPARATOR + saltedHash(salt, secret)
+}
+
+func secretHashMatches(hash string, secret string) bool {
+	parts := strings.SplitN(hash, SECRET_HASH_SEPARATOR, 2)
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(saltedHash(salt, secret)), []byte(parts[1])) == 1
+}
+
+func saltedHash(salt []byte, secret string) string {
+	hash := sha256.New()
+	hash.Write(salt)
+	hash.Write([]byte(secret))
+	return hex.EncodeToString(hash.Sum(nil))
+}
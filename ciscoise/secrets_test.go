@@ -0,0 +1,116 @@
+package ciscoise
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testSecretsResource(create schema.CreateContextFunc) *schema.Resource {
+	r := &schema.Resource{
+		CreateContext: create,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"shared_secret": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: diffSupressOptional(),
+							Computed:         true,
+						},
+					},
+				},
+			},
+		},
+	}
+	writeOnlySecretResources(&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{"ciscoise_tacacs_external_servers": r},
+	})
+	return r
+}
+
+func TestHashSecret(t *testing.T) {
+	hash := hashSecret(context.Background(), "secret1")
+	if !secretHashMatches(hash, "secret1") {
+		t.Errorf("bad: expect %s to match", hash)
+	}
+	if secretHashMatches(hash, "secret2") || secretHashMatches("", "secret1") {
+		t.Errorf("bad: expect %s to differ", hash)
+	}
+	if hash == hashSecret(context.Background(), "secret1") {
+		t.Errorf("bad: expect a new salt for every hash")
+	}
+}
+
+func TestSecretsApply(t *testing.T) {
+	r := testSecretsResource(func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if d.Get("parameters.0.shared_secret") != "secret1" {
+			t.Errorf("bad: expect the secret to be sent on create")
+		}
+		d.SetId("v2:name=s1")
+		return nil
+	})
+	if !r.Schema["parameters"].Elem.(*schema.Resource).Schema["shared_secret"].Sensitive {
+		t.Errorf("bad: expect the secret to be sensitive")
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"parameters": []interface{}{map[string]interface{}{"name": "s1", "shared_secret": "secret1"}},
+	})
+	if diags := r.CreateContext(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if secret := d.Get("parameters.0.shared_secret"); secret != "" {
+		t.Errorf("bad: expect the secret not to be kept, got %v", secret)
+	}
+	if hash := d.Get("secret_hashes").(map[string]interface{})["parameters.0.shared_secret"]; !secretHashMatches(interfaceToString(hash), "secret1") {
+		t.Errorf("bad: expect the hash of the secret, got %v", hash)
+	}
+}
+
+func TestSecretsCustomizeDiff(t *testing.T) {
+	r := testSecretsResource(nil)
+	state := &terraform.InstanceState{
+		ID: "v2:name=s1",
+		Attributes: map[string]string{
+			"id":                         "v2:name=s1",
+			"parameters.#":               "1",
+			"parameters.0.name":          "s1",
+			"parameters.0.shared_secret": "",
+			"secret_hashes.%":            "1",
+			"secret_hashes.parameters.0.shared_secret": hashSecret(context.Background(), "secret1"),
+		},
+	}
+	cases := map[string]bool{
+		"secret1": false,
+		"secret2": true,
+	}
+	for secret, expectChange := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"parameters": []interface{}{map[string]interface{}{"name": "s1", "shared_secret": secret}},
+		})
+		diff, err := r.Diff(context.Background(), state, config, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		changed := diff != nil && diff.Attributes["parameters.0.shared_secret"] != nil
+		if changed != expectChange {
+			t.Errorf("bad: expect change %v for %s, got %v", expectChange, secret, diff)
+		}
+		if changed && (diff.Attributes["secret_hashes.%"] == nil || !diff.Attributes["secret_hashes.%"].NewComputed) {
+			t.Errorf("bad: expect new hashes for %s, got %v", secret, diff)
+		}
+	}
+}
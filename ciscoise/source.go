@@ -0,0 +1,246 @@
+package ciscoise
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Separator of the values of a list attribute in a source column.
+const SOURCE_LIST_SEPARATOR = ";"
+
+// sourceSpec describes how the records of a CSV or JSON source become the
+// items of a resource attribute.
+type sourceSpec struct {
+	// itemsKey is the Optional and Computed attribute set from the source.
+	itemsKey string
+	// columns are the attributes read from the source, each one from the
+	// column of column_mappings or the column of the same name.
+	columns []string
+	item    func(record map[string]string) (map[string]interface{}, error)
+}
+
+// sourceSchema adds the attributes loading the items of spec from a CSV or
+// JSON source.
+func sourceSchema(s map[string]*schema.Schema, spec sourceSpec) map[string]*schema.Schema {
+	s["source_file"] = &schema.Schema{
+		Description:   fmt.Sprintf(`Path of a CSV or JSON file holding the %s items.`, spec.itemsKey),
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"source_content", spec.itemsKey},
+	}
+	s["source_content"] = &schema.Schema{
+		Description:   fmt.Sprintf(`CSV or JSON content holding the %s items.`, spec.itemsKey),
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		ConflictsWith: []string{spec.itemsKey},
+	}
+	s["source_format"] = &schema.Schema{
+		Description: `Format of the source, csv or json. By default json when the file has a .json extension or the content
+is an array, csv otherwise.`,
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateStringHasValueFunc([]string{"", "csv", "json"}),
+	}
+	s["column_mappings"] = &schema.Schema{
+		Description: fmt.Sprintf(`Column of the source for each attribute, by default the column named as the attribute.
+Attributes: %s.`, strings.Join(spec.columns, ", ")),
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	s["source_hash"] = &schema.Schema{
+		Description: `SHA-256 of the source and the column mappings, it changes whenever the source is edited.`,
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	return s
+}
+
+// customizeSourceDiff plans the items of the source in place of the items of
+// the state, so that the plan shows each item the source adds, changes or
+// removes.
+func customizeSourceDiff(diff *schema.ResourceDiff, spec sourceSpec) error {
+	for _, key := range []string{"source_file", "source_content", "source_format", "column_mappings"} {
+		if !diff.NewValueKnown(key) {
+			if err := diff.SetNewComputed("source_hash"); err != nil {
+				return err
+			}
+			return diff.SetNewComputed(spec.itemsKey)
+		}
+	}
+	file := diff.Get("source_file").(string)
+	content := diff.Get("source_content").(string)
+	if file == "" && content == "" {
+		return nil
+	}
+	items, hash, err := loadSource(file, content, diff.Get("source_format").(string), mapInterfaceToMapString(diff.Get("column_mappings").(map[string]interface{})), spec)
+	if err != nil {
+		return err
+	}
+	if hash != diff.Get("source_hash").(string) {
+		if err := diff.SetNew("source_hash", hash); err != nil {
+			return err
+		}
+	}
+	return diff.SetNew(spec.itemsKey, items)
+}
+
+// loadSource reads the items of spec from file or content and returns them
+// with the hash of the source.
+func loadSource(file string, content string, format string, mappings map[string]string, spec sourceSpec) ([]interface{}, string, error) {
+	data := []byte(content)
+	if file != "" {
+		var err error
+		data, err = os.ReadFile(file)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if format == "" {
+		format = "csv"
+		if strings.HasSuffix(strings.ToLower(file), ".json") || strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+			format = "json"
+		}
+	}
+
+	columns := make(map[string]string)
+	for _, column := range spec.columns {
+		columns[column] = column
+	}
+	keys := make([]string, 0, len(mappings))
+	for attribute, column := range mappings {
+		if _, ok := columns[attribute]; !ok {
+			return nil, "", fmt.Errorf("column_mappings: unknown attribute %s, expected one of %s", attribute, strings.Join(spec.columns, ", "))
+		}
+		columns[attribute] = column
+		keys = append(keys, attribute)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	hash.Write(data)
+	for _, key := range keys {
+		fmt.Fprintf(hash, "\n%s=%s", key, mappings[key])
+	}
+
+	var records []map[string]string
+	var err error
+	if format == "json" {
+		records, err = parseSourceJSON(data)
+	} else {
+		records, err = parseSourceCSV(data)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := make([]interface{}, 0, len(records))
+	for i, record := range records {
+		values := make(map[string]string)
+		for attribute, column := range columns {
+			values[attribute] = record[normalizeSourceColumn(column)]
+		}
+		item, err := spec.item(values)
+		if err != nil {
+			return nil, "", fmt.Errorf("record %d: %v", i+1, err)
+		}
+		items = append(items, item)
+	}
+	return items, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// normalizeSourceColumn matches column names ignoring case, spaces and
+// underscores.
+func normalizeSourceColumn(column string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "_", "").Replace(strings.TrimSpace(column)))
+}
+
+func parseSourceCSV(data []byte) ([]map[string]string, error) {
+	reader := csv.NewReader(strings.NewReader(string(data)))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records []map[string]string
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		record := make(map[string]string)
+		empty := true
+		for i, column := range header {
+			if i < len(row) {
+				record[normalizeSourceColumn(column)] = strings.TrimSpace(row[i])
+				empty = empty && strings.TrimSpace(row[i]) == ""
+			}
+		}
+		if !empty {
+			records = append(records, record)
+		}
+	}
+}
+
+// parseSourceJSON reads an array of objects. Arrays are joined with
+// SOURCE_LIST_SEPARATOR, other values are formatted.
+func parseSourceJSON(data []byte) ([]map[string]string, error) {
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, err
+	}
+	records := make([]map[string]string, 0, len(objects))
+	for _, object := range objects {
+		record := make(map[string]string)
+		for key, value := range object {
+			record[normalizeSourceColumn(key)] = sourceValueToString(value)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func sourceValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, i := range v {
+			values = append(values, sourceValueToString(i))
+		}
+		return strings.Join(values, SOURCE_LIST_SEPARATOR)
+	}
+	return fmt.Sprint(value)
+}
+
+// sourceList splits a list column on SOURCE_LIST_SEPARATOR.
+func sourceList(value string) []interface{} {
+	values := []interface{}{}
+	for _, v := range strings.Split(value, SOURCE_LIST_SEPARATOR) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,113 @@
+package ciscoise
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadSourceCSV(t *testing.T) {
+	content := `Name,IP Addresses,Network Device Groups,Secret
+switch1,10.0.0.1/24;10.0.1.1,Location#All Locations#Site1,s1
+
+switch2,10.0.0.2,,s2
+`
+	items, hash, err := loadSource("", content, "", map[string]string{"radius_shared_secret": "Secret"}, networkDevicesSource)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("bad: expect 2 devices, got %v", items)
+	}
+	device := items[0].(map[string]interface{})
+	if device["name"] != "switch1" || device["radius_shared_secret"] != "s1" || device["coa_port"] != 1700 {
+		t.Errorf("bad: unexpected device %v", device)
+	}
+	ipList := []interface{}{
+		map[string]interface{}{"ipaddress": "10.0.0.1", "mask": 24, "get_ipaddress_exclude": ""},
+		map[string]interface{}{"ipaddress": "10.0.1.1", "mask": 32, "get_ipaddress_exclude": ""},
+	}
+	if !reflect.DeepEqual(device["network_device_iplist"], ipList) {
+		t.Errorf("bad: unexpected addresses %v", device["network_device_iplist"])
+	}
+	if !reflect.DeepEqual(device["network_device_group_list"], []interface{}{"Location#All Locations#Site1"}) {
+		t.Errorf("bad: unexpected groups %v", device["network_device_group_list"])
+	}
+
+	_, editedHash, err := loadSource("", strings.Replace(content, "s2", "s3", 1), "", map[string]string{"radius_shared_secret": "Secret"}, networkDevicesSource)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if editedHash == hash {
+		t.Errorf("bad: expect the hash to change with the content")
+	}
+	_, mappedHash, err := loadSource("", content, "", nil, networkDevicesSource)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if mappedHash == hash {
+		t.Errorf("bad: expect the hash to change with the mappings")
+	}
+}
+
+func TestLoadSourceJSONFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "guests.json")
+	content := `[{"name": "bob", "guest_type": "Daily", "portal_id": "1", "location": "San Jose", "valid_days": 2, "email_address": null}]`
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	items, _, err := loadSource(file, "", "", nil, guestUsersSource)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	guest := items[0].(map[string]interface{})
+	if guest["name"] != "bob" || guest["valid_days"] != 2 || guest["email_address"] != "" {
+		t.Errorf("bad: unexpected guest %v", guest)
+	}
+
+	items, _, err = loadSource("", `[{"name": "switch1", "ip_addresses": ["10.0.0.1", "10.0.1.0/24"]}]`, "", nil, networkDevicesSource)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if ipList := items[0].(map[string]interface{})["network_device_iplist"].([]interface{}); len(ipList) != 2 {
+		t.Errorf("bad: expect a JSON array to be read as a list, got %v", ipList)
+	}
+}
+
+func TestLoadSourceErrors(t *testing.T) {
+	if _, _, err := loadSource("", "name\nswitch1\n", "", map[string]string{"serial": "Serial"}, networkDevicesSource); err == nil || !strings.Contains(err.Error(), "unknown attribute serial") {
+		t.Errorf("bad: expect an unknown attribute error, got %v", err)
+	}
+	if _, _, err := loadSource("", "name,ip_addresses\nswitch1,10.0.0.1\n,10.0.0.2\n", "", nil, networkDevicesSource); err == nil || !strings.Contains(err.Error(), "record 2") {
+		t.Errorf("bad: expect an error on record 2, got %v", err)
+	}
+	if _, _, err := loadSource("", `{"name": "bob"}`, "json", nil, guestUsersSource); err == nil {
+		t.Errorf("bad: expect an error for a JSON object")
+	}
+}
+
+func TestSourceItems(t *testing.T) {
+	endpoint, err := endpointsBulkSourceItem(map[string]string{"mac": "00:11:22:33:44:55", "static_group_assignment": "TRUE"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if endpoint["value"] != "00:11:22:33:44:55" || endpoint["static_group_assignment"] != "true" {
+		t.Errorf("bad: unexpected endpoint %v", endpoint)
+	}
+	if _, err := endpointsBulkSourceItem(map[string]string{"mac": "00:11:22:33:44:55", "static_group_assignment": "yes"}); err == nil {
+		t.Errorf("bad: expect an error for static_group_assignment yes")
+	}
+
+	guest, err := guestUsersSourceItem(map[string]string{"name": "bob", "guest_type": "Daily", "portal_id": "1", "location": "San Jose", "valid_days": "2"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if guest["valid_days"] != 2 || guest["location"] != "San Jose" {
+		t.Errorf("bad: unexpected guest %v", guest)
+	}
+	if _, err := guestUsersSourceItem(map[string]string{"name": "bob"}); err == nil {
+		t.Errorf("bad: expect an error without guest_type")
+	}
+}
@@ -0,0 +1,144 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var taskWaiter = waiter{
+	minInterval: TASK_POLL_MIN_INTERVAL,
+	maxInterval: TASK_POLL_MAX_INTERVAL,
+}
+
+// pendingTaskStatuses are the executionStatus values of a task still running,
+// any other value is terminal.
+var pendingTaskStatuses = map[string]bool{
+	"":            true,
+	"NOT_STARTED": true,
+	"PENDING":     true,
+	"QUEUED":      true,
+	"IN_QUEUE":    true,
+	"IN_PROGRESS": true,
+	"RUNNING":     true,
+}
+
+func isFailedStatus(status string) bool {
+	status = strings.ToUpper(status)
+	return strings.Contains(status, "FAIL") || strings.Contains(status, "ERROR") || status == "ABORTED" || status == "CANCELLED"
+}
+
+// waitForTaskSchema is the wait_for_completion attribute of the resources
+// starting a task.
+func waitForTaskSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Wait until the task started by the resource completes. Defaults to true.`,
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		ForceNew:    true,
+	}
+}
+
+// waitForTask polls the Tasks API until the task reaches a terminal
+// executionStatus. A failed task returns an error diagnostic, followed by
+// one for each node of detailStatus reporting a failure.
+func waitForTask(ctx context.Context, client *isegosdk.Client, taskID string, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if taskID == "" {
+		logWarnf(ctx, "%s did not return a task ID, not waiting for its completion", operation)
+		return diags
+	}
+	var task *isegosdk.ResponseTasksGetTaskStatus
+	err := taskWaiter.wait(ctx, fmt.Sprintf("%s task %s", operation, taskID), func() (bool, error) {
+		taskResponse, restyResp, err := client.Tasks.GetTaskStatus(taskID)
+		if err != nil || taskResponse == nil {
+			if restyResp != nil {
+				logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+			}
+			return false, nil
+		}
+		task = taskResponse
+		logInfof(ctx, "Task %s is %s", taskID, task.ExecutionStatus)
+		return !pendingTaskStatuses[strings.ToUpper(task.ExecutionStatus)], nil
+	})
+	if err != nil {
+		diags = append(diags, diagError(
+			fmt.Sprintf("Failure when waiting for %s task %s", operation, taskID), err))
+		return diags
+	}
+	return taskDiagnostics(task, operation)
+}
+
+func taskDiagnostics(task *isegosdk.ResponseTasksGetTaskStatus, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	failCount := 0
+	if task.FailCount != nil {
+		failCount = *task.FailCount
+	}
+	if !isFailedStatus(task.ExecutionStatus) && failCount == 0 {
+		return diags
+	}
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s task %s ended with status %s", operation, task.ID, task.ExecutionStatus),
+		Detail:   fmt.Sprintf("%d of the task resources failed", failCount),
+	})
+	if task.DetailStatus == nil {
+		return diags
+	}
+	for _, detail := range *task.DetailStatus {
+		node, status, message := taskDetail(detail)
+		if status != "" && !isFailedStatus(status) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s task %s failed on %s", operation, task.ID, node),
+			Detail:   redactSecrets(message),
+		})
+	}
+	return diags
+}
+
+// taskDetail reads an entry of detailStatus, whose content depends on the
+// task module.
+func taskDetail(detail interface{}) (string, string, string) {
+	values, ok := detail.(map[string]interface{})
+	if !ok {
+		return "a node", "", fmt.Sprintf("%v", detail)
+	}
+	lookup := func(keys ...string) string {
+		for _, key := range keys {
+			if value, ok := values[key]; ok && value != nil {
+				return fmt.Sprintf("%v", value)
+			}
+		}
+		return ""
+	}
+	node := lookup("hostname", "hostName", "nodeName", "node", "name")
+	if node == "" {
+		node = "a node"
+	}
+	status := lookup("status", "executionStatus", "state")
+	message := lookup("failureReason", "errorMessage", "message", "statusMessage", "description")
+	if message == "" {
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		parts := make([]string, 0, len(keys))
+		for _, key := range keys {
+			parts = append(parts, fmt.Sprintf("%s: %v", key, values[key]))
+		}
+		message = strings.Join(parts, ", ")
+	}
+	return node, status, message
+}
@@ -0,0 +1,44 @@
+package ciscoise
+
+import (
+	"strings"
+	"testing"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+)
+
+func TestTaskDiagnostics(t *testing.T) {
+	failCount := 1
+	details := []isegosdk.ResponseTasksGetTaskStatusDetailStatus{
+		map[string]interface{}{"hostname": "psn1", "status": "SUCCESS"},
+		map[string]interface{}{"hostname": "psn2", "status": "FAILED", "failureReason": "Application server is not running"},
+	}
+	task := &isegosdk.ResponseTasksGetTaskStatus{
+		ID:              "1234",
+		ExecutionStatus: "FAILED",
+		FailCount:       &failCount,
+		DetailStatus:    &details,
+	}
+	diags := taskDiagnostics(task, "SyncNode")
+	if len(diags) != 2 {
+		t.Fatalf("bad: expect a task and a node diagnostic, got %v", diags)
+	}
+	if !strings.Contains(diags[1].Summary, "psn2") || diags[1].Detail != "Application server is not running" {
+		t.Errorf("bad: unexpected node diagnostic %v", diags[1])
+	}
+
+	task = &isegosdk.ResponseTasksGetTaskStatus{ID: "1234", ExecutionStatus: "COMPLETED"}
+	if diags := taskDiagnostics(task, "SyncNode"); diags.HasError() {
+		t.Errorf("bad: expect no diagnostic for a completed task, got %v", diags)
+	}
+}
+
+func TestTaskDetail(t *testing.T) {
+	node, status, message := taskDetail(map[string]interface{}{"nodeName": "pan1", "state": "ERROR", "code": 500})
+	if node != "pan1" || status != "ERROR" || message != "code: 500, nodeName: pan1, state: ERROR" {
+		t.Errorf("bad: unexpected detail %s %s %s", node, status, message)
+	}
+	if node, _, message := taskDetail("failed to reload"); node != "a node" || message != "failed to reload" {
+		t.Errorf("bad: unexpected detail %s %s", node, message)
+	}
+}
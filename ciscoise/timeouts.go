@@ -1,14 +1,59 @@
 package ciscoise
 
-import "time"
+import (
+	"context"
+	"time"
 
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+)
+
+// Default values of the timeouts block of the long-running resources.
 const HOTPATCH_INSTALL_TIMEOUT = time.Duration(30) * time.Minute
 const HOTPATCH_ROLLBACK_TIMEOUT = time.Duration(30) * time.Minute
 
 const PATCH_INSTALL_TIMEOUT = time.Duration(30) * time.Minute
 const PATCH_ROLLBACK_TIMEOUT = time.Duration(30) * time.Minute
 
-const HOTPATCH_INSTALL_TIMEOUT_SLEEP = time.Duration(5) * time.Minute
-const HOTPATCH_ROLLBACK_TIMEOUT_SLEEP = time.Duration(3) * time.Minute
-const PATCH_INSTALL_TIMEOUT_SLEEP = time.Duration(5) * time.Minute
-const PATCH_ROLLBACK_TIMEOUT_SLEEP = time.Duration(3) * time.Minute
+const BACKUP_RESTORE_TIMEOUT = time.Duration(60) * time.Minute
+const NODE_DEPLOYMENT_TIMEOUT = time.Duration(30) * time.Minute
+const ACTIVE_DIRECTORY_JOIN_TIMEOUT = time.Duration(10) * time.Minute
+const UPGRADE_TIMEOUT = time.Duration(60) * time.Minute
+const TASK_TIMEOUT = time.Duration(30) * time.Minute
+
+// Bounds of the interval between two checks of a patch or hotpatch.
+const PATCH_POLL_MIN_INTERVAL = time.Duration(15) * time.Second
+const PATCH_POLL_MAX_INTERVAL = time.Duration(2) * time.Minute
+
+// Bounds of the interval between two checks of a task status.
+const TASK_POLL_MIN_INTERVAL = time.Duration(5) * time.Second
+const TASK_POLL_MAX_INTERVAL = time.Duration(1) * time.Minute
+
+// sleepContext waits for duration, or returns the error of ctx if it is
+// cancelled or reaches its deadline first.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// callContext runs a SDK call with a client whose requests are canceled
+// when ctx is done, and returns the error of ctx if it ended the call. The
+// results of the call must not be used after an error.
+func callContext(ctx context.Context, m interface{}, call func(client *isegosdk.Client)) error {
+	clientConfig := m.(ClientConfig)
+	client := clientConfig.Client
+	if clientConfig.Config != nil && clientConfig.Config.transport != nil {
+		contextClient, err := clientConfig.Config.NewOperationClient(ctx)
+		if err != nil {
+			return err
+		}
+		client = contextClient
+	}
+	call(client)
+	return ctx.Err()
+}
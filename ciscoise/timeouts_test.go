@@ -0,0 +1,58 @@
+package ciscoise
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+)
+
+func TestSleepContext(t *testing.T) {
+	if err := sleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("bad: expect no error, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := sleepContext(ctx, time.Minute); err != context.DeadlineExceeded {
+		t.Errorf("bad: expect the deadline to interrupt the sleep, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("bad: expect the sleep to stop at the deadline, took %s", time.Since(start))
+	}
+}
+
+func TestCallContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+	clientConfig := testClientConfig(t, context.Background(), server.URL)
+
+	called := false
+	if err := callContext(context.Background(), ClientConfig{}, func(client *isegosdk.Client) { called = true }); err != nil || !called {
+		t.Errorf("bad: expect the call to complete, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	var errCall error
+	err := callContext(ctx, clientConfig, func(client *isegosdk.Client) {
+		_, errCall = client.RestyClient().R().Post(server.URL + "/api/v1/backup-restore/config/restore")
+	})
+	if err != context.DeadlineExceeded || errCall == nil {
+		t.Errorf("bad: expect the deadline to cancel the request, got %v and %v", err, errCall)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("bad: expect the request to stop at the deadline, took %s", time.Since(start))
+	}
+}
@@ -0,0 +1,46 @@
+package ciscoise
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// tlsConfig builds the TLS settings shared by every client of the provider.
+// When a CA bundle is given it replaces the system trust store.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.SSLVerify == "false",
+		ServerName:         c.TLSServerName,
+	}
+
+	if c.CAFile != "" || c.CAPEM != "" {
+		pool := x509.NewCertPool()
+		if c.CAFile != "" {
+			caFileData, err := os.ReadFile(c.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_file: %v", err)
+			}
+			if !pool.AppendCertsFromPEM(caFileData) {
+				return nil, fmt.Errorf("ca_file %s does not contain any PEM encoded certificate", c.CAFile)
+			}
+		}
+		if c.CAPEM != "" && !pool.AppendCertsFromPEM([]byte(c.CAPEM)) {
+			return nil, fmt.Errorf("ca_pem does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertPEM != "" || c.ClientKeyPEM != "" {
+		if c.ClientCertPEM == "" || c.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("client_cert_pem and client_key_pem must be set together")
+		}
+		certificate, err := tls.X509KeyPair([]byte(c.ClientCertPEM), []byte(c.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,117 @@
+package ciscoise
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testSelfSignedPEM(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ise.example.com"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestConfigTLSConfig(t *testing.T) {
+	certPEM, keyPEM := testSelfSignedPEM(t)
+	_, otherKeyPEM := testSelfSignedPEM(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(certPEM), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := map[string]struct {
+		Config      Config
+		ExpectError bool
+	}{
+		"default settings": {
+			Config: Config{SSLVerify: "true"},
+		},
+		"ca pem": {
+			Config: Config{SSLVerify: "true", CAPEM: certPEM},
+		},
+		"ca file": {
+			Config: Config{SSLVerify: "true", CAFile: caFile},
+		},
+		"missing ca file": {
+			Config:      Config{SSLVerify: "true", CAFile: filepath.Join(t.TempDir(), "missing.pem")},
+			ExpectError: true,
+		},
+		"invalid ca pem": {
+			Config:      Config{SSLVerify: "true", CAPEM: "not a certificate"},
+			ExpectError: true,
+		},
+		"client certificate": {
+			Config: Config{SSLVerify: "true", ClientCertPEM: certPEM, ClientKeyPEM: keyPEM},
+		},
+		"client certificate without key": {
+			Config:      Config{SSLVerify: "true", ClientCertPEM: certPEM},
+			ExpectError: true,
+		},
+		"client certificate with another key": {
+			Config:      Config{SSLVerify: "true", ClientCertPEM: certPEM, ClientKeyPEM: otherKeyPEM},
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		_, err := tc.Config.tlsConfig()
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expect error %t, got %v", tn, tc.ExpectError, err)
+		}
+	}
+}
+
+func TestConfigTLSConfigSettings(t *testing.T) {
+	certPEM, keyPEM := testSelfSignedPEM(t)
+	config := Config{
+		SSLVerify:     "false",
+		CAPEM:         certPEM,
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+		TLSServerName: "ise.example.com",
+	}
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Errorf("bad: expect InsecureSkipVerify when ssl_verify is false")
+	}
+	if tlsConfig.ServerName != "ise.example.com" {
+		t.Errorf("bad: expect ServerName ise.example.com, got %s", tlsConfig.ServerName)
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Errorf("bad: expect RootCAs to be set")
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Errorf("bad: expect one client certificate, got %d", len(tlsConfig.Certificates))
+	}
+}
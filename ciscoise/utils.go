@@ -5,7 +5,6 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -48,6 +47,12 @@ func compareMacAddress(old_mac_address, new_mac_address string) bool {
 	return strings.ToLower(oldClear) == strings.ToLower(newClear)
 }
 
+func normalizeMacAddress(mac_address string) string {
+	rexp := `([-.:])`
+	clear, _ := replaceRegExStrings(mac_address, "", rexp, "")
+	return strings.ToLower(clear)
+}
+
 func compareBoolean(new string, old string) bool {
 	if old == "" && new != "" {
 		return false
@@ -117,38 +122,58 @@ func isEmptyValue(v reflect.Value) bool {
 	return false
 }
 
+// Prefix of the resource IDs encoded as a URL query string, e.g.
+// v2:id=1234&name=corp%5CDomain+Admins.
+const RESOURCE_ID_PREFIX = "v2:"
+
+// Separators of the legacy resource IDs, e.g. id:=1234\name:=Site1. They are
+// not escaped, so values holding them cannot be told apart.
+const LEGACY_ID_PARAMS_SEPARATOR = "\\"
+const LEGACY_ID_VALUE_SEPARATOR = ":="
+
+// joinResourceID encodes the non-empty params as a versioned resource ID.
+// Keys and values are escaped, so any name can be stored.
 func joinResourceID(result_params map[string]string) string {
-	var PARAMS_SEPARATOR string = "\\"
-	var PARAM_VALUE_SEPARATOR string = ":="
-	ID := ""
-	params := []string{}
+	values := url.Values{}
 	for key, value := range result_params {
 		if value != "" {
-			params = append(params, fmt.Sprintf("%s%s%s", key, PARAM_VALUE_SEPARATOR, value))
+			values.Set(key, value)
 		}
 	}
-	sort.Strings(params) // Sort params
-	ID = strings.Join(params, PARAMS_SEPARATOR)
-	return ID
+	if len(values) == 0 {
+		return ""
+	}
+	// Encode sorts by key
+	return RESOURCE_ID_PREFIX + values.Encode()
 }
 
+// separateResourceID decodes a resource ID built by joinResourceID, or a
+// legacy ID of key:=value pairs separated by a backslash.
 func separateResourceID(ID string) map[string]string {
-	var PARAMS_SEPARATOR string = "\\"
-	var PARAM_VALUE_SEPARATOR string = ":="
-	params := strings.Split(ID, PARAMS_SEPARATOR)
-	sort.Strings(params) // Sort params
 	result_params := make(map[string]string)
-	for _, param := range params {
-		param_key_value := strings.Split(param, PARAM_VALUE_SEPARATOR)
-		if len(param_key_value) == 2 {
-			if param_key_value[1] != "" {
-				result_params[param_key_value[0]] = param_key_value[1]
+	if strings.HasPrefix(ID, RESOURCE_ID_PREFIX) {
+		values, _ := url.ParseQuery(strings.TrimPrefix(ID, RESOURCE_ID_PREFIX))
+		for key := range values {
+			if value := values.Get(key); value != "" {
+				result_params[key] = value
 			}
 		}
+		return result_params
+	}
+	for _, param := range strings.Split(ID, LEGACY_ID_PARAMS_SEPARATOR) {
+		param_key_value := strings.SplitN(param, LEGACY_ID_VALUE_SEPARATOR, 2)
+		if len(param_key_value) == 2 && param_key_value[1] != "" {
+			result_params[param_key_value[0]] = param_key_value[1]
+		}
 	}
 	return result_params
 }
 
+// isLegacyResourceID reports whether ID is a legacy key:=value resource ID.
+func isLegacyResourceID(ID string) bool {
+	return !strings.HasPrefix(ID, RESOURCE_ID_PREFIX) && strings.Contains(ID, LEGACY_ID_VALUE_SEPARATOR)
+}
+
 // listNicely listNicely
 /* Converts []string to string, by adding quotes and separate values by comma
 @param values
@@ -190,7 +215,7 @@ func pickMethod(methods [][]bool) int {
 func diagError(summaryErr string, err error) diag.Diagnostic {
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error, Summary: summaryErr}
 	if err != nil {
-		diagErrResponse.Detail = err.Error()
+		diagErrResponse.Detail = redactSecrets(err.Error())
 		return diagErrResponse
 	}
 	return diagErrResponse
@@ -199,10 +224,10 @@ func diagError(summaryErr string, err error) diag.Diagnostic {
 func diagErrorWithResponse(summaryErr string, err error, restyResponse string) diag.Diagnostic {
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error, Summary: summaryErr}
 	if err != nil {
-		diagErrResponse.Detail = fmt.Sprintf("%s\n%v", err.Error(), restyResponse)
+		diagErrResponse.Detail = redactSecrets(fmt.Sprintf("%s\n%v", err.Error(), restyResponse))
 		return diagErrResponse
 	}
-	diagErrResponse.Detail = restyResponse
+	diagErrResponse.Detail = redactSecrets(restyResponse)
 	return diagErrResponse
 }
 
@@ -210,12 +235,12 @@ func diagErrorWithAltAndResponse(summaryErr string, err error, restyResponse str
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error}
 	if err != nil {
 		diagErrResponse.Summary = summaryErr
-		diagErrResponse.Detail = fmt.Sprintf("%s\n%v", err.Error(), restyResponse)
+		diagErrResponse.Detail = redactSecrets(fmt.Sprintf("%s\n%v", err.Error(), restyResponse))
 		return diagErrResponse
 	}
 	diagErrResponse.Summary = summaryAlt
 	if detail != "" {
-		diagErrResponse.Detail = detail
+		diagErrResponse.Detail = redactSecrets(detail)
 	}
 	return diagErrResponse
 }
@@ -224,12 +249,12 @@ func diagErrorWithAlt(summaryErr string, err error, summaryAlt string, detail st
 	diagErrResponse := diag.Diagnostic{Severity: diag.Error}
 	if err != nil {
 		diagErrResponse.Summary = summaryErr
-		diagErrResponse.Detail = err.Error()
+		diagErrResponse.Detail = redactSecrets(err.Error())
 		return diagErrResponse
 	}
 	diagErrResponse.Summary = summaryAlt
 	if detail != "" {
-		diagErrResponse.Detail = detail
+		diagErrResponse.Detail = redactSecrets(detail)
 	}
 	return diagErrResponse
 }
@@ -1,6 +1,7 @@
 package ciscoise
 
 import (
+	"context"
 	"reflect"
 	"testing"
 )
@@ -353,3 +354,60 @@ func TestUtilsRemoveParameters(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeMacAddress(t *testing.T) {
+	for _, mac := range []string{"AA:BB:CC:00:11:22", "aa-bb-cc-00-11-22", "aabb.cc00.1122"} {
+		if normalized := normalizeMacAddress(mac); normalized != "aabbcc001122" {
+			t.Errorf("bad: unexpected normalized MAC %s for %s", normalized, mac)
+		}
+	}
+}
+
+func TestUtilsResourceID(t *testing.T) {
+	params := map[string]string{
+		"id":   "1234",
+		"name": `corp\Domain Admins:=a&b=c`,
+	}
+	ID := joinResourceID(params)
+	if ID != `v2:id=1234&name=corp%5CDomain+Admins%3A%3Da%26b%3Dc` {
+		t.Errorf("bad: unexpected ID %s", ID)
+	}
+	if result := separateResourceID(ID); !reflect.DeepEqual(result, params) {
+		t.Errorf("bad: expect %v, got %v", params, result)
+	}
+	if ID := joinResourceID(map[string]string{"id": ""}); ID != "" {
+		t.Errorf("bad: expect an empty ID without values, got %s", ID)
+	}
+
+	legacy := map[string]string{"id": "1234", "name": "Location#All Locations#Site1"}
+	if result := separateResourceID(`id:=1234\name:=Location#All Locations#Site1`); !reflect.DeepEqual(result, legacy) {
+		t.Errorf("bad: expect %v, got %v", legacy, result)
+	}
+	if !isLegacyResourceID(`id:=1234`) || isLegacyResourceID(ID) || isLegacyResourceID("1634567890") {
+		t.Errorf("bad: unexpected legacy ID detection")
+	}
+}
+
+func TestUtilsUpgradeResourceIDV0(t *testing.T) {
+	cases := map[string]string{
+		`id:=1234\name:=Site1`: "v2:id=1234&name=Site1",
+		"v2:id=1234":           "v2:id=1234",
+		"1634567890":           "1634567890",
+	}
+	for ID, expected := range cases {
+		state, err := upgradeResourceIDV0(context.Background(), map[string]interface{}{"id": ID}, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if state["id"] != expected {
+			t.Errorf("bad: expect %s for %s, got %v", expected, ID, state["id"])
+		}
+	}
+
+	p := Provider()
+	for name, r := range p.ResourcesMap {
+		if r.SchemaVersion != RESOURCE_ID_SCHEMA_VERSION || len(r.StateUpgraders) != 1 {
+			t.Errorf("bad: expect a resource ID state upgrader on %s", name)
+		}
+	}
+}
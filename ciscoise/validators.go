@@ -3,6 +3,7 @@ package ciscoise
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -80,3 +81,12 @@ func validateStringMatchesPattern(pattern string) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+func validateRFC3339Time(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q is not a valid RFC 3339 time: %q", k, value))
+	}
+	return
+}
@@ -89,3 +89,14 @@ func TestValidatorsValidateStringMatchesPattern(t *testing.T) {
 		t.Fatalf("%q should match the pattern", v)
 	}
 }
+
+func TestValidatorsValidateRFC3339Time(t *testing.T) {
+	v := "2026-10-19T10:30:00+02:00"
+	if _, errors := validateRFC3339Time(v, "time"); errors != nil {
+		t.Fatalf("%q should be a valid time", v)
+	}
+	v = "2026-10-19 10:30"
+	if _, errors := validateRFC3339Time(v, "time"); errors == nil {
+		t.Fatalf("%q should not be a valid time", v)
+	}
+}
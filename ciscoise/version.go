@@ -0,0 +1,171 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ISEVersion is the release and patch of the connected ISE deployment.
+type ISEVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+func (v ISEVersion) String() string {
+	if v.Patch > 0 {
+		return fmt.Sprintf("%d.%d patch %d", v.Major, v.Minor, v.Patch)
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// AtLeast reports whether v is the same release and patch as min or a later one.
+func (v ISEVersion) AtLeast(min ISEVersion) bool {
+	if v.Major != min.Major {
+		return v.Major > min.Major
+	}
+	if v.Minor != min.Minor {
+		return v.Minor > min.Minor
+	}
+	return v.Patch >= min.Patch
+}
+
+// parseISEVersion parses the version ("3.1.0.518") and the installed
+// patches ("0", "1,3") returned by GetIseVersionAndPatch.
+func parseISEVersion(version string, patches string) (*ISEVersion, error) {
+	parts := strings.Split(strings.TrimSpace(version), ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("unexpected ISE version %q", version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected ISE version %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("unexpected ISE version %q", version)
+	}
+	iseVersion := ISEVersion{Major: major, Minor: minor}
+	for _, patch := range strings.Split(patches, ",") {
+		if value, err := strconv.Atoi(strings.TrimSpace(patch)); err == nil && value > iseVersion.Patch {
+			iseVersion.Patch = value
+		}
+	}
+	return &iseVersion, nil
+}
+
+// getISEVersion queries the version of the connected deployment.
+func getISEVersion(ctx context.Context, client *isegosdk.Client) (*ISEVersion, error) {
+	response, restyResp, err := client.VersionAndPatch.GetIseVersionAndPatch()
+	if err != nil {
+		if restyResp != nil {
+			logDebugf(ctx, "Retrieved error response %s", restyResp.String())
+		}
+		return nil, err
+	}
+	if response == nil || response.OperationResult == nil || response.OperationResult.ResultValue == nil {
+		return nil, fmt.Errorf("unexpected response from GetIseVersionAndPatch")
+	}
+	version, patches := "", ""
+	for _, item := range *response.OperationResult.ResultValue {
+		switch strings.ToLower(item.Name) {
+		case "version":
+			version = item.Value
+		case "patch information":
+			patches = item.Value
+		}
+	}
+	return parseISEVersion(version, patches)
+}
+
+// minimumVersions are the ISE releases introducing the APIs of the resources
+// and data sources not available since 3.0.
+var minimumVersions = map[string]ISEVersion{
+	"ciscoise_trustsec_sg_vn_mapping":               {Major: 3, Minor: 1},
+	"ciscoise_trustsec_sg_vn_mapping_bulk_create":   {Major: 3, Minor: 1},
+	"ciscoise_trustsec_sg_vn_mapping_bulk_delete":   {Major: 3, Minor: 1},
+	"ciscoise_trustsec_sg_vn_mapping_bulk_update":   {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn":                          {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_bulk_create":              {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_bulk_delete":              {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_bulk_update":              {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_vlan_mapping":             {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_vlan_mapping_bulk_create": {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_vlan_mapping_bulk_delete": {Major: 3, Minor: 1},
+	"ciscoise_trustsec_vn_vlan_mapping_bulk_update": {Major: 3, Minor: 1},
+	"ciscoise_dataconnect_info":                     {Major: 3, Minor: 2},
+	"ciscoise_dataconnect_settings":                 {Major: 3, Minor: 2},
+	"ciscoise_px_grid_direct":                       {Major: 3, Minor: 2},
+	"ciscoise_px_grid_direct_dictionary_info":       {Major: 3, Minor: 2},
+	"ciscoise_px_grid_direct_sync":                  {Major: 3, Minor: 2},
+	"ciscoise_px_grid_direct_test_connector":        {Major: 3, Minor: 2},
+	"ciscoise_duo_identity_sync":                    {Major: 3, Minor: 3},
+	"ciscoise_duo_identity_sync_cancel_info":        {Major: 3, Minor: 3},
+	"ciscoise_duo_identity_sync_status":             {Major: 3, Minor: 3},
+	"ciscoise_duo_identitysync_sync_info":           {Major: 3, Minor: 3},
+	"ciscoise_duo_mfa":                              {Major: 3, Minor: 3},
+	"ciscoise_duo_mfa_testconnection":               {Major: 3, Minor: 3},
+	"ciscoise_user_equipment":                       {Major: 3, Minor: 3},
+	"ciscoise_user_equipment_bulk":                  {Major: 3, Minor: 3},
+	"ciscoise_user_equipment_csv":                   {Major: 3, Minor: 3},
+	"ciscoise_user_equipment_imei_info":             {Major: 3, Minor: 3},
+	"ciscoise_user_equipment_subscriber_info":       {Major: 3, Minor: 3},
+}
+
+// requireVersion returns an error diagnostic when the connected deployment
+// is older than min. It does nothing when the version could not be detected.
+func requireVersion(m interface{}, name string, min ISEVersion) diag.Diagnostics {
+	clientConfig, ok := m.(ClientConfig)
+	if !ok || clientConfig.ISEVersion == nil || clientConfig.ISEVersion.AtLeast(min) {
+		return nil
+	}
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s requires ISE %s, connected to %s", name, min, clientConfig.ISEVersion),
+		Detail:   fmt.Sprintf("The API used by %s is not available in ISE %s.", name, clientConfig.ISEVersion),
+	}}
+}
+
+func versionGate(name string, min ISEVersion, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if diags := requireVersion(m, name, min); diags.HasError() {
+			return diags
+		}
+		return f(ctx, d, m)
+	}
+}
+
+// gateResourceVersions checks minimumVersions before any API call of the
+// resources and data sources listed there.
+func gateResourceVersions(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		min, ok := minimumVersions[name]
+		if !ok {
+			continue
+		}
+		if r.CreateContext != nil {
+			r.CreateContext = schema.CreateContextFunc(versionGate(name, min, r.CreateContext))
+		}
+		if r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(versionGate(name, min, r.ReadContext))
+		}
+		if r.UpdateContext != nil {
+			r.UpdateContext = schema.UpdateContextFunc(versionGate(name, min, r.UpdateContext))
+		}
+		if r.DeleteContext != nil {
+			r.DeleteContext = schema.DeleteContextFunc(versionGate(name, min, r.DeleteContext))
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if min, ok := minimumVersions[name]; ok && r.ReadContext != nil {
+			r.ReadContext = schema.ReadContextFunc(versionGate(name, min, r.ReadContext))
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ciscoise
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseISEVersion(t *testing.T) {
+	cases := map[string]struct {
+		Version     string
+		Patches     string
+		Expect      ISEVersion
+		ExpectError bool
+	}{
+		"no patch": {
+			Version: "3.1.0.518",
+			Patches: "0",
+			Expect:  ISEVersion{Major: 3, Minor: 1},
+		},
+		"several patches": {
+			Version: "3.2.0.542",
+			Patches: "1,3,2",
+			Expect:  ISEVersion{Major: 3, Minor: 2, Patch: 3},
+		},
+		"empty patches": {
+			Version: "3.3.0.430",
+			Expect:  ISEVersion{Major: 3, Minor: 3},
+		},
+		"invalid": {
+			Version:     "unknown",
+			ExpectError: true,
+		},
+	}
+	for tn, tc := range cases {
+		result, err := parseISEVersion(tc.Version, tc.Patches)
+		if (err != nil) != tc.ExpectError {
+			t.Errorf("bad: %s, expect error %t, got %v", tn, tc.ExpectError, err)
+			continue
+		}
+		if err == nil && *result != tc.Expect {
+			t.Errorf("bad: %s, expect %v, got %v", tn, tc.Expect, *result)
+		}
+	}
+}
+
+func TestISEVersionAtLeast(t *testing.T) {
+	min := ISEVersion{Major: 3, Minor: 3, Patch: 1}
+	if (ISEVersion{Major: 3, Minor: 3}).AtLeast(min) {
+		t.Errorf("bad: expect 3.3 to be older than 3.3 patch 1")
+	}
+	if !(ISEVersion{Major: 3, Minor: 3, Patch: 2}).AtLeast(min) {
+		t.Errorf("bad: expect 3.3 patch 2 to satisfy 3.3 patch 1")
+	}
+	if (ISEVersion{Major: 3, Minor: 2, Patch: 5}).AtLeast(min) {
+		t.Errorf("bad: expect 3.2 patch 5 to be older than 3.3 patch 1")
+	}
+	if !(ISEVersion{Major: 4}).AtLeast(min) {
+		t.Errorf("bad: expect 4.0 to satisfy 3.3 patch 1")
+	}
+}
+
+func TestVersionGate(t *testing.T) {
+	called := false
+	read := versionGate("ciscoise_duo_mfa", ISEVersion{Major: 3, Minor: 3, Patch: 1}, func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		called = true
+		return nil
+	})
+
+	diags := read(context.Background(), nil, ClientConfig{ISEVersion: &ISEVersion{Major: 3, Minor: 1}})
+	if !diags.HasError() || called {
+		t.Fatalf("bad: expect the read to be refused before any call")
+	}
+	if !strings.Contains(diags[0].Summary, "requires ISE 3.3 patch 1, connected to 3.1") {
+		t.Errorf("bad: unexpected summary %s", diags[0].Summary)
+	}
+
+	if diags := read(context.Background(), nil, ClientConfig{}); diags.HasError() || !called {
+		t.Errorf("bad: expect the read to run when the version is unknown")
+	}
+}
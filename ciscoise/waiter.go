@@ -0,0 +1,79 @@
+package ciscoise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	isegosdk "github.com/CiscoISE/ciscoise-go-sdk/sdk"
+)
+
+// waiter polls a check until it is done, doubling the interval between two
+// checks from minInterval up to maxInterval.
+type waiter struct {
+	minInterval time.Duration
+	maxInterval time.Duration
+}
+
+var patchWaiter = waiter{
+	minInterval: PATCH_POLL_MIN_INTERVAL,
+	maxInterval: PATCH_POLL_MAX_INTERVAL,
+}
+
+// wait runs check until it reports done or fails, or ctx is done. Checks
+// must return an error only when waiting longer is pointless.
+func (w waiter) wait(ctx context.Context, description string, check func() (bool, error)) error {
+	interval := w.minInterval
+	start := time.Now()
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			logInfof(ctx, "%s completed after %s", description, time.Since(start).Round(time.Second))
+			return nil
+		}
+		logInfof(ctx, "Waiting for %s, elapsed %s, next check in %s", description, time.Since(start).Round(time.Second), interval)
+		if err := sleepContext(ctx, interval); err != nil {
+			return fmt.Errorf("stopped waiting for %s after %s: %v", description, time.Since(start).Round(time.Second), err)
+		}
+		interval *= 2
+		if interval > w.maxInterval {
+			interval = w.maxInterval
+		}
+	}
+}
+
+// patchCheck returns a check which is done once installed reports want.
+// ISE restarts its application server while installing or rolling back a
+// patch, so the API being unreachable is progress and not a failure. A
+// failed task ends the wait.
+func patchCheck(ctx context.Context, client *isegosdk.Client, taskID string, want bool, installed func() (bool, error)) func() (bool, error) {
+	return func() (bool, error) {
+		if _, err := getISEVersion(ctx, client); err != nil {
+			logInfof(ctx, "The ISE application server is not running yet: %v", err)
+			return false, nil
+		}
+		isInstalled, err := installed()
+		if err != nil {
+			logInfof(ctx, "Unable to list the installed patches yet: %v", err)
+			return false, nil
+		}
+		if isInstalled == want {
+			return true, nil
+		}
+		if taskID == "" {
+			return false, nil
+		}
+		taskResponse, _, err := client.Tasks.GetTaskStatusByID(taskID)
+		if err != nil || taskResponse == nil {
+			return false, nil
+		}
+		logInfof(ctx, "Task %s is %s", taskID, taskResponse.ExecutionStatus)
+		if taskResponse.ExecutionStatus == "FAILED" {
+			return false, fmt.Errorf("task %s failed", taskID)
+		}
+		return false, nil
+	}
+}
@@ -0,0 +1,55 @@
+package ciscoise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaiterWait(t *testing.T) {
+	w := waiter{minInterval: time.Millisecond, maxInterval: 4 * time.Millisecond}
+	checks := 0
+	err := w.wait(context.Background(), "test", func() (bool, error) {
+		checks++
+		return checks == 5, nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if checks != 5 {
+		t.Errorf("bad: expect the wait to return at the first successful check, got %d checks", checks)
+	}
+}
+
+func TestWaiterWaitFailure(t *testing.T) {
+	w := waiter{minInterval: time.Millisecond, maxInterval: time.Millisecond}
+	failure := errors.New("task failed")
+	err := w.wait(context.Background(), "test", func() (bool, error) {
+		return false, failure
+	})
+	if err != failure {
+		t.Errorf("bad: expect the check error to be returned, got %v", err)
+	}
+}
+
+func TestWaiterWaitCancelled(t *testing.T) {
+	w := waiter{minInterval: time.Minute, maxInterval: time.Minute}
+	ctx, cancel := context.WithCancel(context.Background())
+	checks := 0
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	start := time.Now()
+	err := w.wait(ctx, "test", func() (bool, error) {
+		checks++
+		return false, nil
+	})
+	if err == nil || !errors.Is(ctx.Err(), context.Canceled) {
+		t.Errorf("bad: expect the cancellation to stop the wait, got %v", err)
+	}
+	if checks != 1 || time.Since(start) > time.Second {
+		t.Errorf("bad: expect the wait to stop during the first interval, got %d checks in %s", checks, time.Since(start))
+	}
+}
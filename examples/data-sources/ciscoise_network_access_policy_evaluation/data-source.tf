@@ -0,0 +1,21 @@
+
+data "ciscoise_network_access_policy_evaluation" "example" {
+  provider = ciscoise
+  attribute {
+    name  = "Radius:NAS-Port-Type"
+    value = "Wireless - IEEE 802.11"
+  }
+  attribute {
+    name  = "AD1:ExternalGroups"
+    value = "corp/Staff"
+  }
+  attribute {
+    name  = "AD1:ExternalGroups"
+    value = "corp/Sales"
+  }
+  time = "2026-10-19T10:30:00+02:00"
+}
+
+output "ciscoise_network_access_policy_evaluation_example" {
+  value = data.ciscoise_network_access_policy_evaluation.example.item
+}
@@ -8,10 +8,21 @@ provider "ciscoise" {
   password = "admin123"
   # it can be set using the environment variable ISE_PASSWORD
 
+  # File holding the password, it takes precedence over password
+  # password_file = "/run/secrets/ise_password"
+  # it can be set using the environment variable ISE_PASSWORD_FILE
+
+  # Command printing {"username": "...", "password": "..."}, it runs again when ISE returns 401
+  # credentials_command = ["vault", "kv", "get", "-format=json", "-field=data", "secret/ise"]
+
   #  Cisco Identity Services Engine base URL, FQDN or IP
   base_url = "https://172.168.196.2"
   # it can be set using the environment variable ISE_BASE_URL
 
+  # Admin nodes tried in order when the active one is down or is not the primary PAN,
+  # it takes precedence over base_url
+  # base_urls = ["https://172.168.196.2", "https://172.168.196.3"]
+
   # Boolean to enable debugging
   debug = "false"
   # it can be set using the environment variable ISE_DEBUG
@@ -20,6 +31,25 @@ provider "ciscoise" {
   ssl_verify = "false"
   # it can be set using the environment variable ISE_SSL_VERIFY
 
+  # CA bundle used to verify the ISE certificates instead of the system trust store
+  # ca_file = "/etc/pki/ise/ca.pem"
+  # ca_pem  = file("ca.pem")
+  # they can be set using the environment variables ISE_CA_FILE and ISE_CA_PEM
+
+  # Client certificate and private key for mutual TLS
+  # client_cert_pem = file("client.pem")
+  # client_key_pem  = file("client.key")
+  # they can be set using the environment variables ISE_CLIENT_CERT_PEM and ISE_CLIENT_KEY_PEM
+
+  # Server name used to verify the ISE certificates
+  # tls_server_name = "ise.example.com"
+  # it can be set using the environment variable ISE_TLS_SERVER_NAME
+
+  # Proxy used to reach ISE, and hosts reached without it
+  # proxy_url = "http://proxy.example.com:3128"
+  # no_proxy  = "10.0.0.0/8,.lab.example.com"
+  # they can be set using the environment variables ISE_PROXY_URL and ISE_NO_PROXY
+
   # Boolean to enable or disable the usage of the ISE's API Gateway
   use_api_gateway = "false"
   # it can be set using the environment variable ISE_USE_API_GATEWAY
@@ -32,7 +62,28 @@ provider "ciscoise" {
   single_request_timeout = 60
   # it can be set using the environment variable ISE_SINGLE_REQUEST_TIMEOUT
 
+  # Number of retries for idempotent requests that fail with transient errors
+  max_retries = 3
+  # it can be set using the environment variable ISE_MAX_RETRIES
+
+  # Minimum and maximum time (in seconds) to wait between retries
+  retry_min_wait = 1
+  retry_max_wait = 30
+  # they can be set using the environment variables ISE_RETRY_MIN_WAIT and ISE_RETRY_MAX_WAIT
+
+  # Maximum number of concurrent requests to each ISE node, 0 means unlimited
+  max_concurrent_requests = 0
+  # it can be set using the environment variable ISE_MAX_CONCURRENT_REQUESTS
+
+  # Maximum number of requests per second to each ISE node, 0 means unlimited
+  requests_per_second = 0
+  # it can be set using the environment variable ISE_REQUESTS_PER_SECOND
+
   # Boolean to enable or disable autoimport on resources
   enable_auto_import = "false"
   # it can be set using the environment variable ISE_ENABLE_AUTO_IMPORT
+
+  # What autoimport does with an existing object: adopt, overwrite or fail_on_diff
+  auto_import_mode = "adopt"
+  # it can be set using the environment variable ISE_AUTO_IMPORT_MODE
 }
\ No newline at end of file
@@ -1,2 +1,5 @@
+# By id
+terraform import ciscoise_anc_endpoint.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
 terraform import ciscoise_anc_endpoint.example "id:=string"
-terraform import ciscoise_anc_endpoint.example "mac_address:=string\policy_name:=string"
\ No newline at end of file
+terraform import ciscoise_anc_endpoint.example "mac_address:=string\policy_name:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_device_administration_authorization_rules.example "id:=string\policy_id:=string"
\ No newline at end of file
+# By policy set name and rule name
+terraform import ciscoise_device_administration_authorization_rules.example "policy_set_name/rule_name"
+# By resource ID
+terraform import ciscoise_device_administration_authorization_rules.example "id:=string\policy_id:=string"
@@ -1,2 +1,7 @@
+# By name
+terraform import ciscoise_device_administration_policy_set.example "string"
+# By id
+terraform import ciscoise_device_administration_policy_set.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
 terraform import ciscoise_device_administration_policy_set.example "id:=string"
-terraform import ciscoise_device_administration_policy_set.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_device_administration_policy_set.example "name:=string"
@@ -0,0 +1,6 @@
+# By policy set name
+terraform import ciscoise_device_administration_policy_set_rules.example "string"
+# By policy set id
+terraform import ciscoise_device_administration_policy_set_rules.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
+terraform import ciscoise_device_administration_policy_set_rules.example "v2:policy_id=01234567-89ab-cdef-0123-456789abcdef"
@@ -0,0 +1,34 @@
+resource "ciscoise_device_administration_policy_set_rules" "example" {
+  provider  = ciscoise
+  policy_id = "string"
+
+  authentication_rule {
+    name                 = "Default"
+    identity_source_name = "All_User_ID_Stores"
+    if_auth_fail         = "REJECT"
+    if_process_fail      = "DROP"
+    if_user_not_found    = "REJECT"
+  }
+
+  authorization_rule {
+    name                 = "Network Admins"
+    condition_expression = "AD1:ExternalGroups EQUALS \"corp/NetAdmins\""
+    commands             = ["PermitAllCommands"]
+    profile              = "Default Shell Profile"
+  }
+  authorization_rule {
+    name                 = "Helpdesk"
+    condition_expression = "AD1:ExternalGroups EQUALS \"corp/Helpdesk\" AND NOT Lib:Core_Devices"
+    commands             = ["ShowCommands"]
+    profile              = "Default Shell Profile"
+  }
+  authorization_rule {
+    name     = "Default"
+    commands = ["DenyAllCommands"]
+    profile  = "Deny All Shell Profile"
+  }
+}
+
+output "ciscoise_device_administration_policy_set_rules_example" {
+  value = ciscoise_device_administration_policy_set_rules.example
+}
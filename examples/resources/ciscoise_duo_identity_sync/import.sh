@@ -1 +1,4 @@
-terraform import ciscoise_duo_identity_sync.example "sync_name:=string"
\ No newline at end of file
+# By sync_name
+terraform import ciscoise_duo_identity_sync.example "string"
+# By resource ID
+terraform import ciscoise_duo_identity_sync.example "sync_name:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_duo_mfa.example "connection_name:=string"
\ No newline at end of file
+# By connection_name
+terraform import ciscoise_duo_mfa.example "string"
+# By resource ID
+terraform import ciscoise_duo_mfa.example "connection_name:=string"
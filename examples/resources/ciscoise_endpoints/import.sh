@@ -1 +1,4 @@
-terraform import ciscoise_endpoints.example "value:=string"
\ No newline at end of file
+# By value
+terraform import ciscoise_endpoints.example "string"
+# By resource ID
+terraform import ciscoise_endpoints.example "value:=string"
@@ -1,2 +1,5 @@
+# By id
+terraform import ciscoise_filter_policy.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
 terraform import ciscoise_filter_policy.example "id:=string"
-terraform import ciscoise_filter_policy.example "sgt:=string\subnet:=string\vn:=string"
\ No newline at end of file
+terraform import ciscoise_filter_policy.example "sgt:=string\subnet:=string\vn:=string"
@@ -7,4 +7,11 @@ resource "ciscoise_guest_user_bulk_request" "example" {
     operation_type      = "string"
     resource_media_type = "string"
   }
-}
\ No newline at end of file
+}
+resource "ciscoise_guest_user_bulk_request" "from_csv" {
+  provider = ciscoise
+  parameters {
+    operation_type = "create"
+  }
+  source_file = "${path.module}/guests.csv"
+}
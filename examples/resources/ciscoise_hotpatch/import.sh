@@ -1 +1,4 @@
-terraform import ciscoise_hotpatch.example "repository_name:=string\hotpatch_name:=string"
\ No newline at end of file
+# By hotpatch_name
+terraform import ciscoise_hotpatch.example "string"
+# By resource ID
+terraform import ciscoise_hotpatch.example "repository_name:=string\hotpatch_name:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_ipsec.example "host_name:=string\nad_ip:=string"
\ No newline at end of file
+# By host_name and nad_ip
+terraform import ciscoise_ipsec.example "host_name/nad_ip"
+# By resource ID
+terraform import ciscoise_ipsec.example "host_name:=string\nad_ip:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_licensing_tier_state.example "name:=string"
\ No newline at end of file
+# By name
+terraform import ciscoise_licensing_tier_state.example "string"
+# By resource ID
+terraform import ciscoise_licensing_tier_state.example "name:=string"
@@ -63,6 +63,23 @@ resource "ciscoise_network_access_authorization_rules" "example" {
   }
 }
 
+resource "ciscoise_network_access_authorization_rules" "expression" {
+  provider = ciscoise
+  parameters {
+
+    policy_id = "string"
+    profile   = ["PermitAccess"]
+    rule {
+
+      condition_expression = "Radius:NAS-Port-Type EQUALS \"Wireless - IEEE 802.11\" AND NOT Lib:Compliant"
+      default              = "false"
+      name                 = "Wireless staff"
+      rank                 = 1
+      state                = "enabled"
+    }
+  }
+}
+
 output "ciscoise_network_access_authorization_rules_example" {
   value = ciscoise_network_access_authorization_rules.example
 }
\ No newline at end of file
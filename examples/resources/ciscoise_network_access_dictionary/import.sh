@@ -1 +1,6 @@
-terraform import ciscoise_network_access_dictionary.example "name:=string"
\ No newline at end of file
+# By name
+terraform import ciscoise_network_access_dictionary.example "string"
+# By id
+terraform import ciscoise_network_access_dictionary.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
+terraform import ciscoise_network_access_dictionary.example "name:=string"
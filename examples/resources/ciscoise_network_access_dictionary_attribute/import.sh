@@ -1 +1,4 @@
-terraform import ciscoise_network_access_dictionary_attribute.example "dictionary_name:=string\name:=string"
\ No newline at end of file
+# By dictionary_name and name
+terraform import ciscoise_network_access_dictionary_attribute.example "dictionary_name/name"
+# By resource ID
+terraform import ciscoise_network_access_dictionary_attribute.example "dictionary_name:=string\name:=string"
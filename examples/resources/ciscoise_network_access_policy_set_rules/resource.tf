@@ -0,0 +1,47 @@
+resource "ciscoise_network_access_policy_set_rules" "example" {
+  provider  = ciscoise
+  policy_id = "string"
+
+  authentication_rule {
+    name                 = "Dot1X"
+    condition_expression = "Lib:Wired_802.1X OR Lib:Wireless_802.1X"
+    identity_source_name = "All_User_ID_Stores"
+    if_auth_fail         = "REJECT"
+    if_process_fail      = "DROP"
+    if_user_not_found    = "REJECT"
+  }
+  authentication_rule {
+    name                 = "Default"
+    identity_source_name = "All_User_ID_Stores"
+    if_auth_fail         = "REJECT"
+    if_process_fail      = "DROP"
+    if_user_not_found    = "REJECT"
+  }
+
+  local_exception_rule {
+    name                 = "Quarantine"
+    condition_expression = "Session:ANCPolicy EQUALS \"Quarantine\""
+    profile              = ["DenyAccess"]
+  }
+
+  authorization_rule {
+    name                 = "Staff"
+    condition_expression = "AD1:ExternalGroups EQUALS \"corp/Staff\" AND Lib:Compliant"
+    profile              = ["PermitAccess"]
+    security_group       = "Employees"
+  }
+  authorization_rule {
+    name                 = "Contractors"
+    state                = "monitor"
+    condition_expression = "AD1:ExternalGroups EQUALS \"corp/Contractors\""
+    profile              = ["PermitAccess"]
+  }
+  authorization_rule {
+    name    = "Default"
+    profile = ["DenyAccess"]
+  }
+}
+
+output "ciscoise_network_access_policy_set_rules_example" {
+  value = ciscoise_network_access_policy_set_rules.example
+}
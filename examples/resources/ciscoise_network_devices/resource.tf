@@ -0,0 +1,27 @@
+
+resource "ciscoise_network_devices" "example" {
+  provider                           = ciscoise
+  authoritative_network_device_group = "Location#All Locations#Site1"
+
+  dynamic "device" {
+    for_each = var.switches
+    content {
+      name                      = device.key
+      description               = device.value.description
+      network_device_group_list = ["Location#All Locations#Site1"]
+      radius_shared_secret      = var.radius_shared_secret
+      network_device_iplist {
+        ipaddress = device.value.ipaddress
+        mask      = 32
+      }
+    }
+  }
+}
+
+resource "ciscoise_network_devices" "from_csv" {
+  provider    = ciscoise
+  source_file = "${path.module}/switches.csv"
+  column_mappings = {
+    radius_shared_secret = "Secret"
+  }
+}
@@ -1 +1,4 @@
-terraform import ciscoise_node_deployment.example "hostname:=string\fqdn:=string"
\ No newline at end of file
+# By hostname
+terraform import ciscoise_node_deployment.example "string"
+# By resource ID
+terraform import ciscoise_node_deployment.example "hostname:=string\fqdn:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_node_group.example "node_group_name:=string"
\ No newline at end of file
+# By node_group_name
+terraform import ciscoise_node_group.example "string"
+# By resource ID
+terraform import ciscoise_node_group.example "node_group_name:=string"
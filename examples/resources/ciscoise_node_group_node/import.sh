@@ -1 +1,4 @@
-terraform import ciscoise_node_group_node.example "hostname:=string\node_group_name:=string"
\ No newline at end of file
+# By node_group_name and hostname
+terraform import ciscoise_node_group_node.example "node_group_name/hostname"
+# By resource ID
+terraform import ciscoise_node_group_node.example "hostname:=string\node_group_name:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_node_services_profiler_probe_config.example "hostname:=string"
\ No newline at end of file
+# By hostname
+terraform import ciscoise_node_services_profiler_probe_config.example "string"
+# By resource ID
+terraform import ciscoise_node_services_profiler_probe_config.example "hostname:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_node_services_sxp_interfaces.example "hostname:=string"
\ No newline at end of file
+# By hostname
+terraform import ciscoise_node_services_sxp_interfaces.example "string"
+# By resource ID
+terraform import ciscoise_node_services_sxp_interfaces.example "hostname:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_patch.example "repository_name:=string\hotpatch_name:=string\patch_number:=1"
\ No newline at end of file
+# By patch_number
+terraform import ciscoise_patch.example "string"
+# By resource ID
+terraform import ciscoise_patch.example "repository_name:=string\hotpatch_name:=string\patch_number:=1"
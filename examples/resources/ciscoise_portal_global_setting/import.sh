@@ -1 +1,4 @@
-terraform import ciscoise_portal_global_setting.example "id:=string"
\ No newline at end of file
+# By id
+terraform import ciscoise_portal_global_setting.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
+terraform import ciscoise_portal_global_setting.example "id:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_px_grid_direct.example "connector_name:=string"
\ No newline at end of file
+# By connector_name
+terraform import ciscoise_px_grid_direct.example "string"
+# By resource ID
+terraform import ciscoise_px_grid_direct.example "connector_name:=string"
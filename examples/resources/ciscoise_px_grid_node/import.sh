@@ -1 +1,4 @@
-terraform import ciscoise_px_grid_node.example "name:=string"
\ No newline at end of file
+# By name
+terraform import ciscoise_px_grid_node.example "string"
+# By resource ID
+terraform import ciscoise_px_grid_node.example "name:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_repository.example "name:=string"
\ No newline at end of file
+# By name
+terraform import ciscoise_repository.example "string"
+# By resource ID
+terraform import ciscoise_repository.example "name:=string"
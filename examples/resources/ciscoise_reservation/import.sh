@@ -1 +1,4 @@
-terraform import ciscoise_reservation.example "client_id:=string"
\ No newline at end of file
+# By client_id
+terraform import ciscoise_reservation.example "string"
+# By resource ID
+terraform import ciscoise_reservation.example "client_id:=string"
@@ -1,2 +1,7 @@
+# By name
+terraform import ciscoise_sg_to_vn_to_vlan.example "string"
+# By id
+terraform import ciscoise_sg_to_vn_to_vlan.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
 terraform import ciscoise_sg_to_vn_to_vlan.example "id:=string"
-terraform import ciscoise_sg_to_vn_to_vlan.example "name:=string"
\ No newline at end of file
+terraform import ciscoise_sg_to_vn_to_vlan.example "name:=string"
@@ -1,2 +1,7 @@
+# By sxp_vpn_name
+terraform import ciscoise_sxp_vpns.example "string"
+# By id
+terraform import ciscoise_sxp_vpns.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
 terraform import ciscoise_sxp_vpns.example "id:=string"
-terraform import ciscoise_sxp_vpns.example "sxp_vpn_name:=string"
\ No newline at end of file
+terraform import ciscoise_sxp_vpns.example "sxp_vpn_name:=string"
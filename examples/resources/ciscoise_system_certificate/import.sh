@@ -1,2 +1,5 @@
+# By host_name and name
+terraform import ciscoise_system_certificate.example "host_name/name"
+# By resource ID
 terraform import ciscoise_system_certificate.example "host_name:=string\id:=string"
-terraform import ciscoise_system_certificate.example "host_name:=string\name:=string"
\ No newline at end of file
+terraform import ciscoise_system_certificate.example "host_name:=string\name:=string"
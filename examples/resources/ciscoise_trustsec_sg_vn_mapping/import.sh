@@ -1 +1,4 @@
-terraform import ciscoise_trustsec_sg_vn_mapping.example "id:=string"
\ No newline at end of file
+# By id
+terraform import ciscoise_trustsec_sg_vn_mapping.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
+terraform import ciscoise_trustsec_sg_vn_mapping.example "id:=string"
@@ -1 +1,4 @@
-terraform import ciscoise_user_equipment.example "user_equipment_id:=string"
\ No newline at end of file
+# By user_equipment_id
+terraform import ciscoise_user_equipment.example "01234567-89ab-cdef-0123-456789abcdef"
+# By resource ID
+terraform import ciscoise_user_equipment.example "user_equipment_id:=string"